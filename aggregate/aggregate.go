@@ -0,0 +1,219 @@
+// file: aggregate/aggregate.go
+// description: streaming aggregation of URLs by eTLD, domain and host
+
+// Package aggregate counts URLs and hosts by public suffix, registrable
+// domain and host name in bounded memory. Each level keeps a Space-Saving
+// sketch, so the heaviest hitters are reported with guaranteed error bounds
+// no matter how long the tail of rare sites is.
+package aggregate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// Level selects the granularity of counts
+type Level int
+
+// Aggregation levels, from coarsest to finest
+const (
+	LevelTLD Level = iota
+	LevelDomain
+	LevelHost
+)
+
+// String returns the name of the level
+func (l Level) String() string {
+	switch l {
+	case LevelTLD:
+		return "tld"
+	case LevelDomain:
+		return "domain"
+	case LevelHost:
+		return "host"
+	}
+	return fmt.Sprintf("Level(%d)", int(l))
+}
+
+// DefaultCapacity is the number of keys monitored per level by New
+const DefaultCapacity = 10000
+
+// Aggregator counts inputs at every level. It is safe for concurrent use.
+type Aggregator struct {
+	fqdn    *gotld.FQDN
+	mu      sync.Mutex
+	levels  [3]*spaceSaving
+	total   uint64
+	invalid uint64
+}
+
+// New creates an Aggregator monitoring up to capacity keys per level; a
+// capacity of zero or less uses DefaultCapacity
+func New(f *gotld.FQDN, capacity int) *Aggregator {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	a := &Aggregator{fqdn: f}
+	for i := range a.levels {
+		a.levels[i] = newSpaceSaving(capacity)
+	}
+
+	return a
+}
+
+// Add counts one URL or host. Inputs that cannot be parsed are counted as
+// invalid and the parse error is returned.
+func (a *Aggregator) Add(input string) error {
+	res, err := a.fqdn.Parse(input)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.total++
+	if err != nil {
+		a.invalid++
+		return err
+	}
+
+	a.levels[LevelTLD].add(res.TLD, "")
+	a.levels[LevelDomain].add(res.RegistrableDomain, res.TLD)
+	a.levels[LevelHost].add(res.Host, res.RegistrableDomain)
+
+	return nil
+}
+
+// ReadFrom counts every non-empty line of r, ignoring invalid lines
+func (a *Aggregator) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	br := bufio.NewReader(r)
+
+	for {
+		line, err := br.ReadString('\n')
+		n += int64(len(line))
+
+		if line = strings.TrimSpace(line); line != "" {
+			_ = a.Add(line)
+		}
+
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+// Top returns up to k of the heaviest keys at level; k <= 0 returns all
+// monitored keys
+func (a *Aggregator) Top(level Level, k int) []Entry {
+	if level < LevelTLD || level > LevelHost {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.levels[level].top(k, "", false)
+}
+
+// Total returns the number of inputs added and how many were invalid
+func (a *Aggregator) Total() (total, invalid uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.total, a.invalid
+}
+
+// Report is a hierarchical roll-up of the heaviest keys
+type Report struct {
+	// Total is the number of inputs added
+	Total uint64 `json:"total"`
+
+	// Invalid is the number of inputs that could not be parsed
+	Invalid uint64 `json:"invalid"`
+
+	// TLDs are the heaviest public suffixes
+	TLDs []TLDReport `json:"tlds"`
+}
+
+// TLDReport is the roll-up of one public suffix
+type TLDReport struct {
+	Entry
+
+	// Domains are the heaviest registrable domains under the suffix
+	Domains []DomainReport `json:"domains,omitempty"`
+}
+
+// DomainReport is the roll-up of one registrable domain
+type DomainReport struct {
+	Entry
+
+	// Hosts are the heaviest host names under the domain
+	Hosts []Entry `json:"hosts,omitempty"`
+}
+
+// Report returns up to k entries per level. Children are drawn from the
+// monitored keys of the finer level, so a child may be missing when it was
+// evicted from its sketch.
+func (a *Aggregator) Report(k int) Report {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rep := Report{Total: a.total, Invalid: a.invalid}
+
+	for _, tld := range a.levels[LevelTLD].top(k, "", false) {
+		tr := TLDReport{Entry: tld}
+
+		for _, domain := range a.levels[LevelDomain].top(k, tld.Key, true) {
+			tr.Domains = append(tr.Domains, DomainReport{
+				Entry: domain,
+				Hosts: a.levels[LevelHost].top(k, domain.Key, true),
+			})
+		}
+
+		rep.TLDs = append(rep.TLDs, tr)
+	}
+
+	return rep
+}
+
+// WriteText writes the report as an indented tree
+func (r Report) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "total %d, invalid %d\n", r.Total, r.Invalid); err != nil {
+		return err
+	}
+
+	for _, tld := range r.TLDs {
+		if _, err := fmt.Fprintf(w, "%s\n", formatEntry(tld.Entry, 0)); err != nil {
+			return err
+		}
+		for _, domain := range tld.Domains {
+			if _, err := fmt.Fprintf(w, "%s\n", formatEntry(domain.Entry, 1)); err != nil {
+				return err
+			}
+			for _, host := range domain.Hosts {
+				if _, err := fmt.Fprintf(w, "%s\n", formatEntry(host, 2)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatEntry formats an entry indented by depth
+func formatEntry(e Entry, depth int) string {
+	s := fmt.Sprintf("%s%-*s %d", strings.Repeat("  ", depth), 40-2*depth, e.Key, e.Count)
+	if e.Error > 0 {
+		s += fmt.Sprintf(" (±%d)", e.Error)
+	}
+	return s
+}
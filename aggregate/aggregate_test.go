@@ -0,0 +1,140 @@
+// file: aggregate/aggregate_test.go
+// description: tests for the streaming aggregator
+
+package aggregate
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// newTestManager creates a manager using the built-in table
+func newTestManager(t testing.TB) *gotld.FQDN {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return f
+}
+
+// TestAggregator tests exact counts while every key fits the sketches
+func TestAggregator(t *testing.T) {
+	a := New(newTestManager(t), 100)
+
+	input := strings.Join([]string{
+		"https://www.example.com/a",
+		"https://www.example.com/b",
+		"api.example.com",
+		"example.co.uk",
+		"shop.example.co.uk",
+		"www.other.com",
+		"not a url",
+		"",
+	}, "\n")
+
+	if _, err := a.ReadFrom(strings.NewReader(input)); err != nil {
+		t.Fatalf("ReadFrom() error = %v", err)
+	}
+
+	if total, invalid := a.Total(); total != 7 || invalid != 1 {
+		t.Errorf("Total() = %d, %d, want 7, 1", total, invalid)
+	}
+
+	tests := []struct {
+		level Level
+		want  []Entry
+	}{
+		{LevelTLD, []Entry{{Key: "com", Count: 4}, {Key: "co.uk", Count: 2}}},
+		{LevelDomain, []Entry{{Key: "example.com", Count: 3}, {Key: "example.co.uk", Count: 2}}},
+		{LevelHost, []Entry{{Key: "www.example.com", Count: 2}, {Key: "api.example.com", Count: 1}}},
+	}
+
+	for _, tt := range tests {
+		got := a.Top(tt.level, 2)
+		if len(got) != len(tt.want) {
+			t.Fatalf("Top(%v) = %+v, want %+v", tt.level, got, tt.want)
+		}
+		for i := range got {
+			if got[i].Key != tt.want[i].Key || got[i].Count != tt.want[i].Count || got[i].Error != 0 {
+				t.Errorf("Top(%v)[%d] = %+v, want %+v", tt.level, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	rep := a.Report(0)
+	if len(rep.TLDs) != 2 || rep.TLDs[0].Key != "com" || len(rep.TLDs[0].Domains) != 2 {
+		t.Fatalf("Report() = %+v", rep)
+	}
+
+	domain := rep.TLDs[0].Domains[0]
+	if domain.Key != "example.com" || len(domain.Hosts) != 2 || domain.Hosts[0].Key != "www.example.com" {
+		t.Errorf("Report() domain = %+v", domain)
+	}
+
+	// co.uk domains must not appear under uk or com
+	for _, d := range rep.TLDs[1].Domains {
+		if !strings.HasSuffix(d.Key, ".co.uk") {
+			t.Errorf("Report() co.uk domain = %q", d.Key)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := rep.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "total 7, invalid 1") || !strings.Contains(buf.String(), "    www.example.com") {
+		t.Errorf("WriteText() = %q", buf.String())
+	}
+}
+
+// TestAggregatorBounded tests that heavy hitters survive a long tail
+func TestAggregatorBounded(t *testing.T) {
+	a := New(newTestManager(t), 20)
+
+	for i := 0; i < 5000; i++ {
+		switch {
+		case i%4 == 0:
+			_ = a.Add("www.heavy.com")
+		case i%10 == 1:
+			_ = a.Add("www.medium.org")
+		default:
+			_ = a.Add(fmt.Sprintf("h.rare%d.net", i))
+		}
+	}
+
+	top := a.Top(LevelDomain, 2)
+	if len(top) != 2 || top[0].Key != "heavy.com" || top[1].Key != "medium.org" {
+		t.Fatalf("Top() = %+v, want heavy.com, medium.org", top)
+	}
+
+	// The true count lies within the reported error bounds
+	if top[0].Count < 1250 || top[0].Count-top[0].Error > 1250 {
+		t.Errorf("heavy.com = %+v, want bounds around 1250", top[0])
+	}
+
+	if n := len(a.Top(LevelHost, 0)); n != 20 {
+		t.Errorf("monitored hosts = %d, want 20", n)
+	}
+}
+
+// BenchmarkAggregatorAdd measures adding hosts with a long tail
+func BenchmarkAggregatorAdd(b *testing.B) {
+	a := New(newTestManager(b), 1000)
+
+	hosts := make([]string, 10000)
+	for i := range hosts {
+		hosts[i] = fmt.Sprintf("www.site%d.example.co.uk", i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = a.Add(hosts[i%len(hosts)])
+	}
+}
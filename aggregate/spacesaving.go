@@ -0,0 +1,121 @@
+// file: aggregate/spacesaving.go
+// description: Space-Saving heavy hitters sketch
+
+package aggregate
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// Entry is an estimated count of one key
+type Entry struct {
+	// Key is the eTLD, registrable domain or host
+	Key string `json:"key"`
+
+	// Count is the estimated number of occurrences; it never undercounts
+	Count uint64 `json:"count"`
+
+	// Error bounds the overestimation: the true count lies within
+	// [Count-Error, Count]
+	Error uint64 `json:"error,omitempty"`
+
+	// parent is the key one level up, e.g. the eTLD of a domain
+	parent string
+}
+
+// counter is a monitored key in the sketch
+type counter struct {
+	Entry
+	index int
+}
+
+// spaceSaving tracks approximate top counts using at most capacity counters
+type spaceSaving struct {
+	capacity int
+	counters map[string]*counter
+	heap     counterHeap
+}
+
+// newSpaceSaving creates a sketch monitoring up to capacity keys
+func newSpaceSaving(capacity int) *spaceSaving {
+	return &spaceSaving{
+		capacity: capacity,
+		counters: make(map[string]*counter, capacity),
+	}
+}
+
+// add counts one occurrence of key, replacing the smallest counter when
+// the sketch is full
+func (s *spaceSaving) add(key, parent string) {
+	if c, ok := s.counters[key]; ok {
+		c.Count++
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+
+	if len(s.heap) < s.capacity {
+		c := &counter{Entry: Entry{Key: key, Count: 1, parent: parent}}
+		s.counters[key] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+
+	// The new key inherits the evicted count as its possible error
+	c := s.heap[0]
+	delete(s.counters, c.Key)
+	c.Error = c.Count
+	c.Count++
+	c.Key, c.parent = key, parent
+	s.counters[key] = c
+	heap.Fix(&s.heap, 0)
+}
+
+// top returns up to k entries with the highest counts; k <= 0 returns all.
+// Only entries whose parent matches are returned when filter is true.
+func (s *spaceSaving) top(k int, parent string, filter bool) []Entry {
+	entries := make([]Entry, 0, len(s.heap))
+	for _, c := range s.heap {
+		if !filter || c.parent == parent {
+			entries = append(entries, c.Entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Key < entries[j].Key
+	})
+
+	if k > 0 && len(entries) > k {
+		entries = entries[:k]
+	}
+
+	return entries
+}
+
+// counterHeap is a min-heap of counters ordered by count
+type counterHeap []*counter
+
+func (h counterHeap) Len() int           { return len(h) }
+func (h counterHeap) Less(i, j int) bool { return h[i].Count < h[j].Count }
+
+func (h counterHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *counterHeap) Push(x any) {
+	c := x.(*counter)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+
+func (h *counterHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,8 @@
+// file: api/gotld/v1/generate.go
+// description: regenerates the protobuf and gRPC code
+
+// Package gotldv1 contains the generated protobuf and gRPC code for the
+// gotld.v1 DomainService.
+package gotldv1
+
+//go:generate protoc -I ../../.. --go_out=../../.. --go_opt=paths=source_relative --go-grpc_out=../../.. --go-grpc_opt=paths=source_relative api/gotld/v1/gotld.proto
@@ -0,0 +1,625 @@
+// file: api/gotld/v1/gotld.proto
+// description: gRPC service definition for domain parsing
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/gotld/v1/gotld.proto
+
+package gotldv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ParseRequest holds a URL or bare host
+type ParseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseRequest) Reset() {
+	*x = ParseRequest{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseRequest) ProtoMessage() {}
+
+func (x *ParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseRequest.ProtoReflect.Descriptor instead.
+func (*ParseRequest) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParseRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+// ParseResponse holds the components of a parsed URL or host
+type ParseResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Input             string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Scheme            string                 `protobuf:"bytes,2,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Host              string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port              string                 `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	Subdomain         string                 `protobuf:"bytes,5,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	Domain            string                 `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+	Tld               string                 `protobuf:"bytes,7,opt,name=tld,proto3" json:"tld,omitempty"`
+	RegistrableDomain string                 `protobuf:"bytes,8,opt,name=registrable_domain,json=registrableDomain,proto3" json:"registrable_domain,omitempty"`
+	// error is only set by BatchParse when the input could not be parsed
+	Error         string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ParseResponse) Reset() {
+	*x = ParseResponse{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseResponse) ProtoMessage() {}
+
+func (x *ParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseResponse.ProtoReflect.Descriptor instead.
+func (*ParseResponse) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ParseResponse) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetTld() string {
+	if x != nil {
+		return x.Tld
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetRegistrableDomain() string {
+	if x != nil {
+		return x.RegistrableDomain
+	}
+	return ""
+}
+
+func (x *ParseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SameSiteRequest holds the two inputs to compare
+type SameSiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	A             string                 `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B             string                 `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SameSiteRequest) Reset() {
+	*x = SameSiteRequest{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SameSiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SameSiteRequest) ProtoMessage() {}
+
+func (x *SameSiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SameSiteRequest.ProtoReflect.Descriptor instead.
+func (*SameSiteRequest) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SameSiteRequest) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *SameSiteRequest) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+// SameSiteResponse holds the comparison result
+type SameSiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SameSite      bool                   `protobuf:"varint,1,opt,name=same_site,json=sameSite,proto3" json:"same_site,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SameSiteResponse) Reset() {
+	*x = SameSiteResponse{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SameSiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SameSiteResponse) ProtoMessage() {}
+
+func (x *SameSiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SameSiteResponse.ProtoReflect.Descriptor instead.
+func (*SameSiteResponse) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SameSiteResponse) GetSameSite() bool {
+	if x != nil {
+		return x.SameSite
+	}
+	return false
+}
+
+// StatusRequest has no fields
+type StatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{4}
+}
+
+// RuleCounts tallies the rules of the loaded list
+type RuleCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Icann         int64                  `protobuf:"varint,2,opt,name=icann,proto3" json:"icann,omitempty"`
+	Private       int64                  `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	Normal        int64                  `protobuf:"varint,4,opt,name=normal,proto3" json:"normal,omitempty"`
+	Wildcard      int64                  `protobuf:"varint,5,opt,name=wildcard,proto3" json:"wildcard,omitempty"`
+	Exception     int64                  `protobuf:"varint,6,opt,name=exception,proto3" json:"exception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleCounts) Reset() {
+	*x = RuleCounts{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCounts) ProtoMessage() {}
+
+func (x *RuleCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCounts.ProtoReflect.Descriptor instead.
+func (*RuleCounts) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RuleCounts) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RuleCounts) GetIcann() int64 {
+	if x != nil {
+		return x.Icann
+	}
+	return 0
+}
+
+func (x *RuleCounts) GetPrivate() int64 {
+	if x != nil {
+		return x.Private
+	}
+	return 0
+}
+
+func (x *RuleCounts) GetNormal() int64 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+func (x *RuleCounts) GetWildcard() int64 {
+	if x != nil {
+		return x.Wildcard
+	}
+	return 0
+}
+
+func (x *RuleCounts) GetException() int64 {
+	if x != nil {
+		return x.Exception
+	}
+	return 0
+}
+
+// StatusResponse describes the loaded public suffix list
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	Rules         *RuleCounts            `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	Builtin       bool                   `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	Fallback      bool                   `protobuf:"varint,6,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	LoadedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=loaded_at,json=loadedAt,proto3" json:"loaded_at,omitempty"`
+	LastRefresh   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_refresh,json=lastRefresh,proto3" json:"last_refresh,omitempty"`
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_gotld_v1_gotld_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_gotld_v1_gotld_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StatusResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetRules() *RuleCounts {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *StatusResponse) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
+func (x *StatusResponse) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetLastRefresh() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRefresh
+	}
+	return nil
+}
+
+func (x *StatusResponse) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+var File_api_gotld_v1_gotld_proto protoreflect.FileDescriptor
+
+const file_api_gotld_v1_gotld_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/gotld/v1/gotld.proto\x12\bgotld.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
+	"\fParseRequest\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\"\xf2\x01\n" +
+	"\rParseResponse\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x16\n" +
+	"\x06scheme\x18\x02 \x01(\tR\x06scheme\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\tR\x04port\x12\x1c\n" +
+	"\tsubdomain\x18\x05 \x01(\tR\tsubdomain\x12\x16\n" +
+	"\x06domain\x18\x06 \x01(\tR\x06domain\x12\x10\n" +
+	"\x03tld\x18\a \x01(\tR\x03tld\x12-\n" +
+	"\x12registrable_domain\x18\b \x01(\tR\x11registrableDomain\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"-\n" +
+	"\x0fSameSiteRequest\x12\f\n" +
+	"\x01a\x18\x01 \x01(\tR\x01a\x12\f\n" +
+	"\x01b\x18\x02 \x01(\tR\x01b\"/\n" +
+	"\x10SameSiteResponse\x12\x1b\n" +
+	"\tsame_site\x18\x01 \x01(\bR\bsameSite\"\x0f\n" +
+	"\rStatusRequest\"\xa4\x01\n" +
+	"\n" +
+	"RuleCounts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05icann\x18\x02 \x01(\x03R\x05icann\x12\x18\n" +
+	"\aprivate\x18\x03 \x01(\x03R\aprivate\x12\x16\n" +
+	"\x06normal\x18\x04 \x01(\x03R\x06normal\x12\x1a\n" +
+	"\bwildcard\x18\x05 \x01(\x03R\bwildcard\x12\x1c\n" +
+	"\texception\x18\x06 \x01(\x03R\texception\"\xd3\x02\n" +
+	"\x0eStatusResponse\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x03 \x01(\tR\x06commit\x12*\n" +
+	"\x05rules\x18\x04 \x01(\v2\x14.gotld.v1.RuleCountsR\x05rules\x12\x18\n" +
+	"\abuiltin\x18\x05 \x01(\bR\abuiltin\x12\x1a\n" +
+	"\bfallback\x18\x06 \x01(\bR\bfallback\x127\n" +
+	"\tloaded_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt\x12=\n" +
+	"\flast_refresh\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vlastRefresh\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError2\x8c\x02\n" +
+	"\rDomainService\x128\n" +
+	"\x05Parse\x12\x16.gotld.v1.ParseRequest\x1a\x17.gotld.v1.ParseResponse\x12A\n" +
+	"\n" +
+	"BatchParse\x12\x16.gotld.v1.ParseRequest\x1a\x17.gotld.v1.ParseResponse(\x010\x01\x12A\n" +
+	"\bSameSite\x12\x19.gotld.v1.SameSiteRequest\x1a\x1a.gotld.v1.SameSiteResponse\x12;\n" +
+	"\x06Status\x12\x17.gotld.v1.StatusRequest\x1a\x18.gotld.v1.StatusResponseB6Z4github.com/AndrewDonelson/gotld/api/gotld/v1;gotldv1b\x06proto3"
+
+var (
+	file_api_gotld_v1_gotld_proto_rawDescOnce sync.Once
+	file_api_gotld_v1_gotld_proto_rawDescData []byte
+)
+
+func file_api_gotld_v1_gotld_proto_rawDescGZIP() []byte {
+	file_api_gotld_v1_gotld_proto_rawDescOnce.Do(func() {
+		file_api_gotld_v1_gotld_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_gotld_v1_gotld_proto_rawDesc), len(file_api_gotld_v1_gotld_proto_rawDesc)))
+	})
+	return file_api_gotld_v1_gotld_proto_rawDescData
+}
+
+var file_api_gotld_v1_gotld_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_gotld_v1_gotld_proto_goTypes = []any{
+	(*ParseRequest)(nil),          // 0: gotld.v1.ParseRequest
+	(*ParseResponse)(nil),         // 1: gotld.v1.ParseResponse
+	(*SameSiteRequest)(nil),       // 2: gotld.v1.SameSiteRequest
+	(*SameSiteResponse)(nil),      // 3: gotld.v1.SameSiteResponse
+	(*StatusRequest)(nil),         // 4: gotld.v1.StatusRequest
+	(*RuleCounts)(nil),            // 5: gotld.v1.RuleCounts
+	(*StatusResponse)(nil),        // 6: gotld.v1.StatusResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_api_gotld_v1_gotld_proto_depIdxs = []int32{
+	5, // 0: gotld.v1.StatusResponse.rules:type_name -> gotld.v1.RuleCounts
+	7, // 1: gotld.v1.StatusResponse.loaded_at:type_name -> google.protobuf.Timestamp
+	7, // 2: gotld.v1.StatusResponse.last_refresh:type_name -> google.protobuf.Timestamp
+	0, // 3: gotld.v1.DomainService.Parse:input_type -> gotld.v1.ParseRequest
+	0, // 4: gotld.v1.DomainService.BatchParse:input_type -> gotld.v1.ParseRequest
+	2, // 5: gotld.v1.DomainService.SameSite:input_type -> gotld.v1.SameSiteRequest
+	4, // 6: gotld.v1.DomainService.Status:input_type -> gotld.v1.StatusRequest
+	1, // 7: gotld.v1.DomainService.Parse:output_type -> gotld.v1.ParseResponse
+	1, // 8: gotld.v1.DomainService.BatchParse:output_type -> gotld.v1.ParseResponse
+	3, // 9: gotld.v1.DomainService.SameSite:output_type -> gotld.v1.SameSiteResponse
+	6, // 10: gotld.v1.DomainService.Status:output_type -> gotld.v1.StatusResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_gotld_v1_gotld_proto_init() }
+func file_api_gotld_v1_gotld_proto_init() {
+	if File_api_gotld_v1_gotld_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_gotld_v1_gotld_proto_rawDesc), len(file_api_gotld_v1_gotld_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_gotld_v1_gotld_proto_goTypes,
+		DependencyIndexes: file_api_gotld_v1_gotld_proto_depIdxs,
+		MessageInfos:      file_api_gotld_v1_gotld_proto_msgTypes,
+	}.Build()
+	File_api_gotld_v1_gotld_proto = out.File
+	file_api_gotld_v1_gotld_proto_goTypes = nil
+	file_api_gotld_v1_gotld_proto_depIdxs = nil
+}
@@ -0,0 +1,245 @@
+// file: api/gotld/v1/gotld.proto
+// description: gRPC service definition for domain parsing
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/gotld/v1/gotld.proto
+
+package gotldv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DomainService_Parse_FullMethodName      = "/gotld.v1.DomainService/Parse"
+	DomainService_BatchParse_FullMethodName = "/gotld.v1.DomainService/BatchParse"
+	DomainService_SameSite_FullMethodName   = "/gotld.v1.DomainService/SameSite"
+	DomainService_Status_FullMethodName     = "/gotld.v1.DomainService/Status"
+)
+
+// DomainServiceClient is the client API for DomainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DomainService exposes a shared public suffix list instance
+type DomainServiceClient interface {
+	// Parse splits a URL or host into its components
+	Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error)
+	// BatchParse parses a stream of inputs; failures are reported per item
+	BatchParse(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ParseRequest, ParseResponse], error)
+	// SameSite reports whether two inputs share a registrable domain
+	SameSite(ctx context.Context, in *SameSiteRequest, opts ...grpc.CallOption) (*SameSiteResponse, error)
+	// Status describes the loaded public suffix list
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+}
+
+type domainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDomainServiceClient(cc grpc.ClientConnInterface) DomainServiceClient {
+	return &domainServiceClient{cc}
+}
+
+func (c *domainServiceClient) Parse(ctx context.Context, in *ParseRequest, opts ...grpc.CallOption) (*ParseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseResponse)
+	err := c.cc.Invoke(ctx, DomainService_Parse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) BatchParse(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ParseRequest, ParseResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DomainService_ServiceDesc.Streams[0], DomainService_BatchParse_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ParseRequest, ParseResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainService_BatchParseClient = grpc.BidiStreamingClient[ParseRequest, ParseResponse]
+
+func (c *domainServiceClient) SameSite(ctx context.Context, in *SameSiteRequest, opts ...grpc.CallOption) (*SameSiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SameSiteResponse)
+	err := c.cc.Invoke(ctx, DomainService_SameSite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, DomainService_Status_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DomainServiceServer is the server API for DomainService service.
+// All implementations must embed UnimplementedDomainServiceServer
+// for forward compatibility.
+//
+// DomainService exposes a shared public suffix list instance
+type DomainServiceServer interface {
+	// Parse splits a URL or host into its components
+	Parse(context.Context, *ParseRequest) (*ParseResponse, error)
+	// BatchParse parses a stream of inputs; failures are reported per item
+	BatchParse(grpc.BidiStreamingServer[ParseRequest, ParseResponse]) error
+	// SameSite reports whether two inputs share a registrable domain
+	SameSite(context.Context, *SameSiteRequest) (*SameSiteResponse, error)
+	// Status describes the loaded public suffix list
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	mustEmbedUnimplementedDomainServiceServer()
+}
+
+// UnimplementedDomainServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDomainServiceServer struct{}
+
+func (UnimplementedDomainServiceServer) Parse(context.Context, *ParseRequest) (*ParseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Parse not implemented")
+}
+func (UnimplementedDomainServiceServer) BatchParse(grpc.BidiStreamingServer[ParseRequest, ParseResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BatchParse not implemented")
+}
+func (UnimplementedDomainServiceServer) SameSite(context.Context, *SameSiteRequest) (*SameSiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SameSite not implemented")
+}
+func (UnimplementedDomainServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedDomainServiceServer) mustEmbedUnimplementedDomainServiceServer() {}
+func (UnimplementedDomainServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDomainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DomainServiceServer will
+// result in compilation errors.
+type UnsafeDomainServiceServer interface {
+	mustEmbedUnimplementedDomainServiceServer()
+}
+
+func RegisterDomainServiceServer(s grpc.ServiceRegistrar, srv DomainServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDomainServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DomainService_ServiceDesc, srv)
+}
+
+func _DomainService_Parse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).Parse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_Parse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).Parse(ctx, req.(*ParseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_BatchParse_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DomainServiceServer).BatchParse(&grpc.GenericServerStream[ParseRequest, ParseResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DomainService_BatchParseServer = grpc.BidiStreamingServer[ParseRequest, ParseResponse]
+
+func _DomainService_SameSite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SameSiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).SameSite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_SameSite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).SameSite(ctx, req.(*SameSiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).Status(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DomainService_ServiceDesc is the grpc.ServiceDesc for DomainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DomainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gotld.v1.DomainService",
+	HandlerType: (*DomainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Parse",
+			Handler:    _DomainService_Parse_Handler,
+		},
+		{
+			MethodName: "SameSite",
+			Handler:    _DomainService_SameSite_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _DomainService_Status_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchParse",
+			Handler:       _DomainService_BatchParse_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/gotld/v1/gotld.proto",
+}
@@ -0,0 +1,410 @@
+// file: blocklist/blocklist.go
+// description: compiled domain blocklist and allowlist matcher
+
+// Package blocklist answers "is this host or any parent blocked?" for
+// lists with millions of entries. A Builder collects block and exception
+// entries, directly or from hosts files, AdBlock filters, plain domain
+// lists and RPZ zones, and compiles them into an immutable Matcher. Like
+// the public suffix table, the Matcher stores its sorted domains in one
+// string addressed by offsets and answers a query by walking the host's
+// labels from the full name towards the top-level domain, so it costs one
+// binary search per label and a few bytes per entry.
+package blocklist
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AndrewDonelson/gotld"
+	"golang.org/x/net/idna"
+)
+
+// ErrInvalidEntry is returned when an entry is not a valid domain
+var ErrInvalidEntry = errors.New("invalid blocklist entry")
+
+// Action is what an entry does to the hosts it matches
+type Action int
+
+const (
+	// Block blocks matching hosts
+	Block Action = iota
+
+	// Allow is an exception: it allows matching hosts even if an entry
+	// for the same host or a parent blocks them
+	Allow
+)
+
+// String returns the name of the action
+func (a Action) String() string {
+	switch a {
+	case Block:
+		return "block"
+	case Allow:
+		return "allow"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (a Action) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// Mode selects the hosts an entry matches
+type Mode int
+
+const (
+	// Exact matches the domain only, as in hosts files
+	Exact Mode = iota
+
+	// Subdomains matches the domain and every subdomain, as in AdBlock
+	// "||example.com^" filters
+	Subdomains
+
+	// Wildcard matches strict subdomains only, as in RPZ "*.example.com"
+	Wildcard
+
+	// Registrable matches every host under the registrable domain of the
+	// entry, so "ads.example.co.uk" covers all of "example.co.uk". It is
+	// stored as a Subdomains entry for the registrable domain.
+	Registrable
+)
+
+// String returns the name of the mode
+func (m Mode) String() string {
+	switch m {
+	case Exact:
+		return "exact"
+	case Subdomains:
+		return "subdomains"
+	case Wildcard:
+		return "wildcard"
+	case Registrable:
+		return "registrable"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (m Mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// Entry is a single blocklist entry
+type Entry struct {
+	// Domain is the listed domain, e.g. "ads.example.com"
+	Domain string `json:"domain"`
+
+	// Action is Block or Allow
+	Action Action `json:"action"`
+
+	// Mode selects the hosts the entry matches
+	Mode Mode `json:"mode"`
+
+	// Tags label the entry, e.g. the list it came from or a category
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Entry flags stored for each domain in a Matcher; the flags of exception
+// entries are the block flags shifted left by allowShift
+const (
+	blockExact uint8 = 1 << iota
+	blockSubdomains
+	blockWildcard
+
+	allowShift = 3
+)
+
+// modeFlag returns the block flag for a mode
+func modeFlag(m Mode) uint8 {
+	switch m {
+	case Subdomains, Registrable:
+		return blockSubdomains
+	case Wildcard:
+		return blockWildcard
+	}
+	return blockExact
+}
+
+// pending is a domain collected by a Builder
+type pending struct {
+	flags     uint8
+	blockTags []string
+	allowTags []string
+}
+
+// Builder collects entries and compiles them into a Matcher. A Builder is
+// not safe for concurrent use.
+type Builder struct {
+	fqdn    *gotld.FQDN
+	entries map[string]*pending
+}
+
+// NewBuilder creates an empty Builder. The manager resolves Registrable
+// entries; nil uses the global manager.
+func NewBuilder(f *gotld.FQDN) *Builder {
+	return &Builder{fqdn: f, entries: make(map[string]*pending)}
+}
+
+// Len returns the number of distinct domains collected
+func (b *Builder) Len() int {
+	return len(b.entries)
+}
+
+// Add records an entry. Entries for the same domain are merged, with the
+// union of their tags per action.
+func (b *Builder) Add(e Entry) error {
+	domain, ok := normalize(e.Domain)
+	if !ok || gotld.ValidateHostname(domain, gotld.HostnameService) != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidEntry, e.Domain)
+	}
+
+	if e.Mode == Registrable {
+		registrable, err := b.registrable(domain)
+		if err != nil {
+			return fmt.Errorf("%w: %q: %w", ErrInvalidEntry, e.Domain, err)
+		}
+		domain = registrable
+	}
+
+	p := b.entries[domain]
+	if p == nil {
+		p = &pending{}
+		b.entries[domain] = p
+	}
+
+	flag := modeFlag(e.Mode)
+	if e.Action == Allow {
+		p.flags |= flag << allowShift
+		p.allowTags = mergeTags(p.allowTags, e.Tags)
+	} else {
+		p.flags |= flag
+		p.blockTags = mergeTags(p.blockTags, e.Tags)
+	}
+
+	return nil
+}
+
+// registrable returns the registrable domain of domain
+func (b *Builder) registrable(domain string) (string, error) {
+	if b.fqdn == nil {
+		f, err := gotld.Manager()
+		if err != nil {
+			return "", err
+		}
+		b.fqdn = f
+	}
+
+	// The list stores Unicode forms, the matcher ASCII ones
+	unicode, err := idna.Lookup.ToUnicode(domain)
+	if err != nil {
+		return "", err
+	}
+
+	registrable, err := b.fqdn.GetFQDN(unicode)
+	if err != nil {
+		return "", err
+	}
+
+	return idna.Lookup.ToASCII(registrable)
+}
+
+// mergeTags adds the tags missing from dst
+func mergeTags(dst, tags []string) []string {
+	for _, tag := range tags {
+		if !slices.Contains(dst, tag) {
+			dst = append(dst, tag)
+		}
+	}
+	return dst
+}
+
+// Build compiles the collected entries into a Matcher. The Builder can
+// keep collecting entries for another Matcher afterwards.
+func (b *Builder) Build() *Matcher {
+	domains := make([]string, 0, len(b.entries))
+	size := 0
+	for d := range b.entries {
+		domains = append(domains, d)
+		size += len(d)
+	}
+	sort.Strings(domains)
+
+	m := &Matcher{
+		offsets:   make([]uint32, 0, len(domains)+1),
+		flags:     make([]uint8, 0, len(domains)),
+		blockTags: make([]uint32, 0, len(domains)),
+		allowTags: make([]uint32, 0, len(domains)),
+		tagSets:   [][]string{nil},
+	}
+
+	// Tag sets are interned, as large lists repeat a handful of them
+	sets := map[string]uint32{"": 0}
+	intern := func(tags []string) uint32 {
+		tags = slices.Clone(tags)
+		sort.Strings(tags)
+		key := strings.Join(tags, "\x00")
+		id, ok := sets[key]
+		if !ok {
+			id = uint32(len(m.tagSets))
+			sets[key] = id
+			m.tagSets = append(m.tagSets, tags)
+		}
+		return id
+	}
+
+	var sb strings.Builder
+	sb.Grow(size)
+	for _, d := range domains {
+		p := b.entries[d]
+		m.offsets = append(m.offsets, uint32(sb.Len()))
+		m.flags = append(m.flags, p.flags)
+		m.blockTags = append(m.blockTags, intern(p.blockTags))
+		m.allowTags = append(m.allowTags, intern(p.allowTags))
+		sb.WriteString(d)
+	}
+	m.offsets = append(m.offsets, uint32(sb.Len()))
+	m.text = sb.String()
+
+	return m
+}
+
+// Result is the outcome of matching a host
+type Result struct {
+	// Blocked is true when a block entry matches and no exception does
+	Blocked bool `json:"blocked"`
+
+	// Domain is the listed domain that decided the result; empty when no
+	// entry matches
+	Domain string `json:"domain,omitempty"`
+
+	// Action is the action of the deciding entry
+	Action Action `json:"action"`
+
+	// Mode is the mode through which the deciding entry matched
+	Mode Mode `json:"mode"`
+
+	// Tags are the tags of the deciding entry
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Matcher is an immutable, compiled blocklist, safe for concurrent use
+type Matcher struct {
+	text      string
+	offsets   []uint32
+	flags     []uint8
+	blockTags []uint32
+	allowTags []uint32
+	tagSets   [][]string
+}
+
+// Len returns the number of distinct domains in the matcher
+func (m *Matcher) Len() int {
+	return len(m.flags)
+}
+
+// Size returns the approximate memory used by the matcher in bytes
+func (m *Matcher) Size() int {
+	size := len(m.text) + 4*len(m.offsets) + len(m.flags) + 4*len(m.blockTags) + 4*len(m.allowTags)
+	for _, tags := range m.tagSets {
+		for _, tag := range tags {
+			size += len(tag)
+		}
+	}
+	return size
+}
+
+// key returns the domain stored at index i
+func (m *Matcher) key(i int) string {
+	return m.text[m.offsets[i]:m.offsets[i+1]]
+}
+
+// find returns the index of an exact domain
+func (m *Matcher) find(s string) (int, bool) {
+	// sort.Search would allocate a closure per call on this hot path
+	lo, hi := 0, len(m.flags)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if m.key(mid) < s {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(m.flags) && m.key(lo) == s
+}
+
+// Match checks host and its parents. An exception matching the host or
+// any parent allows it; otherwise the most specific block entry decides.
+// Hosts are compared case-insensitively in ASCII (punycode) form.
+func (m *Matcher) Match(host string) Result {
+	host, ok := normalize(host)
+	if !ok {
+		return Result{}
+	}
+
+	var (
+		res     Result
+		blocked bool
+	)
+
+	for name, sub := host, false; ; sub = true {
+		if i, ok := m.find(name); ok {
+			flags := m.flags[i]
+			if mode, ok := applies(flags>>allowShift, sub); ok {
+				return Result{Domain: name, Action: Allow, Mode: mode, Tags: m.tagSets[m.allowTags[i]]}
+			}
+			if !blocked {
+				if mode, ok := applies(flags, sub); ok {
+					blocked = true
+					res = Result{Blocked: true, Domain: name, Action: Block, Mode: mode, Tags: m.tagSets[m.blockTags[i]]}
+				}
+			}
+		}
+
+		dot := strings.IndexByte(name, '.')
+		if dot < 0 {
+			return res
+		}
+		name = name[dot+1:]
+	}
+}
+
+// Blocked reports whether host is blocked
+func (m *Matcher) Blocked(host string) bool {
+	return m.Match(host).Blocked
+}
+
+// applies returns the mode through which block flags match a host that is
+// the domain itself, or a subdomain of it when sub is set
+func applies(flags uint8, sub bool) (Mode, bool) {
+	switch {
+	case !sub && flags&blockExact != 0:
+		return Exact, true
+	case flags&blockSubdomains != 0:
+		return Subdomains, true
+	case sub && flags&blockWildcard != 0:
+		return Wildcard, true
+	}
+	return Exact, false
+}
+
+// normalize returns the lowercase ASCII form of a domain without a
+// trailing dot
+func normalize(s string) (string, bool) {
+	s = strings.TrimSuffix(strings.ToLower(s), ".")
+	if s == "" {
+		return "", false
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii, err := idna.Lookup.ToASCII(s)
+			return ascii, err == nil
+		}
+	}
+	return s, true
+}
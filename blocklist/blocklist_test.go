@@ -0,0 +1,264 @@
+// file: blocklist/blocklist_test.go
+// description: tests and benchmarks for the blocklist matcher
+
+package blocklist
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// newTestBuilder returns a Builder with the built-in list
+func newTestBuilder(t testing.TB) *Builder {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("gotld.New() error = %v", err)
+	}
+	return NewBuilder(f)
+}
+
+// TestMatch tests modes, exceptions and precedence
+func TestMatch(t *testing.T) {
+	b := newTestBuilder(t)
+
+	entries := []Entry{
+		{Domain: "ads.example.com", Mode: Exact, Tags: []string{"ads"}},
+		{Domain: "tracker.net", Mode: Subdomains, Tags: []string{"tracking"}},
+		{Domain: "tracker.net", Mode: Subdomains, Tags: []string{"privacy"}},
+		{Domain: "ok.tracker.net", Action: Allow, Mode: Subdomains, Tags: []string{"reviewed"}},
+		{Domain: "cdn.org", Mode: Wildcard},
+		{Domain: "www.evil.co.uk", Mode: Registrable, Tags: []string{"malware"}},
+		{Domain: "Bücher.example", Mode: Exact},
+		{Domain: "deep.a.b.example.com", Mode: Subdomains, Tags: []string{"deep"}},
+		{Domain: "b.example.com", Mode: Subdomains, Tags: []string{"parent"}},
+		{Domain: "a.b.xn--55qx5d.cn", Mode: Registrable, Tags: []string{"idn"}},
+	}
+	for _, e := range entries {
+		if err := b.Add(e); err != nil {
+			t.Fatalf("Add(%+v) error = %v", e, err)
+		}
+	}
+	m := b.Build()
+
+	tests := []struct {
+		host    string
+		blocked bool
+		domain  string
+		action  Action
+		mode    Mode
+		tags    []string
+	}{
+		{"ads.example.com", true, "ads.example.com", Block, Exact, []string{"ads"}},
+		{"ADS.Example.COM.", true, "ads.example.com", Block, Exact, []string{"ads"}},
+		{"x.ads.example.com", false, "", Block, Exact, nil},
+		{"example.com", false, "", Block, Exact, nil},
+		{"tracker.net", true, "tracker.net", Block, Subdomains, []string{"privacy", "tracking"}},
+		{"a.b.tracker.net", true, "tracker.net", Block, Subdomains, []string{"privacy", "tracking"}},
+		{"ok.tracker.net", false, "ok.tracker.net", Allow, Subdomains, []string{"reviewed"}},
+		{"x.ok.tracker.net", false, "ok.tracker.net", Allow, Subdomains, []string{"reviewed"}},
+		{"cdn.org", false, "", Block, Exact, nil},
+		{"img.cdn.org", true, "cdn.org", Block, Wildcard, nil},
+		{"evil.co.uk", true, "evil.co.uk", Block, Subdomains, []string{"malware"}},
+		{"mail.evil.co.uk", true, "evil.co.uk", Block, Subdomains, []string{"malware"}},
+		{"co.uk", false, "", Block, Exact, nil},
+		{"xn--bcher-kva.example", true, "xn--bcher-kva.example", Block, Exact, nil},
+		{"bücher.example", true, "xn--bcher-kva.example", Block, Exact, nil},
+		{"x.deep.a.b.example.com", true, "deep.a.b.example.com", Block, Subdomains, []string{"deep"}},
+		{"c.b.example.com", true, "b.example.com", Block, Subdomains, []string{"parent"}},
+		{"b.xn--55qx5d.cn", true, "b.xn--55qx5d.cn", Block, Subdomains, []string{"idn"}},
+		{"x.b.公司.cn", true, "b.xn--55qx5d.cn", Block, Subdomains, []string{"idn"}},
+		{"c.xn--55qx5d.cn", false, "", Block, Exact, nil},
+		{"", false, "", Block, Exact, nil},
+	}
+
+	for _, tt := range tests {
+		res := m.Match(tt.host)
+		if res.Blocked != tt.blocked || res.Domain != tt.domain || res.Action != tt.action || res.Mode != tt.mode || !slices.Equal(res.Tags, tt.tags) {
+			t.Errorf("Match(%q) = %+v, want blocked %v domain %q %v %v %v", tt.host, res, tt.blocked, tt.domain, tt.action, tt.mode, tt.tags)
+		}
+		if m.Blocked(tt.host) != tt.blocked {
+			t.Errorf("Blocked(%q) = %v", tt.host, !tt.blocked)
+		}
+	}
+
+	if m.Len() != 9 || b.Len() != 9 {
+		t.Errorf("Len() = %d, %d, want 9", m.Len(), b.Len())
+	}
+
+	for _, bad := range []Entry{{Domain: ""}, {Domain: "bad domain.com"}, {Domain: "*.example.com"}, {Domain: "co.uk", Mode: Registrable}, {Domain: "xn--55qx5d.cn", Mode: Registrable}} {
+		if err := b.Add(bad); !errors.Is(err, ErrInvalidEntry) {
+			t.Errorf("Add(%q) error = %v, want ErrInvalidEntry", bad.Domain, err)
+		}
+	}
+}
+
+// TestImport tests the list format importers
+func TestImport(t *testing.T) {
+	b := newTestBuilder(t)
+
+	hosts := `# Hosts file
+127.0.0.1 localhost
+::1 ip6-localhost ip6-loopback
+0.0.0.0 0.0.0.0
+0.0.0.0 ads.example.com banner.example.com # inline comment
+0.0.0.0 bad_host!.com
+`
+	if n, err := b.ImportHosts(strings.NewReader(hosts), "hosts"); err != nil || n != 1 {
+		t.Errorf("ImportHosts() = %d, %v, want 1 line", n, err)
+	}
+
+	adblock := `[Adblock Plus 2.0]
+! Title: test
+||tracker.net^
+||important.net^$important
+@@||ok.tracker.net^
+||thirdparty.net^$third-party
+||example.org/ads/*
+example.net##.banner
+`
+	if n, err := b.ImportAdblock(strings.NewReader(adblock), "adblock"); err != nil || n != 3 {
+		t.Errorf("ImportAdblock() = %d, %v, want 3", n, err)
+	}
+
+	domains := `# plain list
+malware.example
+phish.example # comment
+
+`
+	if n, err := b.ImportDomains(strings.NewReader(domains), Subdomains, "domains"); err != nil || n != 2 {
+		t.Errorf("ImportDomains() = %d, %v, want 2", n, err)
+	}
+
+	rpz := `$TTL 300
+$ORIGIN rpz.local.
+@ IN SOA ns.rpz.local. admin.rpz.local. (
+	1 3600 600 86400 300 )
+@ IN NS ns.rpz.local.
+nx.example CNAME .
+*.wild.example 300 IN CNAME .
+pass.wild.example CNAME rpz-passthru.
+fqdn.example.rpz.local. CNAME .
+other.zone. CNAME .
+local.example A 127.0.0.1
+32.1.0.0.10.rpz-ip CNAME .
+`
+	if n, err := b.ImportRPZ(strings.NewReader(rpz), "rpz"); err != nil || n != 5 {
+		t.Errorf("ImportRPZ() = %d, %v, want 5", n, err)
+	}
+
+	m := b.Build()
+	tests := []struct {
+		host    string
+		blocked bool
+		tag     string
+	}{
+		{"localhost", false, ""},
+		{"ads.example.com", true, "hosts"},
+		{"banner.example.com", true, "hosts"},
+		{"x.ads.example.com", false, ""},
+		{"www.tracker.net", true, "adblock"},
+		{"important.net", true, "adblock"},
+		{"ok.tracker.net", false, "adblock"},
+		{"thirdparty.net", false, ""},
+		{"example.org", false, ""},
+		{"www.malware.example", true, "domains"},
+		{"nx.example", true, "rpz"},
+		{"wild.example", false, ""},
+		{"a.wild.example", true, "rpz"},
+		{"pass.wild.example", false, "rpz"},
+		{"fqdn.example", true, "rpz"},
+		{"other.zone", false, ""},
+		{"local.example", true, "rpz"},
+	}
+
+	for _, tt := range tests {
+		res := m.Match(tt.host)
+		if res.Blocked != tt.blocked || (tt.tag != "" && !slices.Contains(res.Tags, tt.tag)) {
+			t.Errorf("Match(%q) = %+v, want blocked %v tag %q", tt.host, res, tt.blocked, tt.tag)
+		}
+	}
+}
+
+// benchmarkEntries is the size of the benchmark lists
+const benchmarkEntries = 1_000_000
+
+// buildLarge builds a matcher with n synthetic entries across several
+// lists and tags
+func buildLarge(b testing.TB, n int) *Matcher {
+	builder := NewBuilder(nil)
+	tags := [][]string{{"ads"}, {"tracking"}, {"malware"}, {"ads", "tracking"}}
+
+	for i := 0; i < n; i++ {
+		e := Entry{Domain: fmt.Sprintf("host%d.domain%d.com", i, i%50000), Mode: Mode(i % 3), Tags: tags[i%len(tags)]}
+		if i%100 == 0 {
+			e.Action = Allow
+		}
+		if err := builder.Add(e); err != nil {
+			b.Fatalf("Add() error = %v", err)
+		}
+	}
+
+	return builder.Build()
+}
+
+// BenchmarkBuild measures compiling a list of 1M entries and reports the
+// memory retained by the matcher
+func BenchmarkBuild(b *testing.B) {
+	var m *Matcher
+	for i := 0; i < b.N; i++ {
+		m = buildLarge(b, benchmarkEntries)
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	m = buildLarge(b, benchmarkEntries)
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+
+	b.ReportMetric(float64(m.Size())/float64(m.Len()), "bytes/entry")
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/float64(m.Len()), "heap-bytes/entry")
+	runtime.KeepAlive(m)
+}
+
+// BenchmarkMatch measures lookups against a list of 1M entries
+func BenchmarkMatch(b *testing.B) {
+	m := buildLarge(b, benchmarkEntries)
+
+	hosts := map[string]string{
+		"Hit":     "host12345.domain12345.com",
+		"Parent":  "a.b.c.host12346.domain12346.com",
+		"Miss":    "www.example.org",
+		"Deep":    "a.b.c.d.e.f.g.h.example.com",
+		"Unicode": "bücher.example",
+	}
+
+	for _, name := range []string{"Hit", "Parent", "Miss", "Deep", "Unicode"} {
+		host := hosts[name]
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				m.Match(host)
+			}
+		})
+	}
+
+	b.Run("Parallel", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				m.Match(fmt.Sprintf("host%d.domain%d.com", i, i%50000))
+				i++
+			}
+		})
+	})
+}
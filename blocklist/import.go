@@ -0,0 +1,206 @@
+// file: blocklist/import.go
+// description: importers for hosts files, AdBlock filters, domain lists and RPZ zones
+
+package blocklist
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// maxLineLength bounds the lines read by the importers
+const maxLineLength = 64 * 1024
+
+// hostsAliases are local names found in hosts files that are never blocked
+var hostsAliases = map[string]bool{
+	"localhost":             true,
+	"localhost.localdomain": true,
+	"local":                 true,
+	"broadcasthost":         true,
+	"ip6-localhost":         true,
+	"ip6-loopback":          true,
+	"ip6-localnet":          true,
+	"ip6-mcastprefix":       true,
+	"ip6-allnodes":          true,
+	"ip6-allrouters":        true,
+	"ip6-allhosts":          true,
+	"0.0.0.0":               true,
+}
+
+// scanLines calls fn for each line of r with comments after marker and
+// surrounding whitespace removed, skipping empty lines. It returns the
+// number of lines for which fn returned true.
+func scanLines(r io.Reader, marker string, fn func(line string) bool) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), maxLineLength)
+
+	added := 0
+	for scanner.Scan() {
+		line := scanner.Text()
+		if marker != "" {
+			if i := strings.Index(line, marker); i >= 0 {
+				line = line[:i]
+			}
+		}
+
+		line = strings.TrimSpace(line)
+		if line != "" && fn(line) {
+			added++
+		}
+	}
+
+	return added, scanner.Err()
+}
+
+// ImportHosts reads a hosts file such as "0.0.0.0 ads.example.com" and adds
+// an Exact block entry for each name. Local aliases such as "localhost"
+// and invalid names are skipped. It returns the number of entries added.
+func (b *Builder) ImportHosts(r io.Reader, tags ...string) (int, error) {
+	return scanLines(r, "#", func(line string) bool {
+		fields := strings.Fields(line)
+
+		added := false
+		for _, name := range fields[1:] {
+			if hostsAliases[strings.ToLower(name)] {
+				continue
+			}
+			if b.Add(Entry{Domain: name, Action: Block, Mode: Exact, Tags: tags}) == nil {
+				added = true
+			}
+		}
+		return added
+	})
+}
+
+// ImportDomains reads a plain list with one domain per line and "#"
+// comments, adding block entries with the given mode. It returns the
+// number of entries added.
+func (b *Builder) ImportDomains(r io.Reader, mode Mode, tags ...string) (int, error) {
+	return scanLines(r, "#", func(line string) bool {
+		return b.Add(Entry{Domain: strings.Fields(line)[0], Action: Block, Mode: mode, Tags: tags}) == nil
+	})
+}
+
+// ImportAdblock reads AdBlock-style filters, adding a Subdomains block
+// entry for each "||example.com^" rule and an exception for each
+// "@@||example.com^" rule. Rules with paths, wildcards or options other
+// than "$important" cannot be expressed per domain and are skipped, as
+// are "!" comments and "[Adblock Plus]" headers. It returns the number of
+// entries added.
+func (b *Builder) ImportAdblock(r io.Reader, tags ...string) (int, error) {
+	return scanLines(r, "", func(line string) bool {
+		if line[0] == '!' || line[0] == '[' {
+			return false
+		}
+
+		action := Block
+		if rest, ok := strings.CutPrefix(line, "@@"); ok {
+			action, line = Allow, rest
+		}
+
+		domain, ok := strings.CutPrefix(line, "||")
+		if !ok {
+			return false
+		}
+
+		domain, options, _ := strings.Cut(domain, "$")
+		if options != "" && options != "important" {
+			return false
+		}
+
+		domain, ok = strings.CutSuffix(domain, "^")
+		if !ok {
+			return false
+		}
+
+		return b.Add(Entry{Domain: domain, Action: action, Mode: Subdomains, Tags: tags}) == nil
+	})
+}
+
+// ImportRPZ reads a DNS response policy zone. QNAME triggers become
+// entries: "example.com CNAME ." is an Exact block, "*.example.com CNAME ."
+// a Wildcard block, and "rpz-passthru." targets are exceptions. Owner
+// names are taken relative to $ORIGIN. Other triggers, such as rpz-ip,
+// and the zone's SOA and NS records are skipped. It returns the number of
+// entries added.
+func (b *Builder) ImportRPZ(r io.Reader, tags ...string) (int, error) {
+	origin := ""
+	inParens := false
+
+	return scanLines(r, ";", func(line string) bool {
+		// Skip the continuation lines of multi-line records such as the SOA
+		if inParens {
+			inParens = !strings.Contains(line, ")")
+			return false
+		}
+		if strings.Contains(line, "(") && !strings.Contains(line, ")") {
+			inParens = true
+		}
+
+		fields := strings.Fields(line)
+		if fields[0] == "$ORIGIN" && len(fields) > 1 {
+			origin = strings.ToLower(strings.TrimSuffix(fields[1], "."))
+			return false
+		}
+		if strings.HasPrefix(fields[0], "$") || fields[0] == "@" {
+			return false
+		}
+
+		// owner [ttl] [class] type rdata
+		rtype, rdata := "", ""
+		for i := 1; i < len(fields); i++ {
+			f := strings.ToUpper(fields[i])
+			if f == "IN" || isTTL(f) {
+				continue
+			}
+			rtype = f
+			if i+1 < len(fields) {
+				rdata = strings.ToLower(fields[i+1])
+			}
+			break
+		}
+		if rtype == "" || rtype == "SOA" || rtype == "NS" {
+			return false
+		}
+
+		owner := strings.ToLower(fields[0])
+		if name, ok := strings.CutSuffix(owner, "."); ok {
+			// Fully qualified owners include the zone name
+			owner, ok = strings.CutSuffix(name, "."+origin)
+			if !ok || origin == "" {
+				return false
+			}
+		}
+		if strings.Contains(owner, ".rpz-") {
+			return false
+		}
+
+		action := Block
+		if rtype == "CNAME" && rdata == "rpz-passthru." {
+			action = Allow
+		}
+
+		mode := Exact
+		if name, ok := strings.CutPrefix(owner, "*."); ok {
+			mode, owner = Wildcard, name
+		}
+
+		return b.Add(Entry{Domain: owner, Action: action, Mode: mode, Tags: tags}) == nil
+	})
+}
+
+// isTTL reports whether s is a numeric TTL or one with units, such as "1h"
+func isTTL(s string) bool {
+	if s == "" || s[0] < '0' || s[0] > '9' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case '0' <= c && c <= '9', c == 'S', c == 'M', c == 'H', c == 'D', c == 'W':
+		default:
+			return false
+		}
+	}
+	return true
+}
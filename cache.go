@@ -0,0 +1,154 @@
+// file: cache.go
+// description: bounded LRU cache of host lookup results
+
+package gotld
+
+import (
+	"container/list"
+	"sync"
+)
+
+// CacheStats reports the activity of the lookup cache
+type CacheStats struct {
+	// Hits is the number of lookups answered from the cache
+	Hits uint64
+
+	// Misses is the number of lookups that had to consult the rules
+	Misses uint64
+
+	// Evictions is the number of entries dropped to stay within Capacity
+	Evictions uint64
+
+	// Invalidations is the number of times the cache was cleared because
+	// a new list was loaded
+	Invalidations uint64
+
+	// Size is the current number of entries
+	Size int
+
+	// Capacity is the maximum number of entries; zero when disabled
+	Capacity int
+}
+
+// HitRatio returns the fraction of lookups answered from the cache
+func (s CacheStats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// cacheEntry is the cached outcome of splitting a host
+type cacheEntry struct {
+	host        string
+	eTLD        string
+	registrable string
+	err         error
+}
+
+// lruCache is a concurrency-safe, size-bounded cache of lookup results
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+	gen      uint64
+	stats    CacheStats
+}
+
+// newLRUCache creates a cache holding up to capacity entries, or nil when
+// capacity is not positive
+func newLRUCache(capacity int) *lruCache {
+	if capacity <= 0 {
+		return nil
+	}
+
+	return &lruCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element, capacity),
+	}
+}
+
+// get returns the cached entry for host together with the current
+// generation, which must be passed to put
+func (c *lruCache) get(host string) (cacheEntry, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[host]; ok {
+		c.order.MoveToFront(el)
+		c.stats.Hits++
+		return *el.Value.(*cacheEntry), c.gen, true
+	}
+
+	c.stats.Misses++
+	return cacheEntry{}, c.gen, false
+}
+
+// put stores an entry computed during generation gen. Entries computed
+// before the last purge are dropped so stale results never reappear.
+func (c *lruCache) put(entry cacheEntry, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	if el, ok := c.entries[entry.host]; ok {
+		*el.Value.(*cacheEntry) = entry
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[entry.host] = c.order.PushFront(&entry)
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).host)
+		c.stats.Evictions++
+	}
+}
+
+// purge drops every entry and starts a new generation
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.order.Init()
+	clear(c.entries)
+	c.gen++
+	c.stats.Invalidations++
+}
+
+// snapshot returns the current statistics
+func (c *lruCache) snapshot() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Size = c.order.Len()
+	stats.Capacity = c.capacity
+	return stats
+}
+
+// CacheStats returns the lookup cache statistics; all zero when the cache
+// is disabled
+func (f *FQDN) CacheStats() CacheStats {
+	if f.cache == nil {
+		return CacheStats{}
+	}
+	return f.cache.snapshot()
+}
+
+// PurgeCache clears the lookup cache. It only needs to be called after
+// changing Options that affect lookups, such as AllowPrivateTLDs; loading
+// a new list clears the cache automatically.
+func (f *FQDN) PurgeCache() {
+	if f.cache != nil {
+		f.cache.purge()
+	}
+}
@@ -0,0 +1,186 @@
+// file: cache_test.go
+// description: tests and benchmarks for the lookup cache
+
+package gotld
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestCache tests hits, misses and eviction of the lookup cache
+func TestCache(t *testing.T) {
+	fqdn, err := newFQDN(&Options{CacheSize: 2})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	for _, host := range []string{"www.example.com", "https://WWW.example.com/path", "www.example.com:443"} {
+		got, err := fqdn.GetFQDN(host)
+		if err != nil || got != "example.com" {
+			t.Errorf("GetFQDN(%q) = %v, %v, want example.com", host, got, err)
+		}
+	}
+
+	// Errors are cached as well
+	for i := 0; i < 2; i++ {
+		if _, err := fqdn.GetFQDN("example.invalidtld"); !errors.Is(err, ErrInvalidTLD) {
+			t.Errorf("GetFQDN() error = %v, want %v", err, ErrInvalidTLD)
+		}
+	}
+
+	stats := fqdn.CacheStats()
+	if stats.Hits != 3 || stats.Misses != 2 || stats.Size != 2 || stats.Capacity != 2 {
+		t.Errorf("CacheStats() = %+v, want 3 hits, 2 misses, size 2", stats)
+	}
+
+	// A third host evicts the least recently used one, www.example.com,
+	// which then misses and evicts example.invalidtld in turn
+	_, _ = fqdn.GetFQDN("example.org")
+	_, _ = fqdn.GetFQDN("www.example.com")
+
+	stats = fqdn.CacheStats()
+	if stats.Evictions != 2 || stats.Misses != 4 {
+		t.Errorf("CacheStats() = %+v, want 2 evictions, 4 misses", stats)
+	}
+
+	if ratio := stats.HitRatio(); ratio <= 0 || ratio >= 1 {
+		t.Errorf("HitRatio() = %v", ratio)
+	}
+
+	// Disabled caches report nothing
+	plain, _ := newFQDN(&Options{})
+	_, _ = plain.GetFQDN("www.example.com")
+	if stats := plain.CacheStats(); stats != (CacheStats{}) {
+		t.Errorf("CacheStats() = %+v, want zero", stats)
+	}
+}
+
+// TestCacheReload tests that loading a new list invalidates the cache
+func TestCacheReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.dat")
+	if err := os.WriteFile(path, []byte(testList), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	fqdn, err := newFQDN(&Options{PublicSuffixFile: path, CacheSize: 16})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	if got, _ := fqdn.GetFQDN("a.b.example.co.uk"); got != "example.co.uk" {
+		t.Fatalf("GetFQDN() = %q, want example.co.uk", got)
+	}
+
+	// Make example.co.uk a public suffix and reload
+	updated := strings.Replace(testList, "co.uk\n", "co.uk\nexample.co.uk\n", 1)
+	if err := os.WriteFile(path, []byte(updated), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	if err := fqdn.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	if got, _ := fqdn.GetFQDN("a.b.example.co.uk"); got != "b.example.co.uk" {
+		t.Errorf("GetFQDN() after reload = %q, want b.example.co.uk", got)
+	}
+
+	if stats := fqdn.CacheStats(); stats.Invalidations == 0 || stats.Hits != 0 {
+		t.Errorf("CacheStats() = %+v, want an invalidation and no hits", stats)
+	}
+}
+
+// TestCacheConcurrent tests the cache under concurrent access
+func TestCacheConcurrent(t *testing.T) {
+	fqdn, err := newFQDN(&Options{CacheSize: 8})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				host := fmt.Sprintf("h%d.example%d.com", j%4, (i+j)%16)
+				want := fmt.Sprintf("example%d.com", (i+j)%16)
+				if got, err := fqdn.GetFQDN(host); err != nil || got != want {
+					t.Errorf("GetFQDN(%q) = %v, %v, want %v", host, got, err, want)
+					return
+				}
+				if j%50 == 0 {
+					fqdn.PurgeCache()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if stats := fqdn.CacheStats(); stats.Size > stats.Capacity {
+		t.Errorf("Size = %d exceeds Capacity = %d", stats.Size, stats.Capacity)
+	}
+}
+
+// benchmarkHosts returns n distinct hosts
+func benchmarkHosts(n int) []string {
+	hosts := make([]string, n)
+	for i := range hosts {
+		hosts[i] = fmt.Sprintf("www.host%d.example.co.uk", i)
+	}
+	return hosts
+}
+
+// benchmarkLookups runs GetFQDN over hosts with the given cache size
+func benchmarkLookups(b *testing.B, cacheSize int, hosts []string) {
+	fqdn, err := newFQDN(&Options{CacheSize: cacheSize})
+	if err != nil {
+		b.Fatalf("newFQDN() error = %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = fqdn.GetFQDN(hosts[i%len(hosts)])
+	}
+}
+
+// BenchmarkLookupUncached measures lookups of hot hosts without a cache
+func BenchmarkLookupUncached(b *testing.B) {
+	benchmarkLookups(b, 0, benchmarkHosts(1000))
+}
+
+// BenchmarkLookupCachedHot measures lookups of hot hosts that fit the cache
+func BenchmarkLookupCachedHot(b *testing.B) {
+	benchmarkLookups(b, 4096, benchmarkHosts(1000))
+}
+
+// BenchmarkLookupCachedCold measures lookups that always miss the cache
+func BenchmarkLookupCachedCold(b *testing.B) {
+	benchmarkLookups(b, 100, benchmarkHosts(1000))
+}
+
+// BenchmarkLookupCachedParallel measures concurrent lookups of hot hosts
+func BenchmarkLookupCachedParallel(b *testing.B) {
+	fqdn, err := newFQDN(&Options{CacheSize: 4096})
+	if err != nil {
+		b.Fatalf("newFQDN() error = %v", err)
+	}
+	hosts := benchmarkHosts(1000)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			_, _ = fqdn.GetFQDN(hosts[i%len(hosts)])
+			i++
+		}
+	})
+}
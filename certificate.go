@@ -0,0 +1,188 @@
+// file: certificate.go
+// description: RFC 6125 certificate name matching with public suffix checks
+
+package gotld
+
+import (
+	"crypto/x509"
+	"net"
+	"strings"
+)
+
+// CertificateIssue is a certificate name that failed an audit
+type CertificateIssue struct {
+	// Name is the offending subject alternative name
+	Name string
+
+	// Err describes the problem and matches ErrInvalidWildcard,
+	// ErrBroadWildcard, ErrPublicSuffixName or ErrInvalidHostname
+	Err error
+}
+
+// MatchWildcard reports whether host matches the certificate name pattern
+// following RFC 6125 section 6.4.3 as applied by browsers: a wildcard must
+// be the entire left-most label and matches exactly one non-empty label.
+// Comparison is case-insensitive and ignores a trailing dot. No public
+// suffix checks are made; see FQDN.MatchCertificateName.
+func MatchWildcard(pattern, host string) bool {
+	pattern = strings.ToLower(strings.TrimSuffix(pattern, "."))
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if pattern == "" || host == "" {
+		return false
+	}
+
+	if !strings.Contains(pattern, "*") {
+		return pattern == host
+	}
+
+	base, ok := wildcardBase(pattern)
+	if !ok {
+		return false
+	}
+
+	// The wildcard covers exactly one label
+	label, rest, found := strings.Cut(host, ".")
+	return found && label != "" && rest == base
+}
+
+// wildcardBase returns the name below a "*." pattern, rejecting wildcards
+// anywhere but as the whole left-most label
+func wildcardBase(pattern string) (string, bool) {
+	base, ok := strings.CutPrefix(pattern, "*.")
+	if !ok || base == "" || strings.Contains(base, "*") {
+		return "", false
+	}
+	return base, true
+}
+
+// MatchCertificateName reports whether host matches the certificate name
+// pattern like MatchWildcard, but never lets a wildcard cover a public
+// suffix: "*.co.uk" and "*.github.io" match nothing. Private suffixes are
+// always considered, whatever AllowPrivateTLDs is set to.
+func (f *FQDN) MatchCertificateName(pattern, host string) bool {
+	if !MatchWildcard(pattern, host) {
+		return false
+	}
+
+	if base, ok := wildcardBase(strings.ToLower(strings.TrimSuffix(pattern, "."))); ok {
+		return !f.coversPublicSuffix(base)
+	}
+
+	return true
+}
+
+// MatchCertificate reports whether cert is valid for host by its subject
+// alternative names. DNS names are matched with MatchCertificateName and IP
+// addresses exactly; the legacy common name is ignored.
+func (f *FQDN) MatchCertificate(cert *x509.Certificate, host string) bool {
+	if ip := net.ParseIP(strings.Trim(host, "[]")); ip != nil {
+		for _, addr := range cert.IPAddresses {
+			if addr.Equal(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, name := range cert.DNSNames {
+		if f.MatchCertificateName(name, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AuditCertificate checks the DNS subject alternative names of cert and
+// reports malformed wildcards, wildcards covering a public suffix, names
+// that are themselves public suffixes and names with invalid syntax. An
+// empty result means no issues were found.
+func (f *FQDN) AuditCertificate(cert *x509.Certificate) []CertificateIssue {
+	var issues []CertificateIssue
+
+	for _, name := range cert.DNSNames {
+		if err := f.CheckCertificateName(name); err != nil {
+			issues = append(issues, CertificateIssue{Name: name, Err: err})
+		}
+	}
+
+	return issues
+}
+
+// CheckCertificateName checks a single certificate DNS name as
+// AuditCertificate does, returning nil when it may be issued
+func (f *FQDN) CheckCertificateName(name string) error {
+	normalized := strings.ToLower(strings.TrimSuffix(name, "."))
+
+	if strings.Contains(normalized, "*") {
+		base, ok := wildcardBase(normalized)
+		if !ok {
+			return wrapError(ErrInvalidWildcard, "wildcard must be the entire left-most label")
+		}
+
+		if err := ValidateHostname(base, HostnameLDH); err != nil {
+			return err
+		}
+
+		unicode, err := suffixForm(base)
+		if err != nil {
+			return err
+		}
+
+		if f.coversPublicSuffix(unicode) {
+			return wrapError(ErrBroadWildcard, "covers every domain under "+base)
+		}
+
+		return nil
+	}
+
+	if err := ValidateHostname(normalized, HostnameLDH); err != nil {
+		return err
+	}
+
+	unicode, err := suffixForm(normalized)
+	if err != nil {
+		return err
+	}
+
+	if f.isPublicSuffix(unicode, true) {
+		return wrapError(ErrPublicSuffixName, normalized)
+	}
+
+	return nil
+}
+
+// suffixForm converts a validated LDH name to the Unicode form the suffix
+// table is keyed by, so punycode names are checked like their Unicode
+// spelling. Malformed punycode labels make the name invalid.
+func suffixForm(name string) (string, error) {
+	unicode, err := unicodeLabels(name)
+	if err != nil {
+		return "", &HostnameError{Host: name, Index: -1, Reason: err.Error()}
+	}
+	return unicode, nil
+}
+
+// isPublicSuffix reports whether name is a public suffix. Single labels
+// are public suffixes by the list's implicit "*" rule.
+func (f *FQDN) isPublicSuffix(name string, private bool) bool {
+	if !strings.Contains(name, ".") {
+		return true
+	}
+	return f.findSuffix(name, private) == name
+}
+
+// coversPublicSuffix reports whether a wildcard over base would match a
+// public suffix, either because base is one or because a wildcard rule
+// such as "*.kawasaki.jp" makes its children public suffixes
+func (f *FQDN) coversPublicSuffix(base string) bool {
+	if f.isPublicSuffix(base, true) {
+		return true
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.rules.match(tableForm(base), true)&ruleWildcard != 0
+}
@@ -0,0 +1,180 @@
+// file: certificate_test.go
+// description: tests for certificate name matching and auditing
+
+package gotld
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+// newTestCertificate creates a self-signed certificate for the given names
+func newTestCertificate(t *testing.T, names []string, ips []net.IP) *x509.Certificate {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     names,
+		IPAddresses:  ips,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	return cert
+}
+
+// TestMatchWildcard tests RFC 6125 wildcard rules
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{"www.example.com", "www.example.com", true},
+		{"WWW.Example.com.", "www.example.com", true},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "WWW.EXAMPLE.COM.", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", ".example.com", false},
+		{"www.*.com", "www.example.com", false},
+		{"w*.example.com", "www.example.com", false},
+		{"*.*.example.com", "a.b.example.com", false},
+		{"*", "com", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		if got := MatchWildcard(tt.pattern, tt.host); got != tt.want {
+			t.Errorf("MatchWildcard(%q, %q) = %v, want %v", tt.pattern, tt.host, got, tt.want)
+		}
+	}
+}
+
+// TestMatchCertificateName tests that wildcards never cover public suffixes
+func TestMatchCertificateName(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	tests := []struct {
+		pattern string
+		host    string
+		want    bool
+	}{
+		{"*.example.co.uk", "www.example.co.uk", true},
+		{"*.co.uk", "example.co.uk", false},
+		{"*.com", "example.com", false},
+		{"*.github.io", "user.github.io", false},
+		{"*.user.github.io", "docs.user.github.io", true},
+		{"*.kawasaki.jp", "foo.kawasaki.jp", false},
+		{"*.foo.kawasaki.jp", "bar.foo.kawasaki.jp", false},
+		{"*.city.kawasaki.jp", "www.city.kawasaki.jp", true},
+		{"example.co.uk", "example.co.uk", true},
+		{"*.xn--55qx5d.cn", "evil.xn--55qx5d.cn", false},
+		{"*.example.xn--55qx5d.cn", "www.example.xn--55qx5d.cn", true},
+	}
+
+	for _, tt := range tests {
+		if got := fqdn.MatchCertificateName(tt.pattern, tt.host); got != tt.want {
+			t.Errorf("MatchCertificateName(%q, %q) = %v, want %v", tt.pattern, tt.host, got, tt.want)
+		}
+	}
+}
+
+// TestMatchCertificate tests matching hosts against certificate SANs
+func TestMatchCertificate(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	cert := newTestCertificate(t, []string{"example.com", "*.example.com", "*.co.uk"}, []net.IP{net.ParseIP("192.0.2.1")})
+
+	tests := map[string]bool{
+		"example.com":       true,
+		"www.example.com":   true,
+		"a.b.example.com":   false,
+		"example.co.uk":     false,
+		"192.0.2.1":         true,
+		"[192.0.2.1]":       true,
+		"192.0.2.2":         false,
+		"www.example.co.uk": false,
+	}
+
+	for host, want := range tests {
+		if got := fqdn.MatchCertificate(cert, host); got != want {
+			t.Errorf("MatchCertificate(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+// TestAuditCertificate tests reporting offending certificate names
+func TestAuditCertificate(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	cert := newTestCertificate(t, []string{
+		"example.com",
+		"*.example.co.uk",
+		"*.co.uk",
+		"*.github.io",
+		"co.uk",
+		"www.*.example.com",
+		"bad_name.example.com",
+		"*.xn--55qx5d.cn",
+		"xn--55qx5d.cn",
+		"*.example.xn--55qx5d.cn",
+		"xn--zz.example.com",
+	}, nil)
+
+	want := []struct {
+		name string
+		err  error
+	}{
+		{"*.co.uk", ErrBroadWildcard},
+		{"*.github.io", ErrBroadWildcard},
+		{"co.uk", ErrPublicSuffixName},
+		{"www.*.example.com", ErrInvalidWildcard},
+		{"bad_name.example.com", ErrInvalidHostname},
+		{"*.xn--55qx5d.cn", ErrBroadWildcard},
+		{"xn--55qx5d.cn", ErrPublicSuffixName},
+		{"xn--zz.example.com", ErrInvalidHostname},
+	}
+
+	issues := fqdn.AuditCertificate(cert)
+	if len(issues) != len(want) {
+		t.Fatalf("AuditCertificate() = %v, want %d issues", issues, len(want))
+	}
+
+	for i, w := range want {
+		if issues[i].Name != w.name || !errors.Is(issues[i].Err, w.err) {
+			t.Errorf("issue %d = %v, want %s: %v", i, issues[i], w.name, w.err)
+		}
+	}
+}
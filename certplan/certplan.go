@@ -0,0 +1,248 @@
+// file: certplan/certplan.go
+// description: plans certificate issuance grouped by registrable domain
+
+// Package certplan groups host names into certificate requests. ACME
+// certificate authorities such as Let's Encrypt limit issuance per
+// registered domain, so names are grouped by registrable domain, siblings
+// are optionally consolidated into wildcards, and each group is split into
+// SAN bundles within the configured limits. Planning is purely
+// computational and performs no network access.
+//
+// Let's Encrypt counts registered domains using the whole public suffix
+// list, so create the manager with AllowPrivateTLDs to match it.
+package certplan
+
+import (
+	"errors"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// ErrIPAddress is reported for IP addresses, which are not planned
+var ErrIPAddress = errors.New("IP addresses are not supported")
+
+// Limits bounds the certificates proposed by a Planner
+type Limits struct {
+	// MaxNamesPerCert is the maximum number of SANs per certificate
+	MaxNamesPerCert int
+
+	// MaxCertsPerDomain is the number of certificates that may be issued
+	// per registrable domain in one window; further certificates are
+	// deferred. Zero means no limit.
+	MaxCertsPerDomain int
+
+	// WildcardThreshold replaces names sharing a parent with a wildcard
+	// once at least this many siblings are requested; zero disables
+	// consolidation. Explicitly requested wildcards always absorb the
+	// names they cover.
+	WildcardThreshold int
+}
+
+// DefaultLimits returns limits matching the Let's Encrypt production rate
+// limits, without wildcard consolidation
+func DefaultLimits() Limits {
+	return Limits{
+		MaxNamesPerCert:   100,
+		MaxCertsPerDomain: 50,
+		WildcardThreshold: 0,
+	}
+}
+
+// Certificate is a proposed certificate
+type Certificate struct {
+	// Names are the SANs to request
+	Names []string `json:"names"`
+
+	// Hosts are the requested host names this certificate covers
+	Hosts []string `json:"hosts"`
+}
+
+// DomainPlan holds the certificates of one registrable domain
+type DomainPlan struct {
+	// Domain is the registrable domain
+	Domain string `json:"domain"`
+
+	// Certificates fit within MaxCertsPerDomain
+	Certificates []Certificate `json:"certificates"`
+
+	// Deferred are the certificates beyond MaxCertsPerDomain
+	Deferred []Certificate `json:"deferred,omitempty"`
+}
+
+// Rejection is a host name that cannot be issued
+type Rejection struct {
+	// Name is the requested name
+	Name string `json:"name"`
+
+	// Reason describes Err
+	Reason string `json:"reason"`
+
+	// Err is the reason the name was rejected
+	Err error `json:"-"`
+}
+
+// Plan is the outcome of planning
+type Plan struct {
+	// Domains are ordered by registrable domain
+	Domains []DomainPlan `json:"domains"`
+
+	// Rejected are the names that cannot be issued, in input order
+	Rejected []Rejection `json:"rejected,omitempty"`
+}
+
+// Certificates returns the number of planned and deferred certificates
+func (p *Plan) Certificates() (planned, deferred int) {
+	for _, d := range p.Domains {
+		planned += len(d.Certificates)
+		deferred += len(d.Deferred)
+	}
+	return planned, deferred
+}
+
+// Planner proposes certificates for host names
+type Planner struct {
+	fqdn   *gotld.FQDN
+	limits Limits
+}
+
+// New creates a Planner using f to find registrable domains; a
+// MaxNamesPerCert of zero or less uses the default
+func New(f *gotld.FQDN, limits Limits) *Planner {
+	if limits.MaxNamesPerCert <= 0 {
+		limits.MaxNamesPerCert = DefaultLimits().MaxNamesPerCert
+	}
+	return &Planner{fqdn: f, limits: limits}
+}
+
+// Plan groups hosts by registrable domain and bundles them into
+// certificates. Duplicate names are planned once; wildcards such as
+// "*.example.com" may be requested directly.
+func (p *Planner) Plan(hosts []string) *Plan {
+	plan := &Plan{}
+	groups := make(map[string][]string)
+	seen := make(map[string]bool)
+
+	for _, host := range hosts {
+		name := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), "."))
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		domain, err := p.registrableDomain(name)
+		if err != nil {
+			plan.Rejected = append(plan.Rejected, Rejection{Name: host, Reason: err.Error(), Err: err})
+			continue
+		}
+		groups[domain] = append(groups[domain], name)
+	}
+
+	domains := make([]string, 0, len(groups))
+	for domain := range groups {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	for _, domain := range domains {
+		plan.Domains = append(plan.Domains, p.planDomain(domain, groups[domain]))
+	}
+
+	return plan
+}
+
+// registrableDomain validates a name and returns its registrable domain
+func (p *Planner) registrableDomain(name string) (string, error) {
+	if net.ParseIP(strings.Trim(name, "[]")) != nil {
+		return "", ErrIPAddress
+	}
+
+	if err := p.fqdn.CheckCertificateName(name); err != nil {
+		return "", err
+	}
+
+	return p.fqdn.GetFQDN(strings.TrimPrefix(name, "*."))
+}
+
+// planDomain consolidates and bundles the names of one registrable domain
+func (p *Planner) planDomain(domain string, names []string) DomainPlan {
+	// Map every SAN to the requested hosts it covers
+	covers := make(map[string][]string)
+	for _, name := range names {
+		covers[name] = append(covers[name], name)
+	}
+
+	// Group non-wildcard names by parent to find wildcard candidates
+	children := make(map[string][]string)
+	for _, name := range names {
+		if strings.HasPrefix(name, "*.") {
+			continue
+		}
+		if _, parent, ok := strings.Cut(name, "."); ok && strings.Contains(parent, ".") {
+			children[parent] = append(children[parent], name)
+		}
+	}
+
+	for parent, kids := range children {
+		wildcard := "*." + parent
+		_, requested := covers[wildcard]
+
+		consolidate := requested || p.limits.WildcardThreshold > 0 && len(kids) >= p.limits.WildcardThreshold
+		if !consolidate || p.fqdn.CheckCertificateName(wildcard) != nil {
+			continue
+		}
+
+		for _, kid := range kids {
+			delete(covers, kid)
+		}
+		hosts := slices.Clone(kids)
+		if requested {
+			hosts = append(hosts, wildcard)
+		}
+		covers[wildcard] = hosts
+	}
+
+	sans := make([]string, 0, len(covers))
+	for san := range covers {
+		sans = append(sans, san)
+	}
+	sortNames(sans)
+
+	dp := DomainPlan{Domain: domain}
+	for start := 0; start < len(sans); start += p.limits.MaxNamesPerCert {
+		end := min(start+p.limits.MaxNamesPerCert, len(sans))
+
+		cert := Certificate{Names: sans[start:end:end]}
+		for _, san := range cert.Names {
+			cert.Hosts = append(cert.Hosts, covers[san]...)
+		}
+		sortNames(cert.Hosts)
+
+		if p.limits.MaxCertsPerDomain > 0 && len(dp.Certificates) >= p.limits.MaxCertsPerDomain {
+			dp.Deferred = append(dp.Deferred, cert)
+		} else {
+			dp.Certificates = append(dp.Certificates, cert)
+		}
+	}
+
+	return dp
+}
+
+// sortNames orders names from the apex outwards, then alphabetically, with
+// a wildcard sorted next to its parent
+func sortNames(names []string) {
+	sort.Slice(names, func(i, j int) bool {
+		a, b := strings.TrimPrefix(names[i], "*."), strings.TrimPrefix(names[j], "*.")
+		da, db := strings.Count(a, "."), strings.Count(b, ".")
+		if da != db {
+			return da < db
+		}
+		if a != b {
+			return a < b
+		}
+		return names[i] > names[j]
+	})
+}
@@ -0,0 +1,131 @@
+// file: certplan/certplan_test.go
+// description: tests for certificate issuance planning
+
+package certplan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// newTestPlanner creates a planner over the built-in table
+func newTestPlanner(t *testing.T, limits Limits) *Planner {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{AllowPrivateTLDs: true})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return New(f, limits)
+}
+
+// TestPlan tests grouping, bundling and rejections
+func TestPlan(t *testing.T) {
+	p := newTestPlanner(t, Limits{MaxNamesPerCert: 2, MaxCertsPerDomain: 1})
+
+	plan := p.Plan([]string{
+		"www.example.com",
+		"example.com",
+		"API.example.com.",
+		"www.example.com",
+		"shop.example.co.uk",
+		"user.github.io",
+		"192.0.2.1",
+		"co.uk",
+		"github.io",
+		"*.com",
+		"example.invalidtld",
+	})
+
+	if len(plan.Domains) != 3 {
+		t.Fatalf("Domains = %+v, want 3", plan.Domains)
+	}
+
+	com := plan.Domains[0]
+	if com.Domain != "example.co.uk" || plan.Domains[1].Domain != "example.com" || plan.Domains[2].Domain != "user.github.io" {
+		t.Errorf("Domains order = %v, %v, %v", com.Domain, plan.Domains[1].Domain, plan.Domains[2].Domain)
+	}
+
+	// Three names in bundles of two with one certificate allowed
+	ex := plan.Domains[1]
+	if len(ex.Certificates) != 1 || len(ex.Deferred) != 1 {
+		t.Fatalf("example.com plan = %+v", ex)
+	}
+	if want := []string{"example.com", "api.example.com"}; !slices.Equal(ex.Certificates[0].Names, want) {
+		t.Errorf("Names = %v, want %v", ex.Certificates[0].Names, want)
+	}
+	if want := []string{"www.example.com"}; !slices.Equal(ex.Deferred[0].Names, want) {
+		t.Errorf("Deferred = %v, want %v", ex.Deferred[0].Names, want)
+	}
+
+	want := []struct {
+		name string
+		err  error
+	}{
+		{"192.0.2.1", ErrIPAddress},
+		{"co.uk", gotld.ErrPublicSuffixName},
+		{"github.io", gotld.ErrPublicSuffixName},
+		{"*.com", gotld.ErrBroadWildcard},
+		{"example.invalidtld", gotld.ErrInvalidTLD},
+	}
+
+	if len(plan.Rejected) != len(want) {
+		t.Fatalf("Rejected = %+v, want %d", plan.Rejected, len(want))
+	}
+	for i, w := range want {
+		r := plan.Rejected[i]
+		if r.Name != w.name || !errors.Is(r.Err, w.err) || r.Reason == "" {
+			t.Errorf("Rejected[%d] = %+v, want %s: %v", i, r, w.name, w.err)
+		}
+	}
+
+	if planned, deferred := plan.Certificates(); planned != 3 || deferred != 1 {
+		t.Errorf("Certificates() = %d, %d, want 3, 1", planned, deferred)
+	}
+}
+
+// TestPlanWildcards tests consolidating siblings into wildcards
+func TestPlanWildcards(t *testing.T) {
+	p := newTestPlanner(t, Limits{MaxNamesPerCert: 100, WildcardThreshold: 3})
+
+	var hosts []string
+	for i := 0; i < 3; i++ {
+		hosts = append(hosts, fmt.Sprintf("c%d.example.com", i))
+	}
+	hosts = append(hosts, "example.com", "a.shop.example.com", "*.api.example.com", "v1.api.example.com", "x.y.example.com")
+
+	plan := p.Plan(hosts)
+	if len(plan.Domains) != 1 || len(plan.Rejected) != 0 {
+		t.Fatalf("Plan() = %+v", plan)
+	}
+
+	cert := plan.Domains[0].Certificates[0]
+	wantNames := []string{"example.com", "*.example.com", "*.api.example.com", "a.shop.example.com", "x.y.example.com"}
+	if !slices.Equal(cert.Names, wantNames) {
+		t.Errorf("Names = %v, want %v", cert.Names, wantNames)
+	}
+
+	// Every requested host is covered exactly once
+	if len(cert.Hosts) != len(hosts) {
+		t.Errorf("Hosts = %v, want %d hosts", cert.Hosts, len(hosts))
+	}
+	for _, host := range hosts {
+		if !slices.Contains(cert.Hosts, host) {
+			t.Errorf("host %q not covered", host)
+		}
+	}
+}
+
+// TestPlanNoConsolidation tests that wildcards are not invented by default
+func TestPlanNoConsolidation(t *testing.T) {
+	p := newTestPlanner(t, DefaultLimits())
+
+	plan := p.Plan([]string{"a.example.com", "b.example.com", "c.example.com"})
+	if got := plan.Domains[0].Certificates[0].Names; len(got) != 3 {
+		t.Errorf("Names = %v, want 3 names", got)
+	}
+}
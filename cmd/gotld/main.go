@@ -0,0 +1,53 @@
+// file: cmd/gotld/main.go
+// description: command line interface for the gotld package
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// command is a gotld subcommand
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+// commands lists the available subcommands
+var commands = []command{
+	{name: "serve", usage: "serve the HTTP JSON API", run: runServe},
+	{name: "serve-grpc", usage: "serve the gRPC DomainService", run: runServeGRPC},
+	{name: "top", usage: "report the most frequent suffixes, domains and hosts", run: runTop},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "gotld %s: %v\n", cmd.name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+// usage prints the list of subcommands
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: gotld <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+}
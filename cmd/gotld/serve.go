@@ -0,0 +1,60 @@
+// file: cmd/gotld/serve.go
+// description: the serve subcommand
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/AndrewDonelson/gotld"
+	"github.com/AndrewDonelson/gotld/server"
+)
+
+// runServe starts the HTTP JSON API and stops on SIGINT or SIGTERM
+func runServe(args []string) error {
+	cfg := server.DefaultConfig()
+
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "listen address")
+	allowPrivate := fs.Bool("private", false, "allow private TLDs")
+	listURL := fs.String("url", "", "URL of the public suffix list (default: built-in table)")
+	listFile := fs.String("file", "", "local public suffix list file")
+	fs.IntVar(&cfg.MaxBatchSize, "max-batch", cfg.MaxBatchSize, "maximum number of inputs per batch request")
+	fs.Int64Var(&cfg.MaxBodyBytes, "max-body", cfg.MaxBodyBytes, "maximum request body size in bytes")
+	fs.IntVar(&cfg.MaxInputLength, "max-input", cfg.MaxInputLength, "maximum length of a single input")
+	fs.DurationVar(&cfg.MaxListAge, "max-age", cfg.MaxListAge, "report unhealthy when the list is older (0 disables)")
+	fs.DurationVar(&cfg.ShutdownTimeout, "shutdown-timeout", cfg.ShutdownTimeout, "graceful shutdown timeout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts := gotld.DefaultOptions()
+	opts.AllowPrivateTLDs = *allowPrivate
+	opts.PublicSuffixURL = *listURL
+	opts.PublicSuffixFile = *listFile
+	opts.Logger = logger
+	opts.Context = ctx
+
+	f, err := gotld.New(opts)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("listening", "addr", *addr)
+	if err := server.New(f, cfg).ListenAndServe(ctx, *addr); err != nil {
+		return err
+	}
+	logger.Info("stopped")
+
+	return nil
+}
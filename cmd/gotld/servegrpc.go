@@ -0,0 +1,69 @@
+// file: cmd/gotld/servegrpc.go
+// description: the serve-grpc subcommand
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"google.golang.org/grpc"
+
+	"github.com/AndrewDonelson/gotld"
+	"github.com/AndrewDonelson/gotld/grpcserver"
+)
+
+// runServeGRPC starts the gRPC DomainService and stops on SIGINT or SIGTERM
+func runServeGRPC(args []string) error {
+	fs := flag.NewFlagSet("serve-grpc", flag.ExitOnError)
+	addr := fs.String("addr", ":9090", "listen address")
+	allowPrivate := fs.Bool("private", false, "allow private TLDs")
+	listURL := fs.String("url", "", "URL of the public suffix list (default: built-in table)")
+	listFile := fs.String("file", "", "local public suffix list file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	opts := gotld.DefaultOptions()
+	opts.AllowPrivateTLDs = *allowPrivate
+	opts.PublicSuffixURL = *listURL
+	opts.PublicSuffixFile = *listFile
+	opts.Logger = logger
+	opts.Context = ctx
+
+	f, err := gotld.New(opts)
+	if err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+
+	srv := grpc.NewServer()
+	grpcserver.Register(srv, f)
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+
+	logger.Info("listening", "addr", ln.Addr().String())
+	if err := srv.Serve(ln); err != nil {
+		return err
+	}
+	logger.Info("stopped")
+
+	return nil
+}
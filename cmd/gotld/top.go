@@ -0,0 +1,72 @@
+// file: cmd/gotld/top.go
+// description: the top subcommand
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/AndrewDonelson/gotld"
+	"github.com/AndrewDonelson/gotld/aggregate"
+)
+
+// runTop counts URLs or hosts, one per line, from files or standard input
+// and prints the heaviest suffixes, domains and hosts
+func runTop(args []string) error {
+	fs := flag.NewFlagSet("top", flag.ExitOnError)
+	k := fs.Int("k", 10, "number of entries per level")
+	capacity := fs.Int("capacity", aggregate.DefaultCapacity, "keys monitored per level")
+	asJSON := fs.Bool("json", false, "print the report as JSON")
+	allowPrivate := fs.Bool("private", false, "allow private TLDs")
+	listFile := fs.String("file", "", "local public suffix list file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts := gotld.DefaultOptions()
+	opts.AllowPrivateTLDs = *allowPrivate
+	opts.PublicSuffixFile = *listFile
+
+	f, err := gotld.New(opts)
+	if err != nil {
+		return err
+	}
+
+	agg := aggregate.New(f, *capacity)
+
+	if fs.NArg() == 0 {
+		if _, err := agg.ReadFrom(os.Stdin); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range fs.Args() {
+		if err := readFile(agg, name); err != nil {
+			return err
+		}
+	}
+
+	rep := agg.Report(*k)
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rep)
+	}
+
+	return rep.WriteText(os.Stdout)
+}
+
+// readFile feeds the lines of a file to the aggregator
+func readFile(agg io.ReaderFrom, name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = agg.ReadFrom(file)
+	return err
+}
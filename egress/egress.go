@@ -0,0 +1,294 @@
+// file: egress/egress.go
+// description: http.RoundTripper enforcing an outbound host policy
+
+// Package egress restricts the third parties a service may call. A
+// Transport checks every outgoing request, including each redirect hop
+// followed by http.Client, against allow and deny patterns, refuses
+// requests the policy does not allow with a *PolicyError and reports every
+// decision to an Auditor. Patterns are gotld.HostMatcher patterns, the
+// same as origin validation: registrable domains, exact hosts and
+// "*.example.com" suffixes. Policies can be replaced at any time.
+//
+// Registrable domains are determined on every request, so reloading the
+// manager's suffix list applies to the policy immediately. A policy is
+// only replaced through SetPolicy or ReloadFile; the Transport does not
+// watch policy files itself.
+package egress
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// ErrDenied is returned when the policy does not allow a request
+var ErrDenied = errors.New("egress denied by policy")
+
+// Policy lists the hosts a service may call
+type Policy struct {
+	// Allow lists the allowed hosts; empty allows every host not denied
+	Allow []string `json:"allow"`
+
+	// Deny lists hosts that are refused even if allowed
+	Deny []string `json:"deny"`
+}
+
+// LoadPolicyFile reads a JSON policy such as
+// {"allow": ["stripe.com"], "deny": ["*.internal.stripe.com"]}
+func LoadPolicyFile(path string) (Policy, error) {
+	var p Policy
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return p, err
+	}
+	if err := json.Unmarshal(data, &p); err != nil {
+		return p, fmt.Errorf("invalid policy %s: %w", path, err)
+	}
+
+	return p, nil
+}
+
+// Decision is the outcome of checking a URL against the policy
+type Decision struct {
+	// Host is the host that was checked
+	Host string `json:"host"`
+
+	// Allowed is true when the request may proceed
+	Allowed bool `json:"allowed"`
+
+	// Rule is the matching pattern, prefixed with "allow:" or "deny:";
+	// empty when no pattern matched
+	Rule string `json:"rule,omitempty"`
+
+	// Reason explains the decision
+	Reason string `json:"reason"`
+}
+
+// PolicyError is returned by RoundTrip for requests the policy refuses
+type PolicyError struct {
+	// Method is the request method
+	Method string
+
+	// URL is the request URL
+	URL string
+
+	// Decision is the policy decision
+	Decision Decision
+}
+
+// Error implements error
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("%s %s: %v: %s", e.Method, e.URL, ErrDenied, e.Decision.Reason)
+}
+
+// Unwrap returns ErrDenied
+func (e *PolicyError) Unwrap() error {
+	return ErrDenied
+}
+
+// Event is an audit record of a checked request
+type Event struct {
+	// Time is when the request was checked
+	Time time.Time `json:"time"`
+
+	// Method is the request method
+	Method string `json:"method"`
+
+	// URL is the request URL
+	URL string `json:"url"`
+
+	// Redirect is the number of redirects that led to this request
+	Redirect int `json:"redirect,omitempty"`
+
+	// Decision is the policy decision
+	Decision Decision `json:"decision"`
+}
+
+// LogValue implements slog.LogValuer
+func (e Event) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("method", e.Method),
+		slog.String("url", e.URL),
+		slog.Int("redirect", e.Redirect),
+		slog.String("host", e.Decision.Host),
+		slog.Bool("allowed", e.Decision.Allowed),
+		slog.String("rule", e.Decision.Rule),
+		slog.String("reason", e.Decision.Reason),
+	)
+}
+
+// Auditor receives an event for every checked request. Implementations
+// must be safe for concurrent use.
+type Auditor interface {
+	Audit(ctx context.Context, e Event)
+}
+
+// AuditorFunc adapts a function to the Auditor interface
+type AuditorFunc func(ctx context.Context, e Event)
+
+// Audit calls f
+func (f AuditorFunc) Audit(ctx context.Context, e Event) {
+	f(ctx, e)
+}
+
+// LogAuditor returns an Auditor that logs allowed requests at debug level
+// and denied requests at warn level
+func LogAuditor(logger *slog.Logger) Auditor {
+	return AuditorFunc(func(ctx context.Context, e Event) {
+		level := slog.LevelDebug
+		if !e.Decision.Allowed {
+			level = slog.LevelWarn
+		}
+		logger.LogAttrs(ctx, level, "egress", slog.Any("event", e))
+	})
+}
+
+// Transport is an http.RoundTripper that enforces a Policy
+type Transport struct {
+	// Base performs allowed requests; nil uses http.DefaultTransport
+	Base http.RoundTripper
+
+	// Auditor receives an event for every request; nil disables auditing
+	Auditor Auditor
+
+	fqdn    *gotld.FQDN
+	current atomic.Pointer[compiled]
+}
+
+// compiled is a policy with its matchers
+type compiled struct {
+	policy Policy
+	allow  *gotld.HostMatcher
+	deny   *gotld.HostMatcher
+}
+
+// New creates a Transport enforcing policy. The manager determines
+// registrable domains; nil uses the global manager.
+func New(f *gotld.FQDN, policy Policy) (*Transport, error) {
+	if f == nil {
+		var err error
+		if f, err = gotld.Manager(); err != nil {
+			return nil, err
+		}
+	}
+
+	t := &Transport{fqdn: f}
+	if err := t.SetPolicy(policy); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// SetPolicy replaces the policy. Requests already checked are unaffected;
+// if the policy is invalid the current one stays active.
+func (t *Transport) SetPolicy(policy Policy) error {
+	allow, err := t.fqdn.NewHostMatcher(policy.Allow)
+	if err != nil {
+		return fmt.Errorf("allow: %w", err)
+	}
+
+	deny, err := t.fqdn.NewHostMatcher(policy.Deny)
+	if err != nil {
+		return fmt.Errorf("deny: %w", err)
+	}
+
+	t.current.Store(&compiled{policy: policy, allow: allow, deny: deny})
+	return nil
+}
+
+// ReloadFile replaces the policy with the one in a JSON file. Call it
+// whenever the file changes, e.g. on SIGHUP.
+func (t *Transport) ReloadFile(path string) error {
+	policy, err := LoadPolicyFile(path)
+	if err != nil {
+		return err
+	}
+
+	return t.SetPolicy(policy)
+}
+
+// Policy returns the active policy
+func (t *Transport) Policy() Policy {
+	return t.current.Load().policy
+}
+
+// Check decides whether a request to a URL or bare host is allowed. Deny
+// patterns take precedence over allow patterns; hosts without a known
+// public suffix are refused.
+func (t *Transport) Check(srcURL string) Decision {
+	p := t.current.Load()
+
+	res, err := t.fqdn.Parse(srcURL)
+	if err != nil {
+		return Decision{Reason: "invalid host: " + err.Error()}
+	}
+	d := Decision{Host: res.Host}
+
+	if pattern, ok := p.deny.Match(res.Host); ok {
+		d.Rule, d.Reason = "deny:"+pattern, "host matches deny rule "+pattern
+		return d
+	}
+
+	if len(p.policy.Allow) == 0 {
+		d.Allowed, d.Reason = true, "no allow rules"
+		return d
+	}
+
+	if pattern, ok := p.allow.Match(res.Host); ok {
+		d.Allowed, d.Rule, d.Reason = true, "allow:"+pattern, "host matches allow rule "+pattern
+		return d
+	}
+
+	d.Reason = "host matches no allow rule"
+	return d
+}
+
+// RoundTrip implements http.RoundTripper. http.Client calls it again for
+// every redirect, so each hop is checked and audited.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	d := t.Check(req.URL.String())
+
+	if t.Auditor != nil {
+		t.Auditor.Audit(req.Context(), Event{
+			Time:     time.Now(),
+			Method:   req.Method,
+			URL:      req.URL.Redacted(),
+			Redirect: redirects(req),
+			Decision: d,
+		})
+	}
+
+	if !d.Allowed {
+		// A RoundTripper must close the body even on errors
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, &PolicyError{Method: req.Method, URL: req.URL.Redacted(), Decision: d}
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// redirects counts the redirects that led to req; http.Client links each
+// hop to the response that caused it
+func redirects(req *http.Request) int {
+	n := 0
+	for resp := req.Response; resp != nil && resp.Request != nil; resp = resp.Request.Response {
+		n++
+	}
+	return n
+}
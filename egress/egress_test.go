@@ -0,0 +1,228 @@
+// file: egress/egress_test.go
+// description: tests for the egress policy transport
+
+package egress
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// recorder collects audit events
+type recorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// Audit implements Auditor
+func (r *recorder) Audit(_ context.Context, e Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, e)
+}
+
+// newTestTransport returns a transport whose base sends every request to
+// srv, whatever its host
+func newTestTransport(t *testing.T, srv *httptest.Server, policy Policy) (*Transport, *recorder) {
+	t.Helper()
+	t.Cleanup(srv.Close)
+
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("gotld.New() error = %v", err)
+	}
+
+	tr, err := New(f, policy)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, srv.Listener.Addr().String())
+	}
+	tr.Base = base
+
+	rec := &recorder{}
+	tr.Auditor = rec
+
+	return tr, rec
+}
+
+// TestCheck tests policy decisions
+func TestCheck(t *testing.T) {
+	tr, _ := newTestTransport(t, httptest.NewServer(http.NotFoundHandler()), Policy{
+		Allow: []string{"stripe.com", "hooks.slack.com", "*.amazonaws.com"},
+		Deny:  []string{"connect.stripe.com"},
+	})
+
+	tests := []struct {
+		url     string
+		allowed bool
+		rule    string
+	}{
+		{"https://api.stripe.com/v1/charges", true, "allow:stripe.com"},
+		{"https://connect.stripe.com/", false, "deny:connect.stripe.com"},
+		{"https://hooks.slack.com/services/x", true, "allow:hooks.slack.com"},
+		{"https://api.slack.com/", false, ""},
+		{"https://s3.us-east-1.amazonaws.com/bucket", true, "allow:*.amazonaws.com"},
+		{"https://stripe.com.evil.net/", false, ""},
+		{"https://127.0.0.1/", false, ""},
+		{"not a url", false, ""},
+	}
+
+	for _, tt := range tests {
+		d := tr.Check(tt.url)
+		if d.Allowed != tt.allowed || d.Rule != tt.rule || d.Reason == "" {
+			t.Errorf("Check(%q) = %+v, want allowed %v rule %q", tt.url, d, tt.allowed, tt.rule)
+		}
+	}
+}
+
+// TestRoundTrip tests enforcement and auditing of requests and redirects
+func TestRoundTrip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "api.example.com":
+			http.Redirect(w, r, "http://cdn.example.com/file", http.StatusFound)
+		case "cdn.example.com":
+			io.WriteString(w, "ok")
+		case "start.example.com":
+			http.Redirect(w, r, "http://evil.net/", http.StatusFound)
+		}
+	}))
+	defer srv.Close()
+
+	tr, rec := newTestTransport(t, srv, Policy{Allow: []string{"example.com"}})
+	client := &http.Client{Transport: tr}
+
+	// Both hops are allowed
+	resp, err := client.Get("http://api.example.com/")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want ok", body)
+	}
+
+	// The redirect hop leaves the allowed domains
+	_, err = client.Get("http://start.example.com/")
+	var perr *PolicyError
+	if !errors.As(err, &perr) || !errors.Is(err, ErrDenied) {
+		t.Fatalf("Get() error = %v, want PolicyError", err)
+	}
+	if perr.Decision.Host != "evil.net" {
+		t.Errorf("PolicyError host = %q, want evil.net", perr.Decision.Host)
+	}
+
+	want := []struct {
+		host     string
+		redirect int
+		allowed  bool
+	}{
+		{"api.example.com", 0, true},
+		{"cdn.example.com", 1, true},
+		{"start.example.com", 0, true},
+		{"evil.net", 1, false},
+	}
+	if len(rec.events) != len(want) {
+		t.Fatalf("got %d events, want %d", len(rec.events), len(want))
+	}
+	for i, w := range want {
+		e := rec.events[i]
+		if e.Decision.Host != w.host || e.Redirect != w.redirect || e.Decision.Allowed != w.allowed || e.Time.IsZero() {
+			t.Errorf("event %d = %+v, want %+v", i, e, w)
+		}
+	}
+}
+
+// TestReload tests replacing the policy at runtime
+func TestReload(t *testing.T) {
+	tr, _ := newTestTransport(t, httptest.NewServer(http.NotFoundHandler()), Policy{Allow: []string{"example.com"}})
+
+	if !tr.Check("https://example.com/").Allowed || tr.Check("https://example.org/").Allowed {
+		t.Fatal("initial policy not applied")
+	}
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err := os.WriteFile(path, []byte(`{"allow": ["example.org"], "deny": ["*.example.org"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.ReloadFile(path); err != nil {
+		t.Fatalf("ReloadFile() error = %v", err)
+	}
+
+	if tr.Check("https://example.com/").Allowed || !tr.Check("https://example.org/").Allowed || tr.Check("https://www.example.org/").Allowed {
+		t.Error("reloaded policy not applied")
+	}
+
+	// An invalid policy keeps the active one
+	if err := tr.SetPolicy(Policy{Allow: []string{"*"}}); err == nil {
+		t.Error("SetPolicy() accepted an invalid pattern")
+	}
+	if got := tr.Policy().Allow; len(got) != 1 || got[0] != "example.org" {
+		t.Errorf("Policy().Allow = %v, want [example.org]", got)
+	}
+
+	// With no allow rules only denied hosts are refused
+	if err := tr.SetPolicy(Policy{Deny: []string{"evil.net"}}); err != nil {
+		t.Fatalf("SetPolicy() error = %v", err)
+	}
+	if !tr.Check("https://anything.com/").Allowed || tr.Check("https://www.evil.net/").Allowed {
+		t.Error("deny-only policy not applied")
+	}
+}
+
+// TestListReload tests that a reloaded suffix list applies without
+// SetPolicy, as registrable domains are determined per request
+func TestListReload(t *testing.T) {
+	const list = `// https://publicsuffix.org/list/public_suffix_list.dat
+// ===BEGIN ICANN DOMAINS===
+com
+// ===END ICANN DOMAINS===
+`
+	path := filepath.Join(t.TempDir(), "list.dat")
+	if err := os.WriteFile(path, []byte(list), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := gotld.New(&gotld.Options{PublicSuffixFile: path, AllowPrivateTLDs: true})
+	if err != nil {
+		t.Fatalf("gotld.New() error = %v", err)
+	}
+
+	tr, err := New(f, Policy{Allow: []string{"example.com"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if !tr.Check("https://tenant.example.com/").Allowed {
+		t.Fatal("subdomain of an allowed registrable domain refused")
+	}
+
+	// Once example.com is a suffix, its subdomains are separate sites
+	private := list + "// ===BEGIN PRIVATE DOMAINS===\nexample.com\n// ===END PRIVATE DOMAINS===\n"
+	if err := os.WriteFile(path, []byte(private), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	if tr.Check("https://tenant.example.com/").Allowed {
+		t.Error("reloaded list not applied to the policy")
+	}
+}
@@ -0,0 +1,120 @@
+// file: email.go
+// description: parsing of email addresses and their registrable domains
+
+package gotld
+
+import (
+	"errors"
+	"log/slog"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// Length limits from RFC 5321 section 4.5.3.1
+const (
+	maxLocalPartLength = 64
+	maxDomainLength    = 253
+)
+
+// EmailResult holds the components of a parsed email address
+type EmailResult struct {
+	// Input is the string that was parsed
+	Input string `json:"input"`
+
+	// DisplayName is the name in "Name <addr>" form, if present
+	DisplayName string `json:"display_name,omitempty"`
+
+	// LocalPart is the part before the @, with any quoting removed
+	LocalPart string `json:"local_part"`
+
+	// Domain is the lowercase domain in Unicode form
+	Domain string `json:"domain"`
+
+	// DomainASCII is the domain in ASCII (punycode) form
+	DomainASCII string `json:"domain_ascii"`
+
+	// TLD is the public suffix (eTLD) of the domain
+	TLD string `json:"tld"`
+
+	// RegistrableDomain is the organizational domain, e.g. "example.co.uk"
+	RegistrableDomain string `json:"registrable_domain"`
+}
+
+// Address returns the addr-spec in Unicode form, quoting the local part
+// when required
+func (r *EmailResult) Address() string {
+	addr := (&mail.Address{Address: r.LocalPart + "@" + r.Domain}).String()
+	return strings.TrimSuffix(strings.TrimPrefix(addr, "<"), ">")
+}
+
+// ParseEmail parses an RFC 5322 address, with or without a display name,
+// and resolves the registrable domain of its domain. Internationalized
+// local parts and domains are accepted. Domains that are themselves public
+// suffixes, such as "co.uk", are rejected with ErrInvalidEmail.
+func (f *FQDN) ParseEmail(addr string) (*EmailResult, error) {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(addr))
+	if err != nil {
+		return nil, wrapError(ErrInvalidEmail, err.Error())
+	}
+
+	// The parsed address has its local part unquoted, so split on the last @
+	at := strings.LastIndexByte(parsed.Address, '@')
+	if at <= 0 || at == len(parsed.Address)-1 {
+		return nil, ErrInvalidEmail
+	}
+
+	local, domain := parsed.Address[:at], parsed.Address[at+1:]
+	if len(local) > maxLocalPartLength {
+		return nil, wrapError(ErrInvalidEmail, "local part too long")
+	}
+
+	// Domain literals such as [192.0.2.1] have no registrable domain
+	if strings.HasPrefix(domain, "[") {
+		return nil, wrapError(ErrInvalidEmail, "domain literals are not supported")
+	}
+
+	ascii, err := idna.Lookup.ToASCII(strings.TrimSuffix(domain, "."))
+	if err != nil {
+		return nil, wrapError(ErrInvalidEmail, err.Error())
+	}
+
+	if len(ascii) > maxDomainLength || !strings.Contains(ascii, ".") {
+		return nil, wrapError(ErrInvalidEmail, "invalid domain")
+	}
+
+	// The suffix list holds internationalized rules in Unicode form
+	unicode, err := idna.Lookup.ToUnicode(ascii)
+	if err != nil {
+		return nil, wrapError(ErrInvalidEmail, err.Error())
+	}
+
+	eTLD, registrable, err := f.splitHost(unicode, false)
+	switch {
+	case errors.Is(err, ErrInvalidURL):
+		return nil, wrapError(ErrInvalidEmail, "domain is a public suffix")
+	case err != nil:
+		return nil, err
+	}
+
+	return &EmailResult{
+		Input:             addr,
+		DisplayName:       parsed.Name,
+		LocalPart:         local,
+		Domain:            unicode,
+		DomainASCII:       ascii,
+		TLD:               eTLD,
+		RegistrableDomain: registrable,
+	}, nil
+}
+
+// LogValue implements slog.LogValuer; the local part is omitted to keep
+// addresses out of logs
+func (r *EmailResult) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("domain", r.Domain),
+		slog.String("tld", r.TLD),
+		slog.String("registrable_domain", r.RegistrableDomain),
+	)
+}
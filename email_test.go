@@ -0,0 +1,128 @@
+// file: email_test.go
+// description: tests for email address parsing
+
+package gotld
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseEmail tests parsing email addresses into their components
+func TestParseEmail(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		input       string
+		displayName string
+		local       string
+		domain      string
+		ascii       string
+		tld         string
+		registrable string
+		wantErr     error
+	}{
+		{
+			name:        "Plain address",
+			input:       "alice@mail.example.co.uk",
+			local:       "alice",
+			domain:      "mail.example.co.uk",
+			ascii:       "mail.example.co.uk",
+			tld:         "co.uk",
+			registrable: "example.co.uk",
+		},
+		{
+			name:        "Display name and mixed case",
+			input:       "Alice Example <Alice@Mail.Example.COM>",
+			displayName: "Alice Example",
+			local:       "Alice",
+			domain:      "mail.example.com",
+			ascii:       "mail.example.com",
+			tld:         "com",
+			registrable: "example.com",
+		},
+		{
+			name:        "Quoted local part",
+			input:       `"john doe@home"@example.org`,
+			local:       "john doe@home",
+			domain:      "example.org",
+			ascii:       "example.org",
+			tld:         "org",
+			registrable: "example.org",
+		},
+		{
+			name:        "Internationalized address",
+			input:       "用户@例子.公司.cn",
+			local:       "用户",
+			domain:      "例子.公司.cn",
+			ascii:       "xn--fsqu00a.xn--55qx5d.cn",
+			tld:         "公司.cn",
+			registrable: "例子.公司.cn",
+		},
+		{
+			name:        "Punycode domain",
+			input:       "info@xn--fsqu00a.xn--55qx5d.cn",
+			local:       "info",
+			domain:      "例子.公司.cn",
+			ascii:       "xn--fsqu00a.xn--55qx5d.cn",
+			tld:         "公司.cn",
+			registrable: "例子.公司.cn",
+		},
+		{name: "Public suffix domain", input: "bob@co.uk", wantErr: ErrInvalidEmail},
+		{name: "Unknown suffix", input: "bob@example.invalidtld", wantErr: ErrInvalidTLD},
+		{name: "Single label domain", input: "bob@localhost", wantErr: ErrInvalidEmail},
+		{name: "Domain literal", input: "bob@[192.0.2.1]", wantErr: ErrInvalidEmail},
+		{name: "Missing at", input: "bob.example.com", wantErr: ErrInvalidEmail},
+		{name: "Missing local part", input: "@example.com", wantErr: ErrInvalidEmail},
+		{name: "Invalid domain", input: "bob@exa_mple.com", wantErr: ErrInvalidEmail},
+		{name: "Empty", input: "", wantErr: ErrInvalidEmail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fqdn.ParseEmail(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseEmail(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseEmail(%q) error = %v", tt.input, err)
+			}
+
+			if got.DisplayName != tt.displayName || got.LocalPart != tt.local || got.Domain != tt.domain ||
+				got.DomainASCII != tt.ascii || got.TLD != tt.tld || got.RegistrableDomain != tt.registrable {
+				t.Errorf("ParseEmail(%q) = %+v", tt.input, got)
+			}
+		})
+	}
+}
+
+// TestEmailAddress tests formatting a parsed address
+func TestEmailAddress(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	tests := map[string]string{
+		"Alice <alice@Example.com>": "alice@example.com",
+		`"john doe"@example.com`:    `"john doe"@example.com`,
+	}
+
+	for input, want := range tests {
+		got, err := fqdn.ParseEmail(input)
+		if err != nil {
+			t.Fatalf("ParseEmail(%q) error = %v", input, err)
+		}
+		if got.Address() != want {
+			t.Errorf("Address() = %q, want %q", got.Address(), want)
+		}
+	}
+}
@@ -15,14 +15,41 @@ var (
 	// ErrInvalidTLD is returned when a TLD is not found in the public suffix list
 	ErrInvalidTLD = errors.New("invalid TLD")
 
+	// ErrInvalidHostname is returned when a host name fails syntax validation
+	ErrInvalidHostname = errors.New("invalid hostname")
+
+	// ErrInvalidWildcard is returned when a certificate wildcard is malformed
+	ErrInvalidWildcard = errors.New("invalid wildcard")
+
+	// ErrBroadWildcard is returned when a certificate wildcard covers a public suffix
+	ErrBroadWildcard = errors.New("wildcard covers a public suffix")
+
+	// ErrPublicSuffixName is returned when a certificate name is itself a public suffix
+	ErrPublicSuffixName = errors.New("name is a public suffix")
+
+	// ErrUnsafeRedirect is returned when a redirect target is not allowed
+	ErrUnsafeRedirect = errors.New("unsafe redirect")
+
+	// ErrInvalidEmail is returned when an email address is invalid
+	ErrInvalidEmail = errors.New("invalid email address")
+
 	// ErrPublicSuffixDownload is returned when the public suffix file cannot be downloaded
 	ErrPublicSuffixDownload = errors.New("failed to download public suffix file")
 
+	// ErrPublicSuffixRead is returned when the public suffix file cannot be read from a source
+	ErrPublicSuffixRead = errors.New("failed to read public suffix file")
+
 	// ErrPublicSuffixParse is returned when the public suffix file cannot be parsed
 	ErrPublicSuffixParse = errors.New("failed to parse public suffix file")
 
 	// ErrPublicSuffixFormat is returned when the downloaded file is not the public suffix file
 	ErrPublicSuffixFormat = errors.New("file is not the public suffix file")
+
+	// ErrTLDDatabaseFormat is returned when a TLD database cannot be parsed
+	ErrTLDDatabaseFormat = errors.New("invalid TLD database")
+
+	// ErrPublicSuffixIntegrity is returned when the public suffix file fails verification
+	ErrPublicSuffixIntegrity = errors.New("public suffix file failed integrity verification")
 )
 
 // wrapError wraps an error with additional context
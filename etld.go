@@ -9,6 +9,9 @@ import (
 )
 
 // ETLD manages all eTLDs in lists with thread-safety
+//
+// Deprecated: lookups no longer use ETLD lists. Use FQDN.Rules,
+// FQDN.Children and FQDN.IsPublicSuffix to query the loaded rules.
 type ETLD struct {
 	List  []string
 	Count int
@@ -32,8 +35,9 @@ func (e *ETLD) Add(s string, sortList bool) bool {
 	e.List = append(e.List, s)
 	e.Count = len(e.List)
 
+	// Sort in place; calling Sort would re-acquire the lock
 	if sortList {
-		e.Sort()
+		sort.Strings(e.List)
 	}
 
 	return e.Count > oldCount
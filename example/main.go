@@ -7,7 +7,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strings"
 	"time"
@@ -23,17 +23,18 @@ func main() {
 	verbose := flag.Bool("verbose", false, "Enable verbose logging")
 	flag.Parse()
 
-	// Configure logging
+	// Configure logging; verbose mode includes load events
+	level := slog.LevelWarn
 	if *verbose {
-		log.SetOutput(os.Stdout)
-	} else {
-		log.SetOutput(os.Stderr)
+		level = slog.LevelInfo
 	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
 
 	// Create custom options
 	opts := &gotld.Options{
 		AllowPrivateTLDs: *allowPrivate,
 		Timeout:          *timeout,
+		Logger:           logger,
 		Context:          context.Background(),
 	}
 
@@ -44,7 +45,8 @@ func main() {
 
 	// Initialize gotld with custom options
 	if err := gotld.Init(opts); err != nil {
-		log.Fatalf("Failed to initialize gotld: %v", err)
+		logger.Error("failed to initialize gotld", "error", err)
+		os.Exit(1)
 	}
 
 	// Get URLs from command-line arguments or use defaults
@@ -74,7 +76,7 @@ func main() {
 
 	for _, url := range urls {
 		fqdn, err := gotld.GetFQDN(url)
-		
+
 		if err != nil {
 			fmt.Printf("%-50s | %-30s | ERROR: %v\n", url, "-", err)
 		} else {
@@ -85,7 +87,7 @@ func main() {
 	// Demonstrate origin validation
 	fmt.Println("\nOrigin Validation")
 	fmt.Println("----------------")
-	
+
 	allowedOrigins := []string{
 		"example.com",
 		"trusted.org",
@@ -93,7 +95,7 @@ func main() {
 	}
 
 	fmt.Printf("Allowed origins: %s\n\n", strings.Join(allowedOrigins, ", "))
-	
+
 	originsToCheck := []string{
 		"https://example.com",
 		"http://malicious.com",
@@ -107,7 +109,7 @@ func main() {
 		if isValid {
 			validText = "VALID"
 		}
-		
+
 		fmt.Printf("%-40s | %s\n", origin, validText)
 	}
-}
\ No newline at end of file
+}
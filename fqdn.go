@@ -5,23 +5,35 @@ package gotld
 
 import (
 	"context"
-	"crypto/tls"
+	"errors"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"golang.org/x/sync/errgroup"
+	"golang.org/x/net/idna"
 )
 
 // FQDN main object structure with concurrency support
 type FQDN struct {
-	Options  *Options
-	etldList [eTLDGroupMax]*ETLD
-	total    int
-	mu       sync.RWMutex
+	Options     *Options
+	rules       *suffixTable
+	source      SourceInfo
+	version     string
+	commit      string
+	counts      RuleCounts
+	fallback    bool
+	loadedAt    time.Time
+	lastRefresh time.Time
+	lastErr     error
+	cache       *lruCache
+	tlds        *TLDDatabase
+	origins     atomic.Pointer[HostMatcher]
+	mu          sync.RWMutex
 }
 
 // newFQDN creates a new FQDN manager with the specified options
@@ -31,50 +43,53 @@ func newFQDN(opts *Options) (*FQDN, error) {
 	}
 
 	fqdn := &FQDN{
-		Options: opts,
-		mu:      sync.RWMutex{},
+		Options:  opts,
+		rules:    &builtinTable,
+		source:   SourceInfo{Name: "builtin", Version: builtinListVersion},
+		version:  builtinListVersion,
+		commit:   builtinListCommit,
+		loadedAt: time.Now(),
+		cache:    newLRUCache(opts.CacheSize),
+		mu:       sync.RWMutex{},
+	}
+	fqdn.Tidy()
+
+	// Get the public suffix list, falling back to the built-in table
+	var err error
+	if src := fqdn.configuredSource(); src != nil {
+		err = fqdn.loadSource(src, "load")
 	}
 
-	for i := 0; i < eTLDGroupMax; i++ {
-		fqdn.etldList[i] = emptyETLD(i)
-	}
+	if err != nil {
+		if !opts.FallbackToBuiltin {
+			return nil, wrapError(err, "failed to initialize FQDN manager")
+		}
 
-	// Get the public suffix list
-	var err error
-	if opts.PublicSuffixFile != "" {
-		err = fqdn.loadPublicSuffixFromFile(opts.PublicSuffixFile)
-	} else {
-		err = fqdn.downloadPublicSuffixFile(opts.PublicSuffixURL)
+		// Keep the built-in table and report it through Status
+		fqdn.mu.Lock()
+		fqdn.fallback = true
+		fqdn.mu.Unlock()
+
+		fqdn.logEvent(slog.LevelWarn, "using built-in public suffix list",
+			slog.String("source", "builtin"),
+			slog.String("version", builtinListVersion),
+			slog.Any("rules", fqdn.counts),
+			slog.Any("error", err))
 	}
 
-	if err != nil {
+	if err := fqdn.ReloadTLDDatabase(); err != nil {
 		return nil, wrapError(err, "failed to initialize FQDN manager")
 	}
 
 	return fqdn, nil
 }
 
-// Tidy will tally the total number of loaded eTLDs and sort each list
+// Tidy will tally the number of loaded rules by section and type
 func (f *FQDN) Tidy() {
 	f.mu.Lock()
 	defer f.mu.Unlock()
 
-	f.total = 0
-	var wg errgroup.Group
-
-	for i := 0; i < eTLDGroupMax; i++ {
-		i := i // Capture for goroutine
-		wg.Go(func() error {
-			f.etldList[i].Sort()
-			return nil
-		})
-	}
-
-	_ = wg.Wait() // Ignore error as Sort doesn't return an error
-
-	for i := 0; i < eTLDGroupMax; i++ {
-		f.total += f.etldList[i].Count
-	}
+	f.counts = f.rules.counts()
 }
 
 // hasScheme checks if a URL has a scheme and optionally removes it
@@ -135,189 +150,421 @@ func (f *FQDN) guess(domain string, count int) (string, error) {
 
 // findTLD attempts to find the TLD of a domain
 func (f *FQDN) findTLD(s string) string {
+	return f.findSuffix(s, f.Options.AllowPrivateTLDs)
+}
+
+// findSuffix finds the public suffix of a domain, consulting private rules
+// when private is set. Punycode labels are matched against the Unicode
+// rules of the table, and the suffix is returned in the form of s.
+func (f *FQDN) findSuffix(s string, private bool) string {
+	lookup := tableForm(s)
+
 	f.mu.RLock()
 	defer f.mu.RUnlock()
 
 	var (
-		tld, guess string
-		found      bool
-		err        error
+		tld, guess, parent string
+		parentFlags        uint8
+		err                error
 	)
 
-	dots := strings.Count(s, ".")
-	if dots >= 1 {
-		for i := dots; i > 0; i-- {
-			guess, err = f.guess(s, i)
-			if err == nil {
-				tld, found = f.etldList[i-1].Search(guess)
-				if found {
-					break
-				}
+	// Walk from the last label towards the full host keeping the longest match
+	for i := 1; i <= eTLDGroupMax; i++ {
+		guess, err = f.guess(lookup, i)
+		if err != nil {
+			break
+		}
+
+		flags := f.rules.match(guess, private)
+		switch {
+		case flags&ruleException != 0:
+			// An exception rule makes its parent the public suffix
+			return lastLabels(s, parent)
+		case flags&ruleNormal != 0, parentFlags&ruleWildcard != 0:
+			tld = guess
+		}
+
+		parent, parentFlags = guess, flags
+	}
+
+	return lastLabels(s, tld)
+}
+
+// tableForm converts the punycode labels of a domain to the Unicode form
+// the suffix table is keyed by. Labels that fail to convert are kept and
+// simply match no IDN rule.
+func tableForm(s string) string {
+	u, _ := unicodeLabels(s)
+	return u
+}
+
+// unicodeLabels converts the punycode labels of s to Unicode one by one,
+// so a malformed label elsewhere in the name does not prevent it. Labels
+// that fail to convert are kept and the first failure is returned.
+func unicodeLabels(s string) (string, error) {
+	if !strings.Contains(s, "xn--") {
+		return s, nil
+	}
+
+	var firstErr error
+	labels := strings.Split(s, ".")
+	for i, label := range labels {
+		if !strings.HasPrefix(label, "xn--") {
+			continue
+		}
+
+		u, err := idna.Lookup.ToUnicode(label)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
 			}
+			continue
 		}
+		labels[i] = u
 	}
 
-	return tld
+	return strings.Join(labels, "."), firstErr
 }
 
-// GetFQDN extracts the FQDN from a URL
-func (f *FQDN) GetFQDN(srcURL string) (string, error) {
+// lastLabels returns as many trailing labels of s as suffix has, so a
+// suffix found in the table form of s is returned in the form of s
+func lastLabels(s, suffix string) string {
+	if suffix == "" || strings.HasSuffix(s, suffix) {
+		return suffix
+	}
+
+	n := strings.Count(suffix, ".") + 1
+	i := len(s)
+	for ; n > 0 && i > 0; n-- {
+		i = strings.LastIndexByte(s[:i], '.')
+	}
+	return s[i+1:]
+}
+
+// parseURL parses a URL or bare host, reporting whether it had a scheme.
+// Malformed URLs end a lookup before splitHost, so they are reported to the
+// Observer here.
+func (f *FQDN) parseURL(srcURL string) (parsedURL *url.URL, hadScheme bool, err error) {
+	defer func() {
+		if err != nil {
+			f.observeLookup(0, err)
+		}
+	}()
+
 	if srcURL == "" {
-		return "", ErrInvalidURL
+		return nil, false, ErrInvalidURL
 	}
 
 	// Shortest domain ex. a.io (4), and must have at least 1 DOT
 	if len(srcURL) < 4 || strings.Count(srcURL, ".") < 1 {
-		return "", ErrInvalidURL
+		return nil, false, ErrInvalidURL
 	}
 
 	// If no prefix, add a fake one for net/url.Parse() (workaround)
-	hadScheme := false
 	srcURL, hadScheme = f.hasScheme(srcURL, false)
 	if !hadScheme {
 		srcURL = "fake://" + srcURL
 	}
 
-	parsedURL, err := url.Parse(srcURL)
+	parsedURL, err = url.Parse(srcURL)
+	if err != nil {
+		return nil, false, wrapError(ErrInvalidURL, err.Error())
+	}
+
+	if parsedURL.Hostname() == "" {
+		return nil, false, ErrInvalidURL
+	}
+
+	return parsedURL, hadScheme, nil
+}
+
+// hostname extracts the lowercase host name from a URL or bare host
+func (f *FQDN) hostname(srcURL string) (string, error) {
+	parsedURL, _, err := f.parseURL(srcURL)
+	if err != nil {
+		return "", err
+	}
+
+	// Hostname drops the scheme, userinfo, port, path and query
+	return strings.ToLower(parsedURL.Hostname()), nil
+}
+
+// GetFQDN extracts the FQDN from a URL
+func (f *FQDN) GetFQDN(srcURL string) (string, error) {
+	return f.getFQDN(srcURL)
+}
+
+// getFQDN extracts the FQDN from a URL
+func (f *FQDN) getFQDN(srcURL string) (string, error) {
+	host, err := f.hostname(srcURL)
 	if err != nil {
-		return "", wrapError(ErrInvalidURL, err.Error())
+		return "", err
+	}
+
+	_, fqdn, err := f.splitHost(host, true)
+	return fqdn, err
+}
+
+// splitHost returns the public suffix and registrable domain of a host,
+// validating it first when check is set and the options ask for it. Every
+// entry point funnels through here, so each lookup is reported to the
+// Observer.
+func (f *FQDN) splitHost(host string, check bool) (string, string, error) {
+	if f.Options.Observer == nil {
+		return f.resolveHost(host, check)
 	}
 
-	// We don't need scheme anymore - get rid of it
-	srcURL, _ = f.hasScheme(srcURL, true)
+	start := time.Now()
+	eTLD, registrable, err := f.resolveHost(host, check)
+	f.observeLookup(time.Since(start), err)
+
+	return eTLD, registrable, err
+}
 
-	// Remove port if present
-	if parsedURL.Port() != "" {
-		srcURL = strings.Replace(srcURL, ":"+parsedURL.Port(), "", 1)
+// observeLookup reports a lookup to the Observer, if any
+func (f *FQDN) observeLookup(duration time.Duration, err error) {
+	if f.Options.Observer != nil {
+		f.Options.Observer.ObserveLookup(duration, err)
+	}
+}
+
+// resolveHost validates and splits a host, consulting the lookup cache
+// when enabled
+func (f *FQDN) resolveHost(host string, check bool) (string, string, error) {
+	if check {
+		if err := f.checkHostname(host); err != nil {
+			return "", "", err
+		}
 	}
 
-	// Remove query parameters
-	if parsedURL.RawQuery != "" {
-		srcURL = strings.Replace(srcURL, "?"+parsedURL.RawQuery, "", 1)
+	if f.cache == nil {
+		return f.lookupHost(host)
 	}
 
-	// Remove path
-	if parsedURL.Path != "" && parsedURL.Path != "/" {
-		srcURL = strings.Replace(srcURL, parsedURL.Path, "", 1)
+	// The generation is read before the lookup so a concurrent reload
+	// invalidates the result
+	entry, gen, ok := f.cache.get(host)
+	if ok {
+		return entry.eTLD, entry.registrable, entry.err
 	}
 
+	eTLD, registrable, err := f.lookupHost(host)
+	f.cache.put(cacheEntry{host: host, eTLD: eTLD, registrable: registrable, err: err}, gen)
+
+	return eTLD, registrable, err
+}
+
+// lookupHost returns the public suffix and registrable domain of a host
+func (f *FQDN) lookupHost(host string) (string, string, error) {
 	// Find the TLD
-	eTLD := f.findTLD(srcURL)
+	eTLD := f.findTLD(host)
 	if eTLD == "" {
-		return "", ErrInvalidTLD
+		return "", "", ErrInvalidTLD
 	}
 
 	// Extract the domain from the URL
-	domainPart := strings.Replace(srcURL, "."+eTLD, "", 1)
+	domainPart := strings.TrimSuffix(host, "."+eTLD)
 
-	if domainPart == "" {
-		return "", ErrInvalidURL
+	if domainPart == "" || host == eTLD {
+		return "", "", ErrInvalidURL
 	}
 
 	// Handle subdomains
 	dots := strings.Count(domainPart, ".")
 	if dots == 0 {
-		return domainPart + "." + eTLD, nil
+		return eTLD, domainPart + "." + eTLD, nil
 	}
 
 	parts := strings.Split(domainPart, ".")
-	return parts[len(parts)-1] + "." + eTLD, nil
+	return eTLD, parts[len(parts)-1] + "." + eTLD, nil
 }
 
-// loadPublicSuffixFromFile loads the public suffix list from a local file
-func (f *FQDN) loadPublicSuffixFromFile(filePath string) error {
-	if filePath == "" {
-		return wrapError(ErrPublicSuffixDownload, "no file path provided")
+// ValidateOrigin checks if the host or FQDN of origin is in the allowed origins list,
+// which holds HostMatcher patterns. It ignores the scheme; use IsSafeRedirect to
+// validate redirect targets. The patterns compiled for the last list are reused
+// while callers pass an equal list; callers alternating between lists should
+// compile each once with NewHostMatcher.
+func (f *FQDN) ValidateOrigin(origin string, allowedOrigins []string) bool {
+	u, err := f.GetFQDN(origin)
+	if err != nil {
+		return false
 	}
 
-	ctx := f.Options.Context
-	if ctx == nil {
-		ctx = context.Background()
+	// GetFQDN succeeded, so the host is valid
+	host, _ := f.hostname(origin)
+
+	_, ok := f.originMatcher(allowedOrigins).match(host, u)
+
+	return ok
+}
+
+// configuredSource returns the source selected by the options, or nil when
+// the built-in table is used
+func (f *FQDN) configuredSource() Source {
+	switch {
+	case f.Options.Source != nil:
+		return f.Options.Source
+	case f.Options.PublicSuffixFile != "":
+		return NewFileSource(f.Options.PublicSuffixFile)
+	case f.Options.PublicSuffixURL != "" || len(f.Options.PublicSuffixMirrors) > 0:
+		var urls []string
+		if f.Options.PublicSuffixURL != "" {
+			urls = append(urls, f.Options.PublicSuffixURL)
+		}
+		urls = append(urls, f.Options.PublicSuffixMirrors...)
+		return NewMirrorSource(urls, f.httpClient(), f.Options.Retry)
+	}
+	return nil
+}
+
+// Reload loads the list again from the configured source. If loading or
+// verification fails the previously loaded list stays active.
+func (f *FQDN) Reload() error {
+	src := f.configuredSource()
+	if src == nil {
+		return nil
 	}
 
-	// Load file implementation would go here
-	// For now, fall back to downloading
-	return f.downloadPublicSuffixFile(f.Options.PublicSuffixURL)
+	return f.loadSource(src, "refresh")
+}
+
+// httpClient returns the HTTP client configured in the options
+func (f *FQDN) httpClient() *http.Client {
+	if f.Options.CustomHTTPClient != nil {
+		return f.Options.CustomHTTPClient
+	}
+	return newHTTPClient(f.Options.Timeout)
 }
 
-// downloadPublicSuffixFile downloads and parses the public suffix list
-func (f *FQDN) downloadPublicSuffixFile(fileURL string) error {
-	if fileURL == "" {
-		fileURL = publicSuffixFileURL
+// loadSource reads and parses the public suffix list from a source. The
+// outcome is recorded as the last refresh attempt; event names the attempt
+// ("load" or "refresh") in logs.
+func (f *FQDN) loadSource(src Source, event string) error {
+	started := time.Now()
+	info, err := f.readSource(src, false)
+	duration := time.Since(started)
+
+	if f.Options.Observer != nil {
+		f.Options.Observer.ObserveLoad(info, duration, err)
 	}
 
+	f.mu.Lock()
+	f.lastRefresh = started
+	f.lastErr = err
+	counts := f.counts
+	f.mu.Unlock()
+
+	f.logLoad(event, info, duration, counts, err)
+
+	return err
+}
+
+// readSource opens, verifies, parses and installs the list from src. The
+// sources of a ChainSource and the mirrors of a MirrorSource are tried in
+// turn until one yields a list that passes verification and parses, not
+// just one that opens; fallback marks lists that do not come from the
+// first source.
+func (f *FQDN) readSource(src Source, fallback bool) (SourceInfo, error) {
 	ctx := f.Options.Context
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	// Create HTTP client with proper security settings
-	var client *http.Client
-	if f.Options.CustomHTTPClient != nil {
-		client = f.Options.CustomHTTPClient
-	} else {
-		timeout := f.Options.Timeout
-		if timeout == 0 {
-			timeout = 10 * time.Second
+	var sources []Source
+	switch s := src.(type) {
+	case *ChainSource:
+		sources = s.Sources
+	case *MirrorSource:
+		if len(s.URLs) > 1 {
+			sources = s.split()
 		}
+	}
+
+	if len(sources) == 0 {
+		return f.readList(ctx, src, fallback)
+	}
 
-		transport := &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion: tls.VersionTLS12,
-			},
-			ForceAttemptHTTP2:     true,
-			MaxIdleConns:          10,
-			IdleConnTimeout:       30 * time.Second,
-			TLSHandshakeTimeout:   5 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
+	var errs []error
+	for i, s := range sources {
+		info, err := f.readSource(s, fallback || i > 0)
+		if err == nil {
+			return info, nil
 		}
+		errs = append(errs, err)
 
-		client = &http.Client{
-			Transport: transport,
-			Timeout:   timeout,
+		// Stop early if the caller gave up
+		if ctx.Err() != nil {
+			break
 		}
 	}
 
-	// Create request with context
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	return SourceInfo{}, errors.Join(errs...)
+}
+
+// readList opens, verifies, parses and installs the list from a single
+// source
+func (f *FQDN) readList(ctx context.Context, src Source, fallback bool) (SourceInfo, error) {
+	rc, info, err := src.Open(ctx)
 	if err != nil {
-		return wrapError(ErrPublicSuffixDownload, err.Error())
+		return info, err
 	}
+	defer rc.Close()
 
-	// Set appropriate headers
-	req.Header.Set("User-Agent", "GoTLD/1.0")
-
-	// Get the data
-	resp, err := client.Do(req)
+	// Read the list
+	data, err := io.ReadAll(io.LimitReader(rc, maxDataSize))
 	if err != nil {
-		return wrapError(ErrPublicSuffixDownload, err.Error())
+		return info, wrapError(ErrPublicSuffixRead, err.Error())
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return wrapError(ErrPublicSuffixDownload, "unexpected status code: "+resp.Status)
+	// Verify before parsing so a rejected list never becomes active
+	if err := f.Options.Integrity.verify(ctx, data); err != nil {
+		return info, err
 	}
 
-	// Read the response body
-	respData, err := io.ReadAll(io.LimitReader(resp.Body, 10*1024*1024)) // Limit to 10MB
+	rules, err := f.parsePublicSuffixData(data)
 	if err != nil {
-		return wrapError(ErrPublicSuffixParse, err.Error())
+		return info, err
+	}
+
+	info.Fallback = info.Fallback || fallback
+
+	version := listHeader(data, "VERSION")
+	if info.Version == "" {
+		info.Version = version
 	}
 
-	if len(respData) < minDataSize {
-		return wrapError(ErrPublicSuffixParse, "response data size too small for public suffix file")
+	// The rollback check and the swap share one lock, so concurrent loads
+	// cannot both pass the check against the same version. The check uses
+	// the header of the installed list, as a source may name its own version.
+	f.mu.Lock()
+	err = f.Options.Integrity.checkRollback(version, f.version)
+	if err == nil {
+		f.rules = rules
+		f.source = info
+		f.version = version
+		f.commit = listHeader(data, "COMMIT")
+		f.counts = rules.counts()
+		f.fallback = false
+		f.loadedAt = time.Now()
+	}
+	f.mu.Unlock()
+
+	if err != nil {
+		return info, err
 	}
 
-	// Parse the response
-	return f.parsePublicSuffixData(respData)
+	// Results computed from the previous list are no longer valid
+	f.PurgeCache()
+
+	return info, nil
 }
 
-// parsePublicSuffixData parses the public suffix list data
-func (f *FQDN) parsePublicSuffixData(data []byte) error {
+// parsePublicSuffixData parses the public suffix list data into a table
+func (f *FQDN) parsePublicSuffixData(data []byte) (*suffixTable, error) {
 	sliceData := strings.Split(string(data), "\n")
 
 	if len(sliceData) == 0 {
-		return ErrPublicSuffixParse
+		return nil, ErrPublicSuffixParse
 	}
 
 	// Verify that this is the public suffix list
@@ -330,18 +577,14 @@ func (f *FQDN) parsePublicSuffixData(data []byte) error {
 	}
 
 	if !found {
-		return ErrPublicSuffixFormat
+		return nil, ErrPublicSuffixFormat
 	}
 
-	var icann bool
-
-	f.mu.Lock()
-	defer f.mu.Unlock()
-
-	// Reset the current lists
-	for i := 0; i < eTLDGroupMax; i++ {
-		f.etldList[i] = emptyETLD(i)
-	}
+	var (
+		icann, private bool
+		skipped        int
+	)
+	builder := newTableBuilder()
 
 	for _, tld := range sliceData {
 		// Skip blank lines
@@ -349,37 +592,48 @@ func (f *FQDN) parsePublicSuffixData(data []byte) error {
 			continue
 		}
 
-		// Detect and toggle ICANN eTLD state
-		if strings.Contains(tld, "===BEGIN ICANN DOMAINS===") {
+		// Detect and toggle ICANN and private eTLD state
+		switch {
+		case strings.Contains(tld, "===BEGIN ICANN DOMAINS==="):
 			icann = true
 			continue
-		} else if strings.Contains(tld, "===END ICANN DOMAINS===") {
+		case strings.Contains(tld, "===END ICANN DOMAINS==="):
 			icann = false
 			continue
-		}
-
-		// If private TLDs not allowed and this is not an ICANN TLD, skip it
-		if !f.Options.AllowPrivateTLDs && !icann {
+		case strings.Contains(tld, "===BEGIN PRIVATE DOMAINS==="):
+			private = true
 			continue
-		}
-
-		// Skip comments, wildcards, and exceptions
-		if strings.HasPrefix(tld, "//") || strings.HasPrefix(tld, "*") || strings.HasPrefix(tld, "!") {
+		case strings.Contains(tld, "===END PRIVATE DOMAINS==="):
+			private = false
 			continue
 		}
 
-		// Add eTLD to list
-		tld = strings.ToLower(strings.TrimSpace(tld))
-		if tld == "" {
+		// Rules outside of both sections and malformed rules are ignored
+		line := strings.TrimSpace(tld)
+		isRule := line != "" && !strings.HasPrefix(line, "//")
+		if !icann && !private {
+			if isRule {
+				skipped++
+			}
 			continue
 		}
 
-		dots := strings.Count(tld, ".")
-		if dots < eTLDGroupMax {
-			f.etldList[dots].Add(tld, false)
+		// Add the rule; comments and blank lines are skipped by the builder
+		if !builder.Add(tld, private) && isRule {
+			skipped++
 		}
 	}
 
-	f.Tidy()
-	return nil
+	rules := builder.Build()
+	if rules.Len() == 0 {
+		return nil, ErrPublicSuffixParse
+	}
+
+	if skipped > 0 {
+		f.logEvent(slog.LevelWarn, "ignored malformed or unsectioned public suffix rules",
+			slog.Int("skipped", skipped),
+			slog.Int("rules", rules.Len()))
+	}
+
+	return rules, nil
 }
@@ -0,0 +1,270 @@
+// file: gen.go
+// description: generates the built-in public suffix table (go generate)
+
+//go:build ignore
+
+package main
+
+// This program converts a public suffix list into table_data.go so that the
+// package ships a pinned list and needs no parsing at start-up. Run it with
+//
+//	go generate
+//
+// or, to pin a specific copy of the list,
+//
+//	go run gen.go -input ./public_suffix_list.dat -output table_data.go
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Flags must match the rule* constants in table.go
+	ruleNormal uint8 = 1 << iota
+	ruleWildcard
+	ruleException
+	rulePrivate
+)
+
+var (
+	input  = flag.String("input", "https://publicsuffix.org/list/public_suffix_list.dat", "URL or file path of the public suffix list")
+	output = flag.String("output", "table_data.go", "generated Go file")
+
+	version = flag.String("version", "", "VERSION to record when the list has no VERSION header")
+	commit  = flag.String("commit", "", "COMMIT to record when the list has no COMMIT header")
+	digest  = flag.String("sha256", "", "expected SHA-256 digest of the list")
+)
+
+func main() {
+	flag.Parse()
+
+	data, err := read(*input)
+	if err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+
+	sum := sha256.Sum256(data)
+	if *digest != "" && !strings.EqualFold(*digest, hex.EncodeToString(sum[:])) {
+		log.Fatalf("gen: %s has SHA-256 %x, want %s", *input, sum, *digest)
+	}
+
+	rules, listVersion, listCommit, err := parse(data)
+	if err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+
+	// Copies such as the raw file in the upstream repository carry no
+	// headers, so the release they belong to is passed in by flag
+	listVersion, err = header("VERSION", listVersion, *version)
+	if err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+	listCommit, err = header("COMMIT", listCommit, *commit)
+	if err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+
+	// The built-in version drives Status and rollback protection, so a
+	// list that cannot be traced to a release is refused
+	if listVersion == "" || listCommit == "" {
+		log.Fatalf("gen: %s has no VERSION or COMMIT header; pass -version and -commit", *input)
+	}
+
+	src, err := generate(rules, listVersion, listCommit, hex.EncodeToString(sum[:]))
+	if err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("gen: %v", err)
+	}
+
+	log.Printf("gen: wrote %d rules to %s", len(rules), *output)
+}
+
+// header reconciles a header of the list with the value given by flag
+func header(name, list, flagged string) (string, error) {
+	switch {
+	case flagged == "":
+		return list, nil
+	case list != "" && list != flagged:
+		return "", fmt.Errorf("list has %s %q, not %q", name, list, flagged)
+	}
+	return flagged, nil
+}
+
+// read loads the list from a URL or a local file
+func read(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parse extracts the rules and header values from the list
+func parse(data []byte) (map[string]uint8, string, string, error) {
+	var (
+		rules           = make(map[string]uint8)
+		version, commit string
+		section         string
+	)
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "// VERSION:"):
+			version = strings.TrimSpace(strings.TrimPrefix(line, "// VERSION:"))
+			continue
+		case strings.HasPrefix(line, "// COMMIT:"):
+			commit = strings.TrimSpace(strings.TrimPrefix(line, "// COMMIT:"))
+			continue
+		case strings.Contains(line, "===BEGIN ICANN DOMAINS==="):
+			section = "icann"
+			continue
+		case strings.Contains(line, "===BEGIN PRIVATE DOMAINS==="):
+			section = "private"
+			continue
+		case strings.Contains(line, "===END "):
+			section = ""
+			continue
+		case line == "" || strings.HasPrefix(line, "//"):
+			continue
+		}
+
+		if section == "" {
+			return nil, "", "", fmt.Errorf("rule %q outside of a section", line)
+		}
+
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.ToLower(line)
+
+		// Mirrors parseRule in table.go, so the built-in table holds
+		// exactly the rules a runtime load of the same list would
+		rule := line
+		var flags uint8
+		switch {
+		case strings.HasPrefix(line, "!"):
+			line, flags = line[1:], ruleException
+		case strings.HasPrefix(line, "*."):
+			line, flags = line[2:], ruleWildcard
+		case strings.HasPrefix(line, "*"):
+			return nil, "", "", fmt.Errorf("unsupported rule %q", rule)
+		default:
+			flags = ruleNormal
+		}
+		if line == "" {
+			return nil, "", "", fmt.Errorf("empty rule %q", rule)
+		}
+
+		if section == "private" {
+			flags |= rulePrivate
+		}
+		rules[line] |= flags
+	}
+
+	if len(rules) == 0 {
+		return nil, "", "", fmt.Errorf("no rules found")
+	}
+
+	return rules, version, commit, nil
+}
+
+// generate renders table_data.go
+func generate(rules map[string]uint8, version, commit, sum string) ([]byte, error) {
+	keys := make([]string, 0, len(rules))
+	for k := range rules {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// file: table_data.go")
+	fmt.Fprintln(&buf, "// description: built-in public suffix table pinned at generation time")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package gotld")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "const (")
+	fmt.Fprintln(&buf, "// builtinListVersion is the VERSION header of the built-in list")
+	fmt.Fprintf(&buf, "builtinListVersion = %q\n\n", version)
+	fmt.Fprintln(&buf, "// builtinListCommit is the COMMIT header of the built-in list")
+	fmt.Fprintf(&buf, "builtinListCommit = %q\n\n", commit)
+	fmt.Fprintln(&buf, "// builtinListSHA256 is the SHA-256 digest of the list the table was generated from")
+	fmt.Fprintf(&buf, "builtinListSHA256 = %q\n", sum)
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// builtinTable holds the rules of the built-in list")
+	fmt.Fprintln(&buf, "var builtinTable = suffixTable{")
+	fmt.Fprintln(&buf, "text: builtinText,")
+	fmt.Fprintln(&buf, "offsets: builtinOffsets,")
+	fmt.Fprintln(&buf, "flags: builtinFlags,")
+	fmt.Fprintln(&buf, "}")
+	fmt.Fprintln(&buf)
+
+	// The text is split into chunks to keep lines readable
+	fmt.Fprintln(&buf, "const builtinText = \"\" +")
+	var line strings.Builder
+	for i, k := range keys {
+		line.WriteString(k)
+		if line.Len() >= 64 || i == len(keys)-1 {
+			sep := " +"
+			if i == len(keys)-1 {
+				sep = ""
+			}
+			fmt.Fprintf(&buf, "%q%s\n", line.String(), sep)
+			line.Reset()
+		}
+	}
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "var builtinOffsets = []uint32{")
+	offset := 0
+	for i, k := range keys {
+		fmt.Fprintf(&buf, "%d,", offset)
+		if i%16 == 15 {
+			fmt.Fprintln(&buf)
+		}
+		offset += len(k)
+	}
+	fmt.Fprintf(&buf, "%d,\n}\n\n", offset)
+
+	fmt.Fprintln(&buf, "var builtinFlags = []uint8{")
+	for i, k := range keys {
+		fmt.Fprintf(&buf, "%d,", rules[k])
+		if i%32 == 31 {
+			fmt.Fprintln(&buf)
+		}
+	}
+	fmt.Fprintln(&buf, "\n}")
+
+	return format.Source(buf.Bytes())
+}
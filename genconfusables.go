@@ -0,0 +1,168 @@
+// file: genconfusables.go
+// description: generates the confusables table from UTS 39 (go generate)
+
+//go:build ignore
+
+package main
+
+// This program converts the Unicode confusables.txt into confusables_data.go.
+// Run it with
+//
+//	go generate
+//
+// or, to use a local copy,
+//
+//	go run genconfusables.go -input ./confusables.txt
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	input  = flag.String("input", "https://www.unicode.org/Public/security/15.0.0/confusables.txt", "URL or file path of confusables.txt")
+	output = flag.String("output", "confusables_data.go", "generated Go file")
+)
+
+func main() {
+	flag.Parse()
+
+	data, err := read(*input)
+	if err != nil {
+		log.Fatalf("genconfusables: %v", err)
+	}
+
+	table, version, err := parse(data)
+	if err != nil {
+		log.Fatalf("genconfusables: %v", err)
+	}
+
+	keys := make([]rune, 0, len(table))
+	for r := range table {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// file: confusables_data.go")
+	fmt.Fprintln(&buf, "// description: confusable character mappings used to compute skeletons")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Code generated by genconfusables.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package gotld")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// confusablesVersion identifies the source of the confusables table")
+	fmt.Fprintf(&buf, "const confusablesVersion = %q\n\n", version)
+	fmt.Fprintln(&buf, "// confusables maps characters to their UTS 39 prototypes")
+	fmt.Fprintln(&buf, "var confusables = map[rune]string{")
+	for _, r := range keys {
+		fmt.Fprintf(&buf, "\t0x%04X: %q,\n", r, table[r])
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("genconfusables: %v", err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("genconfusables: %v", err)
+	}
+
+	log.Printf("genconfusables: wrote %d mappings to %s", len(table), *output)
+}
+
+// read loads a URL or a local file
+func read(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parse reads "source ; target ; MA" lines of hex code points
+func parse(data []byte) (map[rune]string, string, error) {
+	table := make(map[rune]string)
+	version := "unknown"
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\uFEFF")
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok {
+			version = strings.TrimSpace(v)
+			continue
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Split(line, ";")
+		if len(fields) < 2 {
+			continue
+		}
+
+		source, err := codePoints(fields[0])
+		if err != nil || len(source) != 1 {
+			return nil, "", fmt.Errorf("invalid source %q", fields[0])
+		}
+
+		target, err := codePoints(fields[1])
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid target %q", fields[1])
+		}
+
+		// Skeleton applies NFD before the lookup, so characters that NFD
+		// decomposes are never looked up, and it applies NFD again after,
+		// so prototypes are kept in that form
+		if !norm.NFD.IsNormalString(string(source)) {
+			continue
+		}
+		if proto := norm.NFD.String(string(target)); proto != string(source) {
+			table[source[0]] = proto
+		}
+	}
+
+	if len(table) == 0 {
+		return nil, "", fmt.Errorf("no mappings found")
+	}
+
+	return table, version, scanner.Err()
+}
+
+// codePoints parses space separated hex code points
+func codePoints(s string) ([]rune, error) {
+	var runes []rune
+	for _, field := range strings.Fields(s) {
+		n, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		runes = append(runes, rune(n))
+	}
+	return runes, nil
+}
@@ -0,0 +1,172 @@
+// file: gentld.go
+// description: generates the built-in TLD database from IANA (go generate)
+
+//go:build ignore
+
+package main
+
+// This program rebuilds tlds.txt from the IANA root zone database and the
+// list of TLDs in the root zone. Run it with
+//
+//	go generate
+//
+// or, to use local copies of both files,
+//
+//	go run gentld.go -db ./root-db.html -alpha ./tlds-alpha-by-domain.txt
+//
+// IANA classifies brand TLDs as generic. Pass -brands with the TLDs whose
+// registry agreement has Specification 13, one per line, to mark them as
+// brand.
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	dbInput    = flag.String("db", "https://www.iana.org/domains/root/db", "URL or file path of the IANA root zone database page")
+	alphaInput = flag.String("alpha", "https://data.iana.org/TLD/tlds-alpha-by-domain.txt", "URL or file path of tlds-alpha-by-domain.txt")
+	brandInput = flag.String("brands", "", "URL or file path of brand TLDs, one per line")
+	output     = flag.String("output", "tlds.txt", "generated database file")
+)
+
+// rowPattern matches a TLD row of the root zone database table
+var rowPattern = regexp.MustCompile(`(?s)<a href="/domains/root/db/([^".]+)\.html">[^<]*</a></span></td>\s*<td>([^<]*)</td>\s*<td>([^<]*)</td>`)
+
+// entry is one TLD of the database
+type entry struct {
+	tld, kind, sponsor string
+}
+
+func main() {
+	flag.Parse()
+
+	page, err := read(*dbInput)
+	if err != nil {
+		log.Fatalf("gentld: %v", err)
+	}
+
+	alpha, err := read(*alphaInput)
+	if err != nil {
+		log.Fatalf("gentld: %v", err)
+	}
+
+	delegated, version := parseAlpha(alpha)
+	if len(delegated) == 0 {
+		log.Fatalf("gentld: no TLDs in %s", *alphaInput)
+	}
+
+	var entries []entry
+	for _, m := range rowPattern.FindAllSubmatch(page, -1) {
+		entries = append(entries, entry{
+			tld:     strings.ToLower(string(m[1])),
+			kind:    strings.TrimSpace(html.UnescapeString(string(m[2]))),
+			sponsor: strings.TrimSpace(html.UnescapeString(string(m[3]))),
+		})
+	}
+	if len(entries) == 0 {
+		log.Fatalf("gentld: no TLDs in %s", *dbInput)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].tld < entries[j].tld })
+
+	var brands map[string]bool
+	if *brandInput != "" {
+		data, err := read(*brandInput)
+		if err != nil {
+			log.Fatalf("gentld: %v", err)
+		}
+		brands, _ = parseAlpha(data)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "# gotld TLD database")
+	fmt.Fprintf(&buf, "# VERSION: %s\n", version)
+	if brands != nil {
+		fmt.Fprintln(&buf, "# SOURCE: IANA root zone database, tlds-alpha-by-domain.txt and brand TLDs")
+	} else {
+		fmt.Fprintln(&buf, "# SOURCE: IANA root zone database and tlds-alpha-by-domain.txt")
+	}
+	fmt.Fprintln(&buf, "# Regenerate with: go run gentld.go")
+	fmt.Fprintln(&buf, "#")
+	fmt.Fprintln(&buf, "# tld\ttype\tstatus\tsponsor")
+
+	for _, e := range entries {
+		status := "undelegated"
+		if delegated[e.tld] {
+			status = "delegated"
+		}
+
+		// Only generic TLDs can be brands; a listed ccTLD is a mistake
+		if brands[e.tld] && e.kind == "generic" {
+			e.kind = "brand"
+		}
+
+		sponsor := e.sponsor
+		if strings.EqualFold(sponsor, "Not assigned") {
+			sponsor = ""
+		}
+
+		fmt.Fprintln(&buf, strings.TrimRight(strings.Join([]string{e.tld, e.kind, status, sponsor}, "\t"), "\t"))
+	}
+
+	if err := os.WriteFile(*output, buf.Bytes(), 0o644); err != nil {
+		log.Fatalf("gentld: %v", err)
+	}
+
+	log.Printf("gentld: wrote %d TLDs (%d delegated) to %s", len(entries), len(delegated), *output)
+}
+
+// read loads a URL or a local file
+func read(src string) ([]byte, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.ReadFile(src)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(src)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+// parseAlpha returns the TLDs of tlds-alpha-by-domain.txt and its version
+func parseAlpha(data []byte) (map[string]bool, string) {
+	tlds := make(map[string]bool)
+	version := time.Now().UTC().Format("2006-01-02")
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// "# Version 2025010100, Last Updated Wed Jan  1 07:07:01 2025 UTC"
+		if v, ok := strings.CutPrefix(line, "# Version "); ok {
+			if fields := strings.Fields(v); len(fields) > 0 {
+				version = strings.TrimSuffix(fields[0], ",")
+			}
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tlds[strings.ToLower(line)] = true
+	}
+
+	return tlds, version
+}
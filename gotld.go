@@ -3,6 +3,10 @@
 
 package gotld
 
+//go:generate go run gen.go -input https://raw.githubusercontent.com/publicsuffix/list/9e8325c62adb9f7c6211cb7c4f6970a27fcb67f1/public_suffix_list.dat -version 2023-02-09_23-26-35_UTC -commit 9e8325c62adb9f7c6211cb7c4f6970a27fcb67f1 -sha256 87d2e11f3602b504fc5dbea9218429a4ce3c0f62aa6ce7a1371024add024baed -output table_data.go
+//go:generate go run gentld.go -output tlds.txt
+//go:generate go run genconfusables.go -output confusables_data.go
+
 import (
 	"sync"
 )
@@ -10,15 +14,24 @@ import (
 // FQDNManager is the main interface for the GoTLD package
 type FQDNManager interface {
 	GetFQDN(url string) (string, error)
+	ValidateOrigin(origin string, allowedOrigins []string) bool
 }
 
+// FQDN implements FQDNManager
+var _ FQDNManager = (*FQDN)(nil)
+
 var (
 	// Global manager instance
-	manager     FQDNManager
+	manager     *FQDN
 	managerOnce sync.Once
 	managerErr  error
 )
 
+// New creates a new FQDN manager with the specified options
+func New(opts *Options) (*FQDN, error) {
+	return newFQDN(opts)
+}
+
 // Init initializes the GoTLD package with custom options
 func Init(opts *Options) error {
 	var err error
@@ -34,6 +47,18 @@ func Init(opts *Options) error {
 	return err
 }
 
+// Manager returns the global manager, initializing it with default options
+// if needed
+func Manager() (*FQDN, error) {
+	if manager == nil {
+		if err := Init(DefaultOptions()); err != nil {
+			return nil, err
+		}
+	}
+
+	return manager, nil
+}
+
 // GetFQDN extracts the FQDN from a URL using the global manager
 func GetFQDN(url string) (string, error) {
 	// Initialize with default options if not already initialized
@@ -47,19 +72,34 @@ func GetFQDN(url string) (string, error) {
 	return manager.GetFQDN(url)
 }
 
+// Reload reloads the public suffix list of the global manager
+func Reload() error {
+	if manager == nil {
+		return Init(DefaultOptions())
+	}
+
+	return manager.Reload()
+}
+
 // ValidateOrigin checks if a given origin is in the allowed origins list
 func ValidateOrigin(origin string, allowedOrigins []string) bool {
-	u, err := GetFQDN(origin)
-	if err != nil {
-		return false
+	// Initialize with default options if not already initialized
+	if manager == nil {
+		if err := Init(DefaultOptions()); err != nil {
+			return false
+		}
 	}
 
-	// Check if the FQDN is in the allowed origins list
-	for _, allowed := range allowedOrigins {
-		if u == allowed {
-			return true
-		}
+	return manager.ValidateOrigin(origin, allowedOrigins)
+}
+
+// IsSafeRedirect reports whether redirecting to target from base is safe
+// under policy using the global manager
+func IsSafeRedirect(target, base string, policy *RedirectPolicy) bool {
+	m, err := Manager()
+	if err != nil {
+		return false
 	}
 
-	return false
+	return m.IsSafeRedirect(target, base, policy)
 }
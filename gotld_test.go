@@ -168,8 +168,10 @@ func TestInitWithCustomOptions(t *testing.T) {
 		w.Header().Set("Content-Type", "text/plain")
 		w.WriteHeader(http.StatusOK)
 
-		// Write the public suffix list header and some test data
+		// Write the public suffix list header and some test data, padded
+		// with comments to pass the minimum size check
 		w.Write([]byte("// The Public Suffix List\n// https://publicsuffix.org/list/public_suffix_list.dat\n\n// ===BEGIN ICANN DOMAINS===\ncom\nco.uk\n// ===END ICANN DOMAINS===\n"))
+		w.Write([]byte(strings.Repeat("// padding\n", minDataSize/10)))
 	}))
 	defer ts.Close()
 
@@ -320,6 +322,26 @@ func TestValidateOrigin(t *testing.T) {
 			origin:  "api.service.com",
 			allowed: true,
 		},
+		{
+			name:    "Exact host with scheme and port",
+			origin:  "https://api.service.com:8443/v1",
+			allowed: true,
+		},
+		{
+			name:    "Sibling of an exact host",
+			origin:  "www.service.com",
+			allowed: false,
+		},
+		{
+			name:    "Registrable domain of an exact host",
+			origin:  "service.com",
+			allowed: false,
+		},
+		{
+			name:    "Subdomain of an exact host",
+			origin:  "x.api.service.com",
+			allowed: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -566,21 +588,21 @@ amazonaws.com
 `)
 
 	// Parse the valid data
-	err = fqdn.parsePublicSuffixData(validData)
+	_, err = fqdn.parsePublicSuffixData(validData)
 	if err != nil {
 		t.Errorf("parsePublicSuffixData() error = %v", err)
 	}
 
 	// Test with invalid data
 	invalidData := []byte(`This is not the public suffix list`)
-	err = fqdn.parsePublicSuffixData(invalidData)
+	_, err = fqdn.parsePublicSuffixData(invalidData)
 	if err == nil {
 		t.Error("parsePublicSuffixData() expected error with invalid data, got nil")
 	}
 
 	// Test with empty data
 	emptyData := []byte(``)
-	err = fqdn.parsePublicSuffixData(emptyData)
+	_, err = fqdn.parsePublicSuffixData(emptyData)
 	if err == nil {
 		t.Error("parsePublicSuffixData() expected error with empty data, got nil")
 	}
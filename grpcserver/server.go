@@ -0,0 +1,144 @@
+// file: grpcserver/server.go
+// description: gRPC server exposing gotld lookups
+
+// Package grpcserver implements the gotld.v1.DomainService gRPC service on
+// top of an FQDN manager, so that services in other languages share one
+// authoritative public suffix list instance.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AndrewDonelson/gotld"
+	gotldv1 "github.com/AndrewDonelson/gotld/api/gotld/v1"
+)
+
+// Server implements gotldv1.DomainServiceServer
+type Server struct {
+	gotldv1.UnimplementedDomainServiceServer
+
+	fqdn *gotld.FQDN
+}
+
+// New creates a Server for f
+func New(f *gotld.FQDN) *Server {
+	return &Server{fqdn: f}
+}
+
+// Register creates a Server for f and registers it with s
+func Register(s grpc.ServiceRegistrar, f *gotld.FQDN) *Server {
+	srv := New(f)
+	gotldv1.RegisterDomainServiceServer(s, srv)
+	return srv
+}
+
+// Parse splits a URL or host into its components
+func (s *Server) Parse(ctx context.Context, req *gotldv1.ParseRequest) (*gotldv1.ParseResponse, error) {
+	res, err := s.fqdn.Parse(req.GetInput())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return parseResponse(res), nil
+}
+
+// BatchParse parses each input received on the stream and sends one
+// response per input. Parse failures are reported in the response's error
+// field so the stream continues.
+func (s *Server) BatchParse(stream grpc.BidiStreamingServer[gotldv1.ParseRequest, gotldv1.ParseResponse]) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &gotldv1.ParseResponse{Input: req.GetInput()}
+		if res, err := s.fqdn.Parse(req.GetInput()); err != nil {
+			resp.Error = err.Error()
+		} else {
+			resp = parseResponse(res)
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// SameSite reports whether two inputs share a registrable domain
+func (s *Server) SameSite(ctx context.Context, req *gotldv1.SameSiteRequest) (*gotldv1.SameSiteResponse, error) {
+	same, err := s.fqdn.SameSite(req.GetA(), req.GetB())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &gotldv1.SameSiteResponse{SameSite: same}, nil
+}
+
+// Status describes the loaded public suffix list
+func (s *Server) Status(ctx context.Context, req *gotldv1.StatusRequest) (*gotldv1.StatusResponse, error) {
+	st := s.fqdn.Status()
+
+	resp := &gotldv1.StatusResponse{
+		Source:   st.Source.Name,
+		Version:  st.Version,
+		Commit:   st.Commit,
+		Builtin:  st.Builtin,
+		Fallback: st.Fallback,
+		Rules: &gotldv1.RuleCounts{
+			Total:     int64(st.Rules.Total),
+			Icann:     int64(st.Rules.ICANN),
+			Private:   int64(st.Rules.Private),
+			Normal:    int64(st.Rules.Normal),
+			Wildcard:  int64(st.Rules.Wildcard),
+			Exception: int64(st.Rules.Exception),
+		},
+	}
+
+	if !st.LoadedAt.IsZero() {
+		resp.LoadedAt = timestamppb.New(st.LoadedAt)
+	}
+	if !st.LastRefresh.IsZero() {
+		resp.LastRefresh = timestamppb.New(st.LastRefresh)
+	}
+	if st.LastError != nil {
+		resp.LastError = st.LastError.Error()
+	}
+
+	return resp, nil
+}
+
+// parseResponse converts a ParseResult to its protobuf form
+func parseResponse(res *gotld.ParseResult) *gotldv1.ParseResponse {
+	return &gotldv1.ParseResponse{
+		Input:             res.Input,
+		Scheme:            res.Scheme,
+		Host:              res.Host,
+		Port:              res.Port,
+		Subdomain:         res.Subdomain,
+		Domain:            res.Domain,
+		Tld:               res.TLD,
+		RegistrableDomain: res.RegistrableDomain,
+	}
+}
+
+// statusError maps lookup errors to gRPC status codes
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, gotld.ErrInvalidURL):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gotld.ErrInvalidTLD):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,149 @@
+// file: grpcserver/server_test.go
+// description: tests for the gRPC server using an in-memory connection
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/AndrewDonelson/gotld"
+	gotldv1 "github.com/AndrewDonelson/gotld/api/gotld/v1"
+)
+
+// newTestClient starts a server on a bufconn listener and returns a client
+func newTestClient(t *testing.T) gotldv1.DomainServiceClient {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	Register(srv, f)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return gotldv1.NewDomainServiceClient(conn)
+}
+
+// TestParse tests the unary Parse RPC
+func TestParse(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.Parse(ctx, &gotldv1.ParseRequest{Input: "https://a.b.example.co.uk:443/"})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if resp.GetRegistrableDomain() != "example.co.uk" || resp.GetSubdomain() != "a.b" || resp.GetTld() != "co.uk" || resp.GetPort() != "443" {
+		t.Errorf("Parse() = %v", resp)
+	}
+
+	tests := []struct {
+		input string
+		code  codes.Code
+	}{
+		{"invalid", codes.InvalidArgument},
+		{"example.invalidtld", codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		_, err := client.Parse(ctx, &gotldv1.ParseRequest{Input: tt.input})
+		if status.Code(err) != tt.code {
+			t.Errorf("Parse(%q) code = %v, want %v", tt.input, status.Code(err), tt.code)
+		}
+	}
+}
+
+// TestBatchParse tests the streaming BatchParse RPC
+func TestBatchParse(t *testing.T) {
+	client := newTestClient(t)
+
+	stream, err := client.BatchParse(context.Background())
+	if err != nil {
+		t.Fatalf("BatchParse() error = %v", err)
+	}
+
+	inputs := []string{"www.example.com", "invalid", "api.example.org"}
+	for _, input := range inputs {
+		if err := stream.Send(&gotldv1.ParseRequest{Input: input}); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+
+	var got []*gotldv1.ParseResponse
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		got = append(got, resp)
+	}
+
+	if len(got) != len(inputs) {
+		t.Fatalf("received %d responses, want %d", len(got), len(inputs))
+	}
+
+	if got[0].GetRegistrableDomain() != "example.com" || got[1].GetError() == "" || got[2].GetRegistrableDomain() != "example.org" {
+		t.Errorf("BatchParse() = %v", got)
+	}
+
+	if got[1].GetInput() != "invalid" {
+		t.Errorf("failed item input = %q, want %q", got[1].GetInput(), "invalid")
+	}
+}
+
+// TestSameSiteAndStatus tests the SameSite and Status RPCs
+func TestSameSiteAndStatus(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	resp, err := client.SameSite(ctx, &gotldv1.SameSiteRequest{A: "https://www.example.com", B: "example.com"})
+	if err != nil || !resp.GetSameSite() {
+		t.Errorf("SameSite() = %v, %v, want true", resp, err)
+	}
+
+	if _, err := client.SameSite(ctx, &gotldv1.SameSiteRequest{A: "invalid", B: "example.com"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SameSite() code = %v, want %v", status.Code(err), codes.InvalidArgument)
+	}
+
+	st, err := client.Status(ctx, &gotldv1.StatusRequest{})
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+
+	if !st.GetBuiltin() || st.GetRules().GetTotal() == 0 || st.GetLoadedAt() == nil {
+		t.Errorf("Status() = %v", st)
+	}
+}
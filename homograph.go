@@ -0,0 +1,353 @@
+// file: homograph.go
+// description: UTS 39 skeletons, mixed-script detection and IDN display policy
+
+package gotld
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+)
+
+// RestrictionLevel is the UTS 39 section 5.2 restriction level of a label
+type RestrictionLevel int
+
+const (
+	// ASCIIOnly labels contain only ASCII characters
+	ASCIIOnly RestrictionLevel = iota
+
+	// SingleScript labels use one script, counting Han with Hiragana and
+	// Katakana, Bopomofo or Hangul as one script
+	SingleScript
+
+	// HighlyRestrictive labels combine Latin with Han and Hiragana and
+	// Katakana, Han and Bopomofo, or Han and Hangul
+	HighlyRestrictive
+
+	// ModeratelyRestrictive labels combine Latin with one other script
+	// that is not Cyrillic, Greek or Cherokee
+	ModeratelyRestrictive
+
+	// MinimallyRestrictive labels mix scripts in any other way
+	MinimallyRestrictive
+)
+
+// String returns the name of the restriction level
+func (l RestrictionLevel) String() string {
+	switch l {
+	case ASCIIOnly:
+		return "ascii-only"
+	case SingleScript:
+		return "single-script"
+	case HighlyRestrictive:
+		return "highly-restrictive"
+	case ModeratelyRestrictive:
+		return "moderately-restrictive"
+	case MinimallyRestrictive:
+		return "minimally-restrictive"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (l RestrictionLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// Skeleton returns the UTS 39 skeleton of s: the NFD normalized string
+// with every confusable character replaced by its prototype, normalized
+// again. Two strings with the same skeleton are visually confusable.
+func Skeleton(s string) string {
+	var sb strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		if proto, ok := confusables[r]; ok {
+			sb.WriteString(proto)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(sb.String())
+}
+
+// domainSkeleton returns a case-insensitive skeleton for domain labels, so
+// that "0" (prototype "O") and "o" are confusable
+func domainSkeleton(s string) string {
+	return Skeleton(strings.ToLower(Skeleton(strings.ToLower(s))))
+}
+
+// Scripts returns the sorted names of the scripts used by s, ignoring the
+// Common and Inherited scripts shared by all
+func Scripts(s string) []string {
+	set := make(map[string]bool)
+	for _, r := range s {
+		if name := scriptOf(r); name != "" {
+			set[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// scriptOf returns the script of r, or "" for Common and Inherited
+func scriptOf(r rune) string {
+	if r < 0x80 {
+		if unicode.IsLetter(r) {
+			return "Latin"
+		}
+		return ""
+	}
+
+	if unicode.Is(unicode.Common, r) || unicode.Is(unicode.Inherited, r) {
+		return ""
+	}
+
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// cjkSets are the script combinations UTS 39 treats as a single script,
+// each also allowed together with Latin in highly restrictive labels
+var cjkSets = [][]string{
+	{"Han", "Hiragana", "Katakana"},
+	{"Han", "Bopomofo"},
+	{"Han", "Hangul"},
+}
+
+// excludedScripts may not be mixed with Latin in moderately restrictive
+// labels because of their many Latin lookalikes
+var excludedScripts = map[string]bool{"Cyrillic": true, "Greek": true, "Cherokee": true}
+
+// Restriction returns the UTS 39 restriction level of a label
+func Restriction(label string) RestrictionLevel {
+	if isASCII(label) {
+		return ASCIIOnly
+	}
+
+	scripts := Scripts(label)
+	if len(scripts) <= 1 || coveredBy(scripts, cjkSets...) {
+		return SingleScript
+	}
+
+	withLatin := make([][]string, len(cjkSets))
+	for i, set := range cjkSets {
+		withLatin[i] = append([]string{"Latin"}, set...)
+	}
+	if coveredBy(scripts, withLatin...) {
+		return HighlyRestrictive
+	}
+
+	if len(scripts) == 2 && contains(scripts, "Latin") {
+		other := scripts[0]
+		if other == "Latin" {
+			other = scripts[1]
+		}
+		if !excludedScripts[other] {
+			return ModeratelyRestrictive
+		}
+	}
+
+	return MinimallyRestrictive
+}
+
+// coveredBy reports whether every script is in one of the sets
+func coveredBy(scripts []string, sets ...[]string) bool {
+	for _, set := range sets {
+		covered := true
+		for _, s := range scripts {
+			if !contains(set, s) {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// HomographResult is the outcome of checking a host for homographs
+type HomographResult struct {
+	// Host is the host in Unicode form
+	Host string `json:"host"`
+
+	// RegistrableDomain is the registrable domain in Unicode form
+	RegistrableDomain string `json:"registrable_domain"`
+
+	// Label is the registrable domain label, e.g. "pаypal"
+	Label string `json:"label"`
+
+	// Skeleton is the confusable skeleton of Label
+	Skeleton string `json:"skeleton"`
+
+	// Scripts are the scripts used by Label
+	Scripts []string `json:"scripts"`
+
+	// Level is the restriction level of the least restrictive label
+	Level RestrictionLevel `json:"level"`
+
+	// MixedScript is true when Label mixes scripts
+	MixedScript bool `json:"mixed_script"`
+
+	// WholeScriptConfusable is true when Label is written entirely in a
+	// non-Latin script using only Latin lookalikes, such as Cyrillic "аре"
+	WholeScriptConfusable bool `json:"whole_script_confusable"`
+
+	// Lookalikes are the protected domains Label imitates
+	Lookalikes []string `json:"lookalikes,omitempty"`
+
+	// ShowUnicode is true when Host may be displayed in Unicode
+	ShowUnicode bool `json:"show_unicode"`
+
+	// Display is the form to show users: Unicode when ShowUnicode is true
+	// and ASCII (punycode) otherwise
+	Display string `json:"display"`
+}
+
+// Suspicious reports whether the host imitates a protected domain or would
+// be displayed as punycode
+func (r *HomographResult) Suspicious() bool {
+	return len(r.Lookalikes) > 0 || !r.ShowUnicode
+}
+
+// HomographChecker detects internationalized hosts that imitate protected
+// domains and decides how hosts should be displayed
+type HomographChecker struct {
+	fqdn      *FQDN
+	protected map[string][]protectedDomain
+}
+
+// protectedDomain is a domain that lookalikes are checked against
+type protectedDomain struct {
+	registrable string
+	label       string
+}
+
+// NewHomographChecker creates a checker flagging lookalikes of the
+// registrable domains of protected, e.g. "paypal.com". A nil manager uses
+// the global one.
+func NewHomographChecker(f *FQDN, protected []string) (*HomographChecker, error) {
+	if f == nil {
+		var err error
+		if f, err = Manager(); err != nil {
+			return nil, err
+		}
+	}
+
+	c := &HomographChecker{fqdn: f, protected: make(map[string][]protectedDomain)}
+
+	for _, domain := range protected {
+		_, registrable, label, err := c.fqdn.splitLabel(domain)
+		if err != nil {
+			return nil, wrapError(err, "invalid protected domain "+domain)
+		}
+
+		key := domainSkeleton(label)
+		c.protected[key] = append(c.protected[key], protectedDomain{registrable: registrable, label: label})
+	}
+
+	return c, nil
+}
+
+// splitLabel returns the Unicode host, registrable domain and registrable
+// label of a URL or host
+func (f *FQDN) splitLabel(srcURL string) (string, string, string, error) {
+	host, err := f.hostname(srcURL)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	unicode, err := idna.Lookup.ToUnicode(host)
+	if err != nil {
+		return "", "", "", wrapError(ErrInvalidURL, err.Error())
+	}
+
+	eTLD, registrable, err := f.splitHost(unicode, false)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return unicode, registrable, strings.TrimSuffix(registrable, "."+eTLD), nil
+}
+
+// Check inspects the registrable domain label of a URL or host for mixed
+// scripts, whole-script confusables and lookalikes of protected domains,
+// and applies a browser-like display policy: Unicode is shown only when
+// every label is at most moderately restrictive and the registrable label
+// is neither a whole-script confusable nor a lookalike.
+func (c *HomographChecker) Check(srcURL string) (*HomographResult, error) {
+	host, registrable, label, err := c.fqdn.splitLabel(srcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &HomographResult{
+		Host:              host,
+		RegistrableDomain: registrable,
+		Label:             label,
+		Skeleton:          domainSkeleton(label),
+		Scripts:           Scripts(label),
+	}
+	res.MixedScript = Restriction(label) > SingleScript
+
+	for _, l := range strings.Split(host, ".") {
+		res.Level = max(res.Level, Restriction(l))
+	}
+
+	res.WholeScriptConfusable = wholeScriptConfusable(label, res.Skeleton, host)
+
+	for _, p := range c.protected[res.Skeleton] {
+		if p.label != label {
+			res.Lookalikes = append(res.Lookalikes, p.registrable)
+		}
+	}
+
+	res.ShowUnicode = res.Level <= ModeratelyRestrictive && !res.WholeScriptConfusable && len(res.Lookalikes) == 0
+	res.Display = host
+	if !res.ShowUnicode {
+		res.Display, _ = idna.Lookup.ToASCII(host)
+	}
+
+	return res, nil
+}
+
+// wholeScriptConfusable reports whether a single non-Latin script label
+// consists only of Latin lookalikes. Labels under a top-level domain of
+// the same script, such as Cyrillic names under "рф", are exempt.
+func wholeScriptConfusable(label, skeleton, host string) bool {
+	scripts := Scripts(label)
+	if len(scripts) != 1 || scripts[0] == "Latin" {
+		return false
+	}
+
+	if !isASCII(skeleton) {
+		return false
+	}
+
+	tld := host[strings.LastIndexByte(host, '.')+1:]
+	tldScripts := Scripts(tld)
+	return len(tldScripts) != 1 || tldScripts[0] != scripts[0]
+}
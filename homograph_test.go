@@ -0,0 +1,173 @@
+// file: homograph_test.go
+// description: tests for homograph and confusable detection
+
+package gotld
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestSkeleton tests confusable skeletons
+func TestSkeleton(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"paypal", "p\u0430ypal", true},                   // Cyrillic а
+		{"epic", "\u0435\u0440\u0456\u0441", true},        // Cyrillic еріс
+		{"appie", "\u0430\u0440\u0440\u04cf\u0435", true}, // Cyrillic palochka is i
+		{"apple", "\u0430\u0440\u0440\u04cf\u0435", false},
+		{"google", "gοοgle", true}, // Greek ο
+		{"modern", "rnodern", true},
+		{"caf\u00e9", "cafe\u0301", true}, // precomposed é
+		{"paypal", "paypa1", true},
+		{"paypal", "paypol", false},
+		{"example", "exarnple", true},
+		{"paypal", "\uff50\uff41\uff59\uff50\uff41\uff4c", true}, // fullwidth
+	}
+
+	for _, tt := range tests {
+		if got := Skeleton(tt.a) == Skeleton(tt.b); got != tt.want {
+			t.Errorf("Skeleton(%q) == Skeleton(%q) = %v, want %v (%q, %q)", tt.a, tt.b, got, tt.want, Skeleton(tt.a), Skeleton(tt.b))
+		}
+	}
+
+	// Domain skeletons ignore case, so digit zero imitates o
+	if domainSkeleton("g00gle") != domainSkeleton("google") {
+		t.Errorf("domainSkeleton(g00gle) = %q, want %q", domainSkeleton("g00gle"), domainSkeleton("google"))
+	}
+}
+
+// TestRestriction tests UTS 39 restriction levels
+func TestRestriction(t *testing.T) {
+	tests := []struct {
+		label   string
+		level   RestrictionLevel
+		scripts []string
+	}{
+		{"example", ASCIIOnly, []string{"Latin"}},
+		{"bücher", SingleScript, []string{"Latin"}},
+		{"пример", SingleScript, []string{"Cyrillic"}},
+		{"日本語とカタカナ", SingleScript, []string{"Han", "Hiragana", "Katakana"}},
+		{"abc日本", HighlyRestrictive, []string{"Han", "Latin"}},
+		{"abcगणेश", ModeratelyRestrictive, []string{"Devanagari", "Latin"}},
+		{"p\u0430ypal", MinimallyRestrictive, []string{"Cyrillic", "Latin"}},
+		{"abα", MinimallyRestrictive, []string{"Greek", "Latin"}},
+	}
+
+	for _, tt := range tests {
+		if got := Restriction(tt.label); got != tt.level {
+			t.Errorf("Restriction(%q) = %v, want %v", tt.label, got, tt.level)
+		}
+		if got := Scripts(tt.label); !slices.Equal(got, tt.scripts) {
+			t.Errorf("Scripts(%q) = %v, want %v", tt.label, got, tt.scripts)
+		}
+	}
+}
+
+// TestHomographChecker tests lookalike detection and the display policy
+func TestHomographChecker(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	checker, err := NewHomographChecker(fqdn, []string{"paypal.com", "https://www.epic.com/", "google.co.uk"})
+	if err != nil {
+		t.Fatalf("NewHomographChecker() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		input       string
+		lookalikes  []string
+		mixed       bool
+		wholeScript bool
+		showUnicode bool
+		display     string
+	}{
+		{
+			name:        "Protected domain itself",
+			input:       "www.paypal.com",
+			showUnicode: true,
+			display:     "www.paypal.com",
+		},
+		{
+			name:       "Mixed script lookalike",
+			input:      "https://login.p\u0430ypal.com/",
+			lookalikes: []string{"paypal.com"},
+			mixed:      true,
+			display:    "login.xn--pypal-4ve.com",
+		},
+		{
+			name:       "Punycode input",
+			input:      "xn--pypal-4ve.com",
+			lookalikes: []string{"paypal.com"},
+			mixed:      true,
+			display:    "xn--pypal-4ve.com",
+		},
+		{
+			name:        "Whole script confusable",
+			input:       "\u0435\u0440\u0456\u0441.com",
+			lookalikes:  []string{"epic.com"},
+			wholeScript: true,
+			display:     "xn--e1awd7f.com",
+		},
+		{
+			name:       "ASCII lookalike on another suffix",
+			input:      "g00gle.com",
+			lookalikes: []string{"google.co.uk"},
+			display:    "g00gle.com",
+		},
+		{
+			name:        "Legitimate IDN",
+			input:       "bücher.de",
+			showUnicode: true,
+			display:     "bücher.de",
+		},
+		{
+			name:       "Cyrillic under a Cyrillic TLD",
+			input:      "\u0435\u0440\u0456\u0441.рф",
+			lookalikes: []string{"epic.com"},
+			display:    "xn--e1awd7f.xn--p1ai",
+		},
+		{
+			name:        "Cyrillic word",
+			input:       "пример.рф",
+			showUnicode: true,
+			display:     "пример.рф",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := checker.Check(tt.input)
+			if err != nil {
+				t.Fatalf("Check(%q) error = %v", tt.input, err)
+			}
+
+			if !slices.Equal(res.Lookalikes, tt.lookalikes) || res.MixedScript != tt.mixed ||
+				res.WholeScriptConfusable != tt.wholeScript || res.ShowUnicode != tt.showUnicode || res.Display != tt.display {
+				t.Errorf("Check(%q) = %+v", tt.input, res)
+			}
+
+			if res.Suspicious() == (tt.showUnicode && len(tt.lookalikes) == 0) {
+				t.Errorf("Suspicious() = %v", res.Suspicious())
+			}
+		})
+	}
+
+	if _, err := NewHomographChecker(fqdn, []string{"co.uk"}); err == nil {
+		t.Error("NewHomographChecker() accepted a public suffix")
+	}
+
+	// A nil manager uses the global one
+	global, err := NewHomographChecker(nil, []string{"paypal.com"})
+	if err != nil {
+		t.Fatalf("NewHomographChecker(nil) error = %v", err)
+	}
+	if res, err := global.Check("pаypal.com"); err != nil || len(res.Lookalikes) != 1 {
+		t.Errorf("Check() = %+v, %v, want a lookalike", res, err)
+	}
+}
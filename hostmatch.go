@@ -0,0 +1,136 @@
+// file: hostmatch.go
+// description: matches hosts against registrable domain, host and suffix patterns
+
+package gotld
+
+import (
+	"slices"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// HostMatcher matches hosts against a list of patterns. A pattern is a
+// registrable domain such as "example.com", which matches every host under
+// it, an exact host such as "api.example.com", or a suffix pattern such as
+// "*.example.com", which matches strict subdomains only. ValidateOrigin
+// uses the same rules.
+type HostMatcher struct {
+	fqdn     *FQDN
+	patterns []string
+	exact    map[string]string
+	suffixes []hostSuffix
+}
+
+// hostSuffix is a compiled suffix pattern
+type hostSuffix struct {
+	suffix  string
+	pattern string
+}
+
+// NewHostMatcher compiles patterns into a matcher and fails on the first
+// invalid pattern. Patterns are compared case-insensitively in ASCII
+// (punycode) form, so Unicode and punycode spellings are equivalent.
+func (f *FQDN) NewHostMatcher(patterns []string) (*HostMatcher, error) {
+	m, err := f.newHostMatcher(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// newHostMatcher compiles the valid patterns and returns the first invalid
+// one as an error
+func (f *FQDN) newHostMatcher(patterns []string) (*HostMatcher, error) {
+	m := &HostMatcher{fqdn: f, patterns: slices.Clone(patterns), exact: make(map[string]string)}
+
+	var firstErr error
+	for _, pattern := range patterns {
+		name, wildcard := strings.CutPrefix(pattern, "*.")
+
+		ascii, err := matchForm(name)
+		if err == nil && (ascii == "" || strings.Contains(ascii, "*")) {
+			err = ErrInvalidURL
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = wrapError(err, "invalid host pattern "+pattern)
+			}
+			continue
+		}
+
+		if wildcard {
+			m.suffixes = append(m.suffixes, hostSuffix{suffix: "." + ascii, pattern: pattern})
+		} else if _, ok := m.exact[ascii]; !ok {
+			m.exact[ascii] = pattern
+		}
+	}
+
+	return m, firstErr
+}
+
+// originMatcher returns a matcher for the patterns, reusing the one
+// compiled by the previous call when the patterns are the same, as
+// ValidateOrigin callers usually pass one list on every request
+func (f *FQDN) originMatcher(patterns []string) *HostMatcher {
+	if m := f.origins.Load(); m != nil && slices.Equal(m.patterns, patterns) {
+		return m
+	}
+
+	// Invalid entries never match, so the compile error is not needed
+	m, _ := f.newHostMatcher(patterns)
+	f.origins.Store(m)
+
+	return m
+}
+
+// Match reports whether the host of a URL or bare host matches a pattern
+// and returns the first pattern that does. Exact hosts and registrable
+// domains take precedence over suffix patterns.
+func (m *HostMatcher) Match(srcURL string) (string, bool) {
+	host, err := m.fqdn.hostname(srcURL)
+	if err != nil {
+		return "", false
+	}
+
+	registrable, err := m.fqdn.getFQDN(host)
+	if err != nil {
+		return "", false
+	}
+
+	return m.match(host, registrable)
+}
+
+// match matches a host and its registrable domain
+func (m *HostMatcher) match(host, registrable string) (string, bool) {
+	host, err := matchForm(host)
+	if err != nil {
+		return "", false
+	}
+
+	if pattern, ok := m.exact[host]; ok {
+		return pattern, true
+	}
+	if ascii, err := matchForm(registrable); err == nil {
+		if pattern, ok := m.exact[ascii]; ok {
+			return pattern, true
+		}
+	}
+
+	for _, s := range m.suffixes {
+		if strings.HasSuffix(host, s.suffix) {
+			return s.pattern, true
+		}
+	}
+
+	return "", false
+}
+
+// matchForm returns the lowercase ASCII form of a host without a trailing dot
+func matchForm(host string) (string, error) {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if isASCII(host) {
+		return host, nil
+	}
+	return idna.Lookup.ToASCII(host)
+}
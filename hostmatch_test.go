@@ -0,0 +1,81 @@
+// file: hostmatch_test.go
+// description: tests for host pattern matching
+
+package gotld
+
+import (
+	"testing"
+)
+
+// TestHostMatcher tests registrable domain, exact host and suffix patterns
+func TestHostMatcher(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	m, err := fqdn.NewHostMatcher([]string{"example.com", "api.partner.net", "*.cdn.org", "Bücher.DE", "*.xn--p1ai"})
+	if err != nil {
+		t.Fatalf("NewHostMatcher() error = %v", err)
+	}
+
+	tests := []struct {
+		input   string
+		pattern string
+	}{
+		{"https://example.com/", "example.com"},
+		{"https://deep.www.example.com/", "example.com"},
+		{"WWW.EXAMPLE.COM", "example.com"},
+		{"https://api.partner.net:8443/v1", "api.partner.net"},
+		{"https://www.partner.net/", ""},
+		{"https://x.api.partner.net/", ""},
+		{"https://img.cdn.org/", "*.cdn.org"},
+		{"https://a.b.cdn.org/", "*.cdn.org"},
+		{"https://cdn.org/", ""},
+		{"https://evilcdn.org/", ""},
+		{"https://www.xn--bcher-kva.de/", "Bücher.DE"},
+		{"https://пример.рф/", "*.xn--p1ai"},
+		{"https://example.com.evil.net/", ""},
+		{"not a host", ""},
+	}
+
+	for _, tt := range tests {
+		pattern, ok := m.Match(tt.input)
+		if pattern != tt.pattern || ok != (tt.pattern != "") {
+			t.Errorf("Match(%q) = %q, %v, want %q", tt.input, pattern, ok, tt.pattern)
+		}
+	}
+
+	for _, bad := range []string{"", "*", "*.", "ex*ample.com", "*.*.com"} {
+		if _, err := fqdn.NewHostMatcher([]string{bad}); err == nil {
+			t.Errorf("NewHostMatcher(%q) error = nil", bad)
+		}
+	}
+}
+
+// TestOriginMatcherCache tests that ValidateOrigin reuses compiled patterns
+func TestOriginMatcherCache(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	allowed := []string{"example.com", "*.cdn.org"}
+	if !fqdn.ValidateOrigin("https://www.example.com", allowed) {
+		t.Fatal("ValidateOrigin() = false for an allowed origin")
+	}
+
+	m := fqdn.origins.Load()
+	if !fqdn.ValidateOrigin("https://img.cdn.org", []string{"example.com", "*.cdn.org"}) || fqdn.origins.Load() != m {
+		t.Error("ValidateOrigin() recompiled an equal list")
+	}
+
+	// Changing the caller's slice must not affect the cached patterns
+	allowed[0] = "other.com"
+	if !fqdn.ValidateOrigin("https://www.other.com", allowed) || fqdn.ValidateOrigin("https://www.example.com", allowed) {
+		t.Error("ValidateOrigin() used stale patterns after the list changed")
+	}
+	if fqdn.origins.Load() == m {
+		t.Error("ValidateOrigin() did not compile the changed list")
+	}
+}
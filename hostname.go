@@ -0,0 +1,194 @@
+// file: hostname.go
+// description: syntax validation of host names
+
+package gotld
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// Length limits from RFC 1035 section 2.3.4
+const (
+	maxLabelLength    = 63
+	maxHostnameLength = 253
+)
+
+// HostnameMode selects how strictly host names are validated
+type HostnameMode int
+
+const (
+	// HostnameLDH accepts RFC 1123 host names of letters, digits and
+	// hyphens, plus internationalized labels that pass IDNA (RFC 5890)
+	HostnameLDH HostnameMode = iota
+
+	// HostnameService is HostnameLDH that also allows underscores, as
+	// used by service names such as "_dmarc.example.com"
+	HostnameService
+
+	// HostnameDNS accepts any DNS name (RFC 2181) and only enforces the
+	// length limits and non-empty labels
+	HostnameDNS
+)
+
+// String returns the name of the mode
+func (m HostnameMode) String() string {
+	switch m {
+	case HostnameLDH:
+		return "ldh"
+	case HostnameService:
+		return "service"
+	case HostnameDNS:
+		return "dns"
+	}
+	return "HostnameMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// HostnameError describes why a host name failed validation. It matches
+// both ErrInvalidHostname and ErrInvalidURL with errors.Is.
+type HostnameError struct {
+	// Host is the host name that was validated
+	Host string
+
+	// Label is the offending label, empty when the whole name failed
+	Label string
+
+	// Index is the position of Label counting from 0 at the left, or -1
+	Index int
+
+	// Reason explains the failure
+	Reason string
+}
+
+// Error implements error
+func (e *HostnameError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("%v %q: %s", ErrInvalidHostname, e.Host, e.Reason)
+	}
+	return fmt.Sprintf("%v %q: label %d %q: %s", ErrInvalidHostname, e.Host, e.Index, e.Label, e.Reason)
+}
+
+// Unwrap returns the sentinel errors matched by the error
+func (e *HostnameError) Unwrap() []error {
+	return []error{ErrInvalidHostname, ErrInvalidURL}
+}
+
+// ValidateHostname checks the syntax of host according to mode. A single
+// trailing dot marking an absolute name is allowed. Lengths are measured on
+// the ASCII (punycode) form of internationalized names.
+func ValidateHostname(host string, mode HostnameMode) error {
+	fail := func(index int, label, reason string) error {
+		return &HostnameError{Host: host, Label: label, Index: index, Reason: reason}
+	}
+
+	name := strings.TrimSuffix(host, ".")
+	if name == "" {
+		return fail(-1, "", "empty host name")
+	}
+
+	labels := strings.Split(name, ".")
+	length := len(labels) - 1
+
+	for i, label := range labels {
+		if label == "" {
+			return fail(i, label, "empty label")
+		}
+
+		ascii := label
+		if mode != HostnameDNS {
+			var err error
+			if ascii, err = checkLabel(label, mode); err != nil {
+				return fail(i, label, err.Error())
+			}
+		}
+
+		if len(ascii) > maxLabelLength {
+			return fail(i, label, fmt.Sprintf("label is %d octets, limit is %d", len(ascii), maxLabelLength))
+		}
+		length += len(ascii)
+	}
+
+	if length > maxHostnameLength {
+		return fail(-1, "", fmt.Sprintf("name is %d octets, limit is %d", length, maxHostnameLength))
+	}
+
+	// RFC 3696 section 2: a top-level domain is never all numeric
+	if mode != HostnameDNS && len(labels) > 1 && isDigits(labels[len(labels)-1]) {
+		return fail(len(labels)-1, labels[len(labels)-1], "top-level label is all numeric")
+	}
+
+	return nil
+}
+
+// checkLabel validates a single label in LDH or service mode and returns
+// its ASCII form
+func checkLabel(label string, mode HostnameMode) (string, error) {
+	// Internationalized labels must convert to valid A-labels
+	if !isASCII(label) {
+		ascii, err := idna.Lookup.ToASCII(label)
+		if err != nil {
+			return "", fmt.Errorf("invalid internationalized label: %w", err)
+		}
+		return ascii, nil
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-':
+		case c == '_' && mode == HostnameService:
+		case c == '_':
+			return "", fmt.Errorf("underscore at offset %d is only allowed in service names", i)
+		default:
+			return "", fmt.Errorf("invalid character %q at offset %d", c, i)
+		}
+	}
+
+	if label[0] == '-' {
+		return "", errors.New("begins with a hyphen")
+	}
+	if label[len(label)-1] == '-' {
+		return "", errors.New("ends with a hyphen")
+	}
+
+	// RFC 5891 section 4.2.3.1: hyphens in the third and fourth position
+	// are reserved for A-labels, which must decode
+	if len(label) >= 4 && label[2:4] == "--" {
+		if !strings.EqualFold(label[:2], "xn") {
+			return "", errors.New("hyphens in third and fourth position are reserved")
+		}
+		if _, err := idna.Lookup.ToUnicode(strings.ToLower(label)); err != nil {
+			return "", fmt.Errorf("invalid A-label: %w", err)
+		}
+	}
+
+	return label, nil
+}
+
+// isASCII reports whether s contains only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateHostname checks host with the mode configured in the options
+func (f *FQDN) ValidateHostname(host string) error {
+	return ValidateHostname(host, f.Options.HostnameMode)
+}
+
+// checkHostname validates host before a lookup when the options ask for it
+func (f *FQDN) checkHostname(host string) error {
+	if !f.Options.ValidateHostnames {
+		return nil
+	}
+	return ValidateHostname(host, f.Options.HostnameMode)
+}
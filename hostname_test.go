@@ -0,0 +1,113 @@
+// file: hostname_test.go
+// description: tests for host name validation
+
+package gotld
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// TestValidateHostname tests host name syntax in each mode
+func TestValidateHostname(t *testing.T) {
+	long := strings.Repeat("a", 63)
+	tooLong := strings.Join([]string{long, long, long, long}, ".") + ".com"
+
+	tests := []struct {
+		name  string
+		host  string
+		mode  HostnameMode
+		index int
+		valid bool
+	}{
+		{name: "Simple", host: "www.example.com", mode: HostnameLDH, valid: true},
+		{name: "Absolute", host: "www.example.com.", mode: HostnameLDH, valid: true},
+		{name: "Digits and hyphens", host: "a-1.b2.example.com", mode: HostnameLDH, valid: true},
+		{name: "63 octet label", host: long + ".com", mode: HostnameLDH, valid: true},
+		{name: "U-label", host: "bücher.example", mode: HostnameLDH, valid: true},
+		{name: "A-label", host: "xn--bcher-kva.example", mode: HostnameLDH, valid: true},
+		{name: "Empty", host: "", mode: HostnameLDH, index: -1},
+		{name: "Empty label", host: "www..example.com", mode: HostnameLDH, index: 1},
+		{name: "Leading dot", host: ".example.com", mode: HostnameLDH, index: 0},
+		{name: "64 octet label", host: long + "a.com", mode: HostnameLDH, index: 0},
+		{name: "Name too long", host: tooLong, mode: HostnameLDH, index: -1},
+		{name: "Leading hyphen", host: "-www.example.com", mode: HostnameLDH, index: 0},
+		{name: "Trailing hyphen", host: "www.example-.com", mode: HostnameLDH, index: 1},
+		{name: "Reserved hyphens", host: "ab--cd.example.com", mode: HostnameLDH, index: 0},
+		{name: "Invalid A-label", host: "xn--a.example.com", mode: HostnameLDH, index: 0},
+		{name: "Underscore", host: "_dmarc.example.com", mode: HostnameLDH, index: 0},
+		{name: "Space", host: "www example.com", mode: HostnameLDH, index: 0},
+		{name: "Numeric TLD", host: "example.123", mode: HostnameLDH, index: 1},
+		{name: "Service underscore", host: "_sip._tcp.example.com", mode: HostnameService, valid: true},
+		{name: "Service leading hyphen", host: "-sip.example.com", mode: HostnameService, index: 0},
+		{name: "DNS any octets", host: "a b!._x.example.com", mode: HostnameDNS, valid: true},
+		{name: "DNS empty label", host: "a..com", mode: HostnameDNS, index: 1},
+		{name: "DNS label too long", host: long + "a.com", mode: HostnameDNS, index: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHostname(tt.host, tt.mode)
+			if tt.valid {
+				if err != nil {
+					t.Errorf("ValidateHostname(%q, %v) error = %v", tt.host, tt.mode, err)
+				}
+				return
+			}
+
+			var herr *HostnameError
+			if !errors.As(err, &herr) {
+				t.Fatalf("ValidateHostname(%q, %v) error = %v, want HostnameError", tt.host, tt.mode, err)
+			}
+
+			if herr.Index != tt.index {
+				t.Errorf("Index = %d, want %d (%v)", herr.Index, tt.index, err)
+			}
+
+			if !errors.Is(err, ErrInvalidHostname) || !errors.Is(err, ErrInvalidURL) {
+				t.Errorf("error %v does not match ErrInvalidHostname and ErrInvalidURL", err)
+			}
+		})
+	}
+}
+
+// TestHostnameErrorMessage tests that errors name the failing label
+func TestHostnameErrorMessage(t *testing.T) {
+	err := ValidateHostname("www.-bad.example.com", HostnameLDH)
+	want := `invalid hostname "www.-bad.example.com": label 1 "-bad": begins with a hyphen`
+	if err == nil || err.Error() != want {
+		t.Errorf("error = %v, want %s", err, want)
+	}
+}
+
+// TestGetFQDNValidateHostnames tests the optional check before lookups
+func TestGetFQDNValidateHostnames(t *testing.T) {
+	lenient, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	strict, err := newFQDN(&Options{ValidateHostnames: true})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	host := "bad_host.example.com"
+	if got, err := lenient.GetFQDN(host); err != nil || got != "example.com" {
+		t.Errorf("GetFQDN() = %v, %v, want example.com", got, err)
+	}
+
+	if _, err := strict.GetFQDN(host); !errors.Is(err, ErrInvalidHostname) {
+		t.Errorf("GetFQDN() error = %v, want %v", err, ErrInvalidHostname)
+	}
+
+	if _, err := strict.Parse("https://" + host + "/"); !errors.Is(err, ErrInvalidHostname) {
+		t.Errorf("Parse() error = %v, want %v", err, ErrInvalidHostname)
+	}
+
+	service, _ := newFQDN(&Options{ValidateHostnames: true, HostnameMode: HostnameService})
+	if got, err := service.GetFQDN(host); err != nil || got != "example.com" {
+		t.Errorf("GetFQDN() = %v, %v, want example.com", got, err)
+	}
+}
@@ -0,0 +1,172 @@
+// file: integrity.go
+// description: integrity verification of loaded public suffix lists
+
+package gotld
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+)
+
+// Integrity configures optional verification of loaded lists. Every check
+// that is configured must pass before a list replaces the active one.
+type Integrity struct {
+	// SHA256 lists the accepted hex encoded digests of the list
+	SHA256 []string
+
+	// PublicKey verifies a detached Ed25519 signature of the list
+	PublicKey ed25519.PublicKey
+
+	// Signature provides the detached signature, either raw or base64
+	// encoded; required when PublicKey is set
+	Signature Source
+
+	// RejectRollback refuses lists whose VERSION header is older than the
+	// list currently loaded, or that have no VERSION header at all. Versions
+	// are compared as dates; headers in another format are refused.
+	RejectRollback bool
+}
+
+// listVersionLayout is the time layout of the VERSION header
+const listVersionLayout = "2006-01-02_15-04-05_MST"
+
+// verify checks the digest and signature of list data
+func (i *Integrity) verify(ctx context.Context, data []byte) error {
+	if i == nil {
+		return nil
+	}
+
+	if len(i.SHA256) > 0 {
+		sum := sha256.Sum256(data)
+		digest := hex.EncodeToString(sum[:])
+
+		pinned := false
+		for _, want := range i.SHA256 {
+			if strings.EqualFold(strings.TrimSpace(want), digest) {
+				pinned = true
+				break
+			}
+		}
+
+		if !pinned {
+			return wrapError(ErrPublicSuffixIntegrity, "digest "+digest+" is not pinned")
+		}
+	}
+
+	if len(i.PublicKey) > 0 {
+		if err := i.verifySignature(ctx, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRollback refuses a list whose VERSION header is missing, cannot be
+// compared or is older than current. It runs under the lock that installs
+// the list.
+func (i *Integrity) checkRollback(version, current string) error {
+	if i == nil || !i.RejectRollback {
+		return nil
+	}
+
+	if version == "" {
+		return wrapError(ErrPublicSuffixIntegrity, "list has no version")
+	}
+
+	t, err := parseListVersion(version)
+	if err != nil {
+		return wrapError(ErrPublicSuffixIntegrity, "list version "+version+" is not a date")
+	}
+
+	// The active list may predate rollback protection and have no version
+	if current == "" {
+		return nil
+	}
+
+	c, err := parseListVersion(current)
+	if err != nil {
+		return wrapError(ErrPublicSuffixIntegrity, "loaded version "+current+" is not a date")
+	}
+
+	if t.Before(c) {
+		return wrapError(ErrPublicSuffixIntegrity, "list version "+version+" is older than "+current)
+	}
+
+	return nil
+}
+
+// parseListVersion parses a VERSION header such as "2025-01-01_00-00-00_UTC"
+func parseListVersion(version string) (time.Time, error) {
+	return time.Parse(listVersionLayout, version)
+}
+
+// verifySignature checks the detached Ed25519 signature of data
+func (i *Integrity) verifySignature(ctx context.Context, data []byte) error {
+	if len(i.PublicKey) != ed25519.PublicKeySize {
+		return wrapError(ErrPublicSuffixIntegrity, "invalid public key")
+	}
+
+	if i.Signature == nil {
+		return wrapError(ErrPublicSuffixIntegrity, "no signature source provided")
+	}
+
+	rc, _, err := i.Signature.Open(ctx)
+	if err != nil {
+		return wrapError(ErrPublicSuffixIntegrity, err.Error())
+	}
+	defer rc.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(rc, 1024))
+	if err != nil {
+		return wrapError(ErrPublicSuffixIntegrity, err.Error())
+	}
+
+	// Accept raw signatures as well as base64 encoded ones
+	sig := raw
+	if len(raw) != ed25519.SignatureSize {
+		sig, err = base64.StdEncoding.DecodeString(string(bytes.TrimSpace(raw)))
+		if err != nil {
+			return wrapError(ErrPublicSuffixIntegrity, "malformed signature")
+		}
+	}
+
+	if !ed25519.Verify(i.PublicKey, data, sig) {
+		return wrapError(ErrPublicSuffixIntegrity, "signature mismatch")
+	}
+
+	return nil
+}
+
+// listHeader returns the value of a "// KEY: value" header line
+func listHeader(data []byte, key string) string {
+	prefix := "// " + key + ":"
+
+	for len(data) > 0 {
+		line := data
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			line, data = data[:i], data[i+1:]
+		} else {
+			data = nil
+		}
+
+		line = bytes.TrimSpace(line)
+		if bytes.HasPrefix(line, []byte(prefix)) {
+			return strings.TrimSpace(string(line[len(prefix):]))
+		}
+
+		// Headers only appear before the first section
+		if bytes.Contains(line, []byte("===BEGIN ")) {
+			break
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,208 @@
+// file: integrity_test.go
+// description: tests for integrity verification of loaded lists
+
+package gotld
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+)
+
+// TestIntegrity tests digest pinning and signature verification
+func TestIntegrity(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	sum := sha256.Sum256([]byte(testList))
+	digest := hex.EncodeToString(sum[:])
+	sig := ed25519.Sign(priv, []byte(testList))
+	badSig := ed25519.Sign(priv, []byte("something else"))
+
+	tests := []struct {
+		name      string
+		integrity *Integrity
+		wantErr   bool
+	}{
+		{
+			name:      "Pinned digest",
+			integrity: &Integrity{SHA256: []string{"00", strings.ToUpper(digest)}},
+		},
+		{
+			name:      "Unpinned digest",
+			integrity: &Integrity{SHA256: []string{"00"}},
+			wantErr:   true,
+		},
+		{
+			name:      "Raw signature",
+			integrity: &Integrity{PublicKey: pub, Signature: NewReaderSource(bytes.NewReader(sig))},
+		},
+		{
+			name:      "Base64 signature",
+			integrity: &Integrity{PublicKey: pub, Signature: NewReaderSource(strings.NewReader(base64.StdEncoding.EncodeToString(sig) + "\n"))},
+		},
+		{
+			name:      "Bad signature",
+			integrity: &Integrity{PublicKey: pub, Signature: NewReaderSource(bytes.NewReader(badSig))},
+			wantErr:   true,
+		},
+		{
+			name:      "Missing signature",
+			integrity: &Integrity{PublicKey: pub},
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &Options{
+				Source:    NewReaderSource(strings.NewReader(testList)),
+				Integrity: tt.integrity,
+			}
+
+			_, err := newFQDN(opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newFQDN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr && !errors.Is(err, ErrPublicSuffixIntegrity) {
+				t.Errorf("newFQDN() error = %v, want %v", err, ErrPublicSuffixIntegrity)
+			}
+		})
+	}
+}
+
+// TestRollbackProtection tests that older lists are refused on reload
+func TestRollbackProtection(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.dat")
+	if err := os.WriteFile(path, []byte(testList), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	fqdn, err := newFQDN(&Options{
+		Source:    NewFileSource(path),
+		Integrity: &Integrity{RejectRollback: true},
+	})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	if fqdn.source.Version != "2025-01-01_00-00-00_UTC" {
+		t.Errorf("Version = %q, want list header value", fqdn.source.Version)
+	}
+
+	// An older list without github.io must be refused
+	older := strings.Replace(testList, "2025-01-01", "2024-01-01", 1)
+	older = strings.Replace(older, "github.io\n", "", 1)
+	if err := os.WriteFile(path, []byte(older), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	if err := fqdn.Reload(); !errors.Is(err, ErrPublicSuffixIntegrity) {
+		t.Fatalf("Reload() error = %v, want %v", err, ErrPublicSuffixIntegrity)
+	}
+
+	// The previous list stays active
+	fqdn.Options.AllowPrivateTLDs = true
+	if got, err := fqdn.GetFQDN("user.github.io"); err != nil || got != "user.github.io" {
+		t.Errorf("GetFQDN() = %v, %v, want user.github.io", got, err)
+	}
+
+	// A newer list is accepted
+	newer := strings.Replace(testList, "2025-01-01", "2026-01-01", 1)
+	if err := os.WriteFile(path, []byte(newer), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	if err := fqdn.Reload(); err != nil {
+		t.Errorf("Reload() error = %v", err)
+	}
+}
+
+// TestRollbackSourceVersion tests that rollback protection compares list
+// headers, not the version a source reports for itself
+func TestRollbackSourceVersion(t *testing.T) {
+	fsys := fstest.MapFS{"list.dat": {Data: []byte(testList)}}
+	src := &EmbeddedSource{FS: fsys, Name: "list.dat", Version: "bundled"}
+
+	fqdn, err := newFQDN(&Options{
+		Source:    src,
+		Integrity: &Integrity{RejectRollback: true},
+	})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	status := fqdn.Status()
+	if status.Source.Version != "bundled" || status.Version != "2025-01-01_00-00-00_UTC" {
+		t.Errorf("Source.Version, Version = %q, %q, want source and header values", status.Source.Version, status.Version)
+	}
+
+	// Reloading the same source succeeds
+	if err := fqdn.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	// An older list from it is still refused
+	fsys["list.dat"] = &fstest.MapFile{Data: []byte(strings.Replace(testList, "2025-01-01", "2024-01-01", 1))}
+	if err := fqdn.Reload(); !errors.Is(err, ErrPublicSuffixIntegrity) {
+		t.Errorf("Reload() error = %v, want %v", err, ErrPublicSuffixIntegrity)
+	}
+}
+
+// TestRollbackVersionFormat tests that versions that are not dates are refused
+func TestRollbackVersionFormat(t *testing.T) {
+	list := strings.Replace(testList, "2025-01-01_00-00-00_UTC", "v10", 1)
+
+	_, err := newFQDN(&Options{
+		Source:    NewReaderSource(strings.NewReader(list)),
+		Integrity: &Integrity{RejectRollback: true},
+	})
+	if !errors.Is(err, ErrPublicSuffixIntegrity) {
+		t.Errorf("newFQDN() error = %v, want %v", err, ErrPublicSuffixIntegrity)
+	}
+
+	// Dates compare by time, not as text
+	if err := (&Integrity{RejectRollback: true}).checkRollback("2025-02-01_00-00-00_UTC", "2025-01-31_23-00-00_UTC"); err != nil {
+		t.Errorf("checkRollback() error = %v", err)
+	}
+}
+
+// TestRollbackConcurrent tests that concurrent loads never install an
+// older list over a newer one
+func TestRollbackConcurrent(t *testing.T) {
+	fqdn, err := newFQDN(&Options{
+		Source:    NewReaderSource(strings.NewReader(testList)),
+		Integrity: &Integrity{RejectRollback: true},
+	})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for year := 2026; year < 2046; year++ {
+		wg.Add(1)
+		go func(year int) {
+			defer wg.Done()
+			list := strings.Replace(testList, "2025-01-01", fmt.Sprintf("%d-01-01", year), 1)
+			fqdn.loadSource(NewReaderSource(strings.NewReader(list)), "refresh")
+		}(year)
+	}
+	wg.Wait()
+
+	if got := fqdn.Status().Version; got != "2045-01-01_00-00-00_UTC" {
+		t.Errorf("Version = %q, want the newest list", got)
+	}
+}
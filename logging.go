@@ -0,0 +1,96 @@
+// file: logging.go
+// description: structured logging of manager events via log/slog
+
+package gotld
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// logEvent writes a log record to the configured logger, if any
+func (f *FQDN) logEvent(level slog.Level, msg string, attrs ...slog.Attr) {
+	logger := f.Options.Logger
+	if logger == nil {
+		return
+	}
+
+	ctx := f.Options.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// logLoad records the outcome of a load or refresh attempt
+func (f *FQDN) logLoad(event string, info SourceInfo, duration time.Duration, counts RuleCounts, err error) {
+	attrs := []slog.Attr{
+		slog.String("event", event),
+		slog.String("source", info.Name),
+		slog.String("version", info.Version),
+		slog.Duration("duration", duration),
+	}
+
+	if err != nil {
+		f.logEvent(slog.LevelError, "public suffix list "+event+" failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+
+	f.logEvent(slog.LevelInfo, "public suffix list "+event+" succeeded", append(attrs, slog.Any("rules", counts))...)
+}
+
+// LogValue implements slog.LogValuer
+func (s SourceInfo) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", s.Name),
+		slog.String("version", s.Version),
+		slog.String("etag", s.ETag),
+		slog.Time("fetched_at", s.FetchedAt),
+		slog.Bool("fallback", s.Fallback),
+	)
+}
+
+// LogValue implements slog.LogValuer
+func (c RuleCounts) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("total", c.Total),
+		slog.Int("icann", c.ICANN),
+		slog.Int("private", c.Private),
+		slog.Int("normal", c.Normal),
+		slog.Int("wildcard", c.Wildcard),
+		slog.Int("exception", c.Exception),
+	)
+}
+
+// LogValue implements slog.LogValuer
+func (r *ParseResult) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("host", r.Host),
+		slog.String("subdomain", r.Subdomain),
+		slog.String("domain", r.Domain),
+		slog.String("tld", r.TLD),
+		slog.String("registrable_domain", r.RegistrableDomain),
+	)
+}
+
+// LogValue implements slog.LogValuer
+func (s Status) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("source", s.Source.Name),
+		slog.String("version", s.Version),
+		slog.String("commit", s.Commit),
+		slog.Any("rules", s.Rules),
+		slog.Bool("builtin", s.Builtin),
+		slog.Bool("fallback", s.Fallback),
+		slog.Time("loaded_at", s.LoadedAt),
+		slog.Time("last_refresh", s.LastRefresh),
+	}
+
+	if s.LastError != nil {
+		attrs = append(attrs, slog.String("last_error", s.LastError.Error()))
+	}
+
+	return slog.GroupValue(attrs...)
+}
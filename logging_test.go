@@ -0,0 +1,110 @@
+// file: logging_test.go
+// description: tests for structured logging of manager events
+
+package gotld
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// logRecords decodes JSON log lines
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]any
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		records = append(records, rec)
+	}
+
+	return records
+}
+
+// TestLogging tests load, refresh, fallback and parse warning events
+func TestLogging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	list := strings.Replace(testList, "// ===BEGIN ICANN", "stray.rule\n// ===BEGIN ICANN", 1)
+	fqdn, err := newFQDN(&Options{Source: NewReaderSource(strings.NewReader(list)), Logger: logger})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	// The reader is consumed, so a refresh fails
+	fqdn.Options.Source = NewReaderSource(strings.NewReader(""))
+	if err := fqdn.Reload(); err == nil {
+		t.Fatal("Reload() succeeded with an empty list")
+	}
+
+	_, err = newFQDN(&Options{PublicSuffixFile: t.TempDir() + "/missing.dat", FallbackToBuiltin: true, Logger: logger})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	records := logRecords(t, &buf)
+	want := []struct {
+		level, msg string
+	}{
+		{"WARN", "ignored malformed or unsectioned public suffix rules"},
+		{"INFO", "public suffix list load succeeded"},
+		{"ERROR", "public suffix list refresh failed"},
+		{"ERROR", "public suffix list load failed"},
+		{"WARN", "using built-in public suffix list"},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("got %d log records, want %d:\n%s", len(records), len(want), buf.String())
+	}
+
+	for i, w := range want {
+		if records[i]["level"] != w.level || records[i]["msg"] != w.msg {
+			t.Errorf("record %d = %v %q, want %v %q", i, records[i]["level"], records[i]["msg"], w.level, w.msg)
+		}
+	}
+
+	loaded := records[1]
+	if loaded["source"] != "reader" || loaded["version"] != "2025-01-01_00-00-00_UTC" {
+		t.Errorf("load record attributes = %v", loaded)
+	}
+
+	rules, ok := loaded["rules"].(map[string]any)
+	if !ok || rules["total"] != float64(9) || rules["wildcard"] != float64(1) {
+		t.Errorf("load record rules = %v", loaded["rules"])
+	}
+
+	if _, ok := records[2]["error"]; !ok {
+		t.Errorf("refresh failure has no error attribute: %v", records[2])
+	}
+
+	// Status logs as a group
+	buf.Reset()
+	logger.Info("status", "status", fqdn.Status())
+	status, ok := logRecords(t, &buf)[0]["status"].(map[string]any)
+	if !ok || status["source"] != "reader" || status["last_error"] == nil {
+		t.Errorf("status record = %v", status)
+	}
+
+	// So do parse results
+	res, err := fqdn.Parse("https://www.example.co.uk/path")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	buf.Reset()
+	logger.Info("parsed", "result", res)
+	parsed, ok := logRecords(t, &buf)[0]["result"].(map[string]any)
+	if !ok || parsed["host"] != "www.example.co.uk" || parsed["registrable_domain"] != "example.co.uk" || parsed["tld"] != "co.uk" {
+		t.Errorf("parse record = %v", parsed)
+	}
+}
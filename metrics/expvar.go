@@ -0,0 +1,16 @@
+// file: metrics/expvar.go
+// description: publishes gotld metrics through expvar
+
+package metrics
+
+import (
+	"expvar"
+)
+
+// Publish exposes the metrics snapshot as an expvar variable. Like
+// expvar.Publish it panics if name is already in use.
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return m.Snapshot()
+	}))
+}
@@ -0,0 +1,151 @@
+// file: metrics/metrics.go
+// description: lookup, error and list freshness metrics for gotld
+
+// Package metrics instruments a gotld FQDN manager. A Metrics value is set
+// as Options.Observer and can be published through expvar or registered
+// with a Prometheus registry.
+package metrics
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// Error reasons used to label lookup failures
+const (
+	ReasonInvalidURL      = "invalid_url"
+	ReasonInvalidHostname = "invalid_hostname"
+	ReasonInvalidTLD      = "invalid_tld"
+	ReasonOther           = "other"
+)
+
+// reasons lists the error reasons in a fixed order
+var reasons = [...]string{ReasonInvalidURL, ReasonInvalidHostname, ReasonInvalidTLD, ReasonOther}
+
+// DefaultBuckets are the lookup latency histogram bounds in seconds
+var DefaultBuckets = []float64{1e-6, 5e-6, 1e-5, 5e-5, 1e-4, 5e-4, 1e-3, 1e-2}
+
+// Metrics collects instrumentation events from an FQDN manager
+type Metrics struct {
+	lookups   atomic.Uint64
+	errors    [len(reasons)]atomic.Uint64
+	buckets   []float64
+	counts    []atomic.Uint64
+	sumNanos  atomic.Uint64
+	loadsOK   atomic.Uint64
+	loadsFail atomic.Uint64
+	lastOK    atomic.Int64
+	manager   atomic.Pointer[gotld.FQDN]
+}
+
+// New creates a Metrics value using DefaultBuckets
+func New() *Metrics {
+	return NewWithBuckets(DefaultBuckets)
+}
+
+// NewWithBuckets creates a Metrics value with custom latency buckets in
+// seconds, which must be sorted in increasing order
+func NewWithBuckets(buckets []float64) *Metrics {
+	return &Metrics{
+		buckets: buckets,
+		counts:  make([]atomic.Uint64, len(buckets)+1),
+	}
+}
+
+// Attach sets the manager whose list age and rule counts are reported
+func (m *Metrics) Attach(f *gotld.FQDN) {
+	m.manager.Store(f)
+}
+
+// ObserveLookup records a lookup
+func (m *Metrics) ObserveLookup(duration time.Duration, err error) {
+	m.lookups.Add(1)
+	m.sumNanos.Add(uint64(duration))
+
+	secs := duration.Seconds()
+	i := 0
+	for i < len(m.buckets) && secs > m.buckets[i] {
+		i++
+	}
+	m.counts[i].Add(1)
+
+	if err != nil {
+		m.errors[reasonIndex(err)].Add(1)
+	}
+}
+
+// ObserveLoad records a list load or refresh attempt
+func (m *Metrics) ObserveLoad(info gotld.SourceInfo, duration time.Duration, err error) {
+	if err != nil {
+		m.loadsFail.Add(1)
+		return
+	}
+
+	m.loadsOK.Add(1)
+	m.lastOK.Store(time.Now().Unix())
+}
+
+// Snapshot is a point in time copy of the metrics
+type Snapshot struct {
+	Lookups         uint64            `json:"lookups"`
+	Errors          map[string]uint64 `json:"errors"`
+	LatencyBuckets  []float64         `json:"latency_buckets"`
+	LatencyCounts   []uint64          `json:"latency_counts"`
+	LatencySum      float64           `json:"latency_sum_seconds"`
+	LoadSuccesses   uint64            `json:"load_successes"`
+	LoadFailures    uint64            `json:"load_failures"`
+	LastLoadSuccess int64             `json:"last_load_success_unix"`
+	ListAge         *float64          `json:"list_age_seconds,omitempty"`
+	Rules           gotld.RuleCounts  `json:"rules"`
+}
+
+// Snapshot returns the current metrics. LatencyCounts are per bucket, not
+// cumulative, with a final entry for observations above the last bound.
+func (m *Metrics) Snapshot() Snapshot {
+	s := Snapshot{
+		Lookups:         m.lookups.Load(),
+		Errors:          make(map[string]uint64, len(reasons)),
+		LatencyBuckets:  m.buckets,
+		LatencyCounts:   make([]uint64, len(m.counts)),
+		LatencySum:      time.Duration(m.sumNanos.Load()).Seconds(),
+		LoadSuccesses:   m.loadsOK.Load(),
+		LoadFailures:    m.loadsFail.Load(),
+		LastLoadSuccess: m.lastOK.Load(),
+	}
+
+	for i, reason := range reasons {
+		s.Errors[reason] = m.errors[i].Load()
+	}
+
+	for i := range m.counts {
+		s.LatencyCounts[i] = m.counts[i].Load()
+	}
+
+	if f := m.manager.Load(); f != nil {
+		status := f.Status()
+		if age, ok := status.Age(time.Now()); ok {
+			seconds := age.Seconds()
+			s.ListAge = &seconds
+		}
+		s.Rules = status.Rules
+	}
+
+	return s
+}
+
+// reasonIndex maps an error to its position in reasons
+func reasonIndex(err error) int {
+	switch {
+	// Hostname errors also match ErrInvalidURL
+	case errors.Is(err, gotld.ErrInvalidHostname):
+		return 1
+	case errors.Is(err, gotld.ErrInvalidURL):
+		return 0
+	case errors.Is(err, gotld.ErrInvalidTLD):
+		return 2
+	}
+	return 3
+}
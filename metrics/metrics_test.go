@@ -0,0 +1,131 @@
+// file: metrics/metrics_test.go
+// description: tests for gotld metrics
+
+package metrics
+
+import (
+	"encoding/json"
+	"expvar"
+	"strings"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// newManager creates a manager instrumented by m
+func newManager(t *testing.T, m *Metrics) *gotld.FQDN {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{Observer: m})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	m.Attach(f)
+
+	return f
+}
+
+// TestPrometheusCollector tests the collected counters
+func TestPrometheusCollector(t *testing.T) {
+	m := New()
+	f, err := gotld.New(&gotld.Options{Observer: m, ValidateHostnames: true, HostnameMode: gotld.HostnameLDH})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	m.Attach(f)
+
+	for _, url := range []string{"example.com", "www.example.co.uk", "invalid", "example.invalidtld", "under_score.example.com"} {
+		_, _ = f.GetFQDN(url)
+	}
+
+	// Every entry point is counted, not just GetFQDN
+	_, _ = f.Parse("https://www.example.org/path")
+	_, _ = f.Parents("a.b.example.net")
+	matcher, err := f.NewHostMatcher([]string{"example.com"})
+	if err != nil {
+		t.Fatalf("NewHostMatcher() error = %v", err)
+	}
+	_, _ = matcher.Match("api.example.com")
+	_, _ = f.ParseEmail("user@example.invalidtld")
+
+	expected := `
+# HELP gotld_lookup_errors_total Total number of failed FQDN lookups by reason.
+# TYPE gotld_lookup_errors_total counter
+gotld_lookup_errors_total{reason="invalid_hostname"} 1
+gotld_lookup_errors_total{reason="invalid_tld"} 2
+gotld_lookup_errors_total{reason="invalid_url"} 1
+gotld_lookup_errors_total{reason="other"} 0
+# HELP gotld_lookups_total Total number of FQDN lookups.
+# TYPE gotld_lookups_total counter
+gotld_lookups_total 9
+`
+	if err := testutil.CollectAndCompare(m, strings.NewReader(expected), "gotld_lookups_total", "gotld_lookup_errors_total"); err != nil {
+		t.Error(err)
+	}
+
+	if n := testutil.CollectAndCount(m, "gotld_lookup_duration_seconds"); n != 1 {
+		t.Errorf("histogram count = %d, want 1", n)
+	}
+
+	if n := testutil.CollectAndCount(m, "gotld_list_rules_by_type"); n != 3 {
+		t.Errorf("rule type series = %d, want 3", n)
+	}
+
+	// The built-in table is not loaded from a source
+	loads := `
+# HELP gotld_list_loads_total Total number of list load and refresh attempts by result.
+# TYPE gotld_list_loads_total counter
+gotld_list_loads_total{result="failure"} 0
+gotld_list_loads_total{result="success"} 0
+`
+	if err := testutil.CollectAndCompare(m, strings.NewReader(loads), "gotld_list_loads_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestLoadsAndExpvar tests load outcomes and the expvar snapshot
+func TestLoadsAndExpvar(t *testing.T) {
+	m := New()
+
+	// A failing source is counted
+	_, err := gotld.New(&gotld.Options{PublicSuffixFile: t.TempDir() + "/missing.dat", Observer: m})
+	if err == nil {
+		t.Fatal("New() succeeded with a missing file")
+	}
+
+	f := newManager(t, m)
+	_, _ = f.GetFQDN("example.com")
+
+	m.Publish("gotld_test")
+	var s Snapshot
+	if err := json.Unmarshal([]byte(expvar.Get("gotld_test").String()), &s); err != nil {
+		t.Fatalf("failed to decode expvar: %v", err)
+	}
+
+	if s.Lookups != 1 || s.LoadFailures != 1 || s.LoadSuccesses != 0 {
+		t.Errorf("Lookups = %d, LoadFailures = %d, LoadSuccesses = %d", s.Lookups, s.LoadFailures, s.LoadSuccesses)
+	}
+
+	if s.Rules.Total == 0 {
+		t.Error("Rules.Total = 0 for attached manager")
+	}
+
+	loads := `
+# HELP gotld_list_loads_total Total number of list load and refresh attempts by result.
+# TYPE gotld_list_loads_total counter
+gotld_list_loads_total{result="failure"} 1
+gotld_list_loads_total{result="success"} 0
+`
+	if err := testutil.CollectAndCompare(m, strings.NewReader(loads), "gotld_list_loads_total"); err != nil {
+		t.Error(err)
+	}
+
+	var total uint64
+	for _, c := range s.LatencyCounts {
+		total += c
+	}
+	if total != 1 || len(s.LatencyCounts) != len(DefaultBuckets)+1 {
+		t.Errorf("LatencyCounts = %v", s.LatencyCounts)
+	}
+}
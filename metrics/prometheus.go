@@ -0,0 +1,81 @@
+// file: metrics/prometheus.go
+// description: Prometheus collector for gotld metrics
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metric descriptors
+var (
+	lookupsDesc = prometheus.NewDesc("gotld_lookups_total",
+		"Total number of FQDN lookups.", nil, nil)
+	errorsDesc = prometheus.NewDesc("gotld_lookup_errors_total",
+		"Total number of failed FQDN lookups by reason.", []string{"reason"}, nil)
+	latencyDesc = prometheus.NewDesc("gotld_lookup_duration_seconds",
+		"FQDN lookup latency.", nil, nil)
+	loadsDesc = prometheus.NewDesc("gotld_list_loads_total",
+		"Total number of list load and refresh attempts by result.", []string{"result"}, nil)
+	lastLoadDesc = prometheus.NewDesc("gotld_list_last_load_success_timestamp_seconds",
+		"Unix time of the last successful list load.", nil, nil)
+	ageDesc = prometheus.NewDesc("gotld_list_age_seconds",
+		"Age of the active public suffix list.", nil, nil)
+	rulesDesc = prometheus.NewDesc("gotld_list_rules",
+		"Number of rules in the active list by section.", []string{"section"}, nil)
+	ruleTypesDesc = prometheus.NewDesc("gotld_list_rules_by_type",
+		"Number of rules in the active list by rule type.", []string{"type"}, nil)
+)
+
+// Metrics implements prometheus.Collector
+var _ prometheus.Collector = (*Metrics)(nil)
+
+// Describe sends the metric descriptors to ch
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lookupsDesc
+	ch <- errorsDesc
+	ch <- latencyDesc
+	ch <- loadsDesc
+	ch <- lastLoadDesc
+	ch <- ageDesc
+	ch <- rulesDesc
+	ch <- ruleTypesDesc
+}
+
+// Collect sends the current metric values to ch
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	s := m.Snapshot()
+
+	ch <- prometheus.MustNewConstMetric(lookupsDesc, prometheus.CounterValue, float64(s.Lookups))
+	for _, reason := range reasons {
+		ch <- prometheus.MustNewConstMetric(errorsDesc, prometheus.CounterValue, float64(s.Errors[reason]), reason)
+	}
+
+	// Prometheus histograms use cumulative bucket counts
+	buckets := make(map[float64]uint64, len(s.LatencyBuckets))
+	var cumulative uint64
+	for i, bound := range s.LatencyBuckets {
+		cumulative += s.LatencyCounts[i]
+		buckets[bound] = cumulative
+	}
+	cumulative += s.LatencyCounts[len(s.LatencyBuckets)]
+	ch <- prometheus.MustNewConstHistogram(latencyDesc, cumulative, s.LatencySum, buckets)
+
+	ch <- prometheus.MustNewConstMetric(loadsDesc, prometheus.CounterValue, float64(s.LoadSuccesses), "success")
+	ch <- prometheus.MustNewConstMetric(loadsDesc, prometheus.CounterValue, float64(s.LoadFailures), "failure")
+	ch <- prometheus.MustNewConstMetric(lastLoadDesc, prometheus.GaugeValue, float64(s.LastLoadSuccess))
+
+	if m.manager.Load() == nil {
+		return
+	}
+
+	// A list without a dated VERSION has no age to report
+	if s.ListAge != nil {
+		ch <- prometheus.MustNewConstMetric(ageDesc, prometheus.GaugeValue, *s.ListAge)
+	}
+	ch <- prometheus.MustNewConstMetric(rulesDesc, prometheus.GaugeValue, float64(s.Rules.ICANN), "icann")
+	ch <- prometheus.MustNewConstMetric(rulesDesc, prometheus.GaugeValue, float64(s.Rules.Private), "private")
+	ch <- prometheus.MustNewConstMetric(ruleTypesDesc, prometheus.GaugeValue, float64(s.Rules.Normal), "normal")
+	ch <- prometheus.MustNewConstMetric(ruleTypesDesc, prometheus.GaugeValue, float64(s.Rules.Wildcard), "wildcard")
+	ch <- prometheus.MustNewConstMetric(ruleTypesDesc, prometheus.GaugeValue, float64(s.Rules.Exception), "exception")
+}
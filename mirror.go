@@ -0,0 +1,196 @@
+// file: mirror.go
+// description: downloads the public suffix list from mirrors with retries
+
+package gotld
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures retries of list downloads
+type RetryPolicy struct {
+	// Attempts is the number of attempts per mirror (minimum 1)
+	Attempts int
+
+	// AttemptTimeout bounds each individual attempt; zero means no limit
+	// beyond the HTTP client timeout
+	AttemptTimeout time.Duration
+
+	// InitialBackoff is the base delay before the second attempt
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts, including delays
+	// requested by a Retry-After header; zero means no cap
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by DefaultOptions
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Attempts:       3,
+		AttemptTimeout: 10 * time.Second,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+	}
+}
+
+// backoff returns the delay before the given retry (1 for the first retry)
+// using exponential backoff with full jitter
+func (p *RetryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < retry && delay < math.MaxInt64/2; i++ {
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay > 0 {
+		delay = rand.N(delay) + 1
+	}
+
+	// The server knows best how long to wait
+	if retryAfter > delay {
+		delay = retryAfter
+		if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+			delay = p.MaxBackoff
+		}
+	}
+
+	return delay
+}
+
+// MirrorSource downloads the list from several mirrors, retrying each with
+// exponential backoff before failing over to the next one. An FQDN manager
+// also fails over when a mirror's list does not pass verification, parse or
+// the rollback check.
+type MirrorSource struct {
+	// URLs are the mirrors, tried in order
+	URLs []string
+
+	// Client is the HTTP client to use; a secure default is used when nil
+	Client *http.Client
+
+	// Retry is the retry policy; a single attempt per mirror when nil
+	Retry *RetryPolicy
+}
+
+// NewMirrorSource creates a MirrorSource for the given mirrors
+func NewMirrorSource(urls []string, client *http.Client, retry *RetryPolicy) *MirrorSource {
+	return &MirrorSource{URLs: urls, Client: client, Retry: retry}
+}
+
+// split returns a source per mirror sharing the client and retry policy
+func (s *MirrorSource) split() []Source {
+	sources := make([]Source, len(s.URLs))
+	for i, mirror := range s.URLs {
+		sources[i] = NewMirrorSource([]string{mirror}, s.Client, s.Retry)
+	}
+	return sources
+}
+
+// Open downloads the list from the first mirror that succeeds. If every
+// attempt fails the returned error lists each attempt's failure.
+func (s *MirrorSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	if len(s.URLs) == 0 {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixDownload, "no mirrors provided")
+	}
+
+	client := s.Client
+	if client == nil {
+		client = newHTTPClient(0)
+	}
+
+	policy := s.Retry
+	if policy == nil {
+		policy = &RetryPolicy{Attempts: 1}
+	}
+
+	attempts := max(policy.Attempts, 1)
+
+	var errs []error
+	for i, mirror := range s.URLs {
+		for attempt := 1; attempt <= attempts; attempt++ {
+			res, err := s.attempt(ctx, client, mirror, policy.AttemptTimeout)
+			if err == nil {
+				res.info.Fallback = i > 0
+				return io.NopCloser(bytes.NewReader(res.data)), res.info, nil
+			}
+			errs = append(errs, fmt.Errorf("%s attempt %d: %w", mirror, attempt, err))
+
+			// Stop if the caller gave up, move on if retrying cannot help
+			if ctx.Err() != nil {
+				return nil, SourceInfo{}, wrapError(errors.Join(errs...), "all mirrors failed")
+			}
+			if !retryable(res.status) || attempt == attempts {
+				break
+			}
+
+			timer := time.NewTimer(policy.backoff(attempt, res.retryAfter))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, SourceInfo{}, wrapError(errors.Join(append(errs, ctx.Err())...), "all mirrors failed")
+			case <-timer.C:
+			}
+		}
+	}
+
+	return nil, SourceInfo{}, wrapError(errors.Join(errs...), "all mirrors failed")
+}
+
+// attempt performs a single download bounded by timeout
+func (s *MirrorSource) attempt(ctx context.Context, client *http.Client, mirror string, timeout time.Duration) (fetchResult, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return download(ctx, client, mirror)
+}
+
+// retryable reports whether a download that ended with status may succeed
+// when tried again; zero means no response was received
+func retryable(status int) bool {
+	switch {
+	case status == 0, status == http.StatusOK:
+		return true
+	case status == http.StatusRequestTimeout, status == http.StatusTooManyRequests:
+		return true
+	case status >= 500:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter converts a Retry-After header in seconds or HTTP date form
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+
+	return 0
+}
@@ -0,0 +1,210 @@
+// file: mirror_test.go
+// description: tests for mirror failover and retries
+
+package gotld
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// servePaddedList writes the test list padded past the minimum size
+func servePaddedList(w http.ResponseWriter) {
+	w.Write([]byte(testList))
+	w.Write([]byte(strings.Repeat("// padding\n", minDataSize/10)))
+}
+
+// TestMirrorFailover tests retries on a flaky mirror and failover past a dead one
+func TestMirrorFailover(t *testing.T) {
+	var deadHits, flakyHits atomic.Int32
+
+	dead := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadHits.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer dead.Close()
+
+	flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if flakyHits.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		servePaddedList(w)
+	}))
+	defer flaky.Close()
+
+	opts := &Options{
+		PublicSuffixURL:     dead.URL,
+		PublicSuffixMirrors: []string{flaky.URL},
+		Retry: &RetryPolicy{
+			Attempts:       3,
+			AttemptTimeout: time.Second,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		},
+	}
+
+	fqdn, err := newFQDN(opts)
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	// 404 is not retried, 503 is
+	if got := deadHits.Load(); got != 1 {
+		t.Errorf("dead mirror hit %d times, want 1", got)
+	}
+	if got := flakyHits.Load(); got != 3 {
+		t.Errorf("flaky mirror hit %d times, want 3", got)
+	}
+
+	if fqdn.source.Name != flaky.URL {
+		t.Errorf("source = %q, want %q", fqdn.source.Name, flaky.URL)
+	}
+}
+
+// TestMirrorVerificationFailover tests failover past a mirror whose list
+// downloads but fails verification
+func TestMirrorVerificationFailover(t *testing.T) {
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		servePaddedList(w)
+	}))
+	defer good.Close()
+
+	tampered := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		servePaddedList(w)
+		w.Write([]byte("evil.example\n"))
+	}))
+	defer tampered.Close()
+
+	rec := httptest.NewRecorder()
+	servePaddedList(rec)
+	sum := sha256.Sum256(rec.Body.Bytes())
+
+	fqdn, err := newFQDN(&Options{
+		PublicSuffixURL:     tampered.URL,
+		PublicSuffixMirrors: []string{good.URL},
+		Integrity:           &Integrity{SHA256: []string{hex.EncodeToString(sum[:])}},
+	})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	status := fqdn.Status()
+	if status.Source.Name != good.URL || !status.Fallback {
+		t.Errorf("Source = %q, Fallback = %v, want %q from a fallback", status.Source.Name, status.Fallback, good.URL)
+	}
+
+	// With no good mirror left the verification error is reported
+	_, err = newFQDN(&Options{
+		PublicSuffixURL:     tampered.URL,
+		PublicSuffixMirrors: []string{tampered.URL + "/second"},
+		Integrity:           &Integrity{SHA256: []string{hex.EncodeToString(sum[:])}},
+	})
+	if !errors.Is(err, ErrPublicSuffixIntegrity) {
+		t.Errorf("newFQDN() error = %v, want %v", err, ErrPublicSuffixIntegrity)
+	}
+}
+
+// TestMirrorCombinedError tests that every failed attempt is reported
+func TestMirrorCombinedError(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	src := NewMirrorSource([]string{down.URL, down.URL + "/second"}, nil, &RetryPolicy{
+		Attempts:       2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	})
+
+	_, _, err := src.Open(context.Background())
+	if !errors.Is(err, ErrPublicSuffixDownload) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrPublicSuffixDownload)
+	}
+
+	for _, want := range []string{down.URL + " attempt 1", down.URL + " attempt 2", "/second attempt 2"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Open() error %q does not mention %q", err, want)
+		}
+	}
+}
+
+// TestRetryPolicy tests backoff and Retry-After handling
+func TestRetryPolicy(t *testing.T) {
+	policy := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for retry := 1; retry <= 6; retry++ {
+		if d := policy.backoff(retry, 0); d <= 0 || d > time.Second {
+			t.Errorf("backoff(%d) = %v, want within (0, 1s]", retry, d)
+		}
+	}
+
+	if d := policy.backoff(1, 500*time.Millisecond); d != 500*time.Millisecond {
+		t.Errorf("backoff() with Retry-After = %v, want 500ms", d)
+	}
+
+	if d := policy.backoff(1, time.Hour); d != time.Second {
+		t.Errorf("backoff() with long Retry-After = %v, want capped at 1s", d)
+	}
+
+	// Without a cap the delay keeps growing
+	uncapped := &RetryPolicy{InitialBackoff: 100 * time.Millisecond}
+	for retry := 1; retry <= 5; retry++ {
+		limit := 100 * time.Millisecond << (retry - 1)
+		if d := uncapped.backoff(retry, 0); d <= 0 || d > limit {
+			t.Errorf("uncapped backoff(%d) = %v, want within (0, %v]", retry, d, limit)
+		}
+	}
+
+	grown := false
+	for range 100 {
+		if uncapped.backoff(5, 0) > 100*time.Millisecond {
+			grown = true
+			break
+		}
+	}
+	if !grown {
+		t.Error("uncapped backoff(5) never exceeded the initial backoff")
+	}
+
+	if d := uncapped.backoff(100, 0); d <= 0 {
+		t.Errorf("uncapped backoff(100) = %v, want a positive delay", d)
+	}
+
+	if d := uncapped.backoff(1, time.Hour); d != time.Hour {
+		t.Errorf("uncapped backoff() with Retry-After = %v, want 1h", d)
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"5", 5 * time.Second},
+		{"-1", 0},
+		{now.Add(time.Minute).Format(http.TimeFormat), time.Minute},
+		{"soon", 0},
+	}
+
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	transport, ok := newHTTPClient(0).Transport.(*http.Transport)
+	if !ok || transport.Proxy == nil {
+		t.Error("built-in client does not honour proxy environment variables")
+	}
+}
@@ -0,0 +1,21 @@
+// file: observer.go
+// description: instrumentation hooks for the FQDN manager
+
+package gotld
+
+import (
+	"time"
+)
+
+// Observer receives instrumentation events from an FQDN manager. Leaving
+// Options.Observer nil disables instrumentation entirely. Implementations
+// must be safe for concurrent use; see the metrics subpackage.
+type Observer interface {
+	// ObserveLookup is called after each lookup of a host's public suffix,
+	// whichever method made it. Malformed URLs are reported with a zero
+	// duration.
+	ObserveLookup(duration time.Duration, err error)
+
+	// ObserveLoad is called after each attempt to load or refresh a list
+	ObserveLoad(info SourceInfo, duration time.Duration, err error)
+}
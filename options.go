@@ -5,6 +5,7 @@ package gotld
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"time"
 )
@@ -20,12 +21,55 @@ type Options struct {
 	// CustomHTTPClient allows setting a custom HTTP client
 	CustomHTTPClient *http.Client
 
-	// PublicSuffixURL is the URL to download the public suffix list from
+	// PublicSuffixURL is the URL to download the public suffix list from.
+	// When Source, PublicSuffixURL and PublicSuffixFile are all empty the
+	// built-in table generated by gen.go is used.
 	PublicSuffixURL string
 
+	// PublicSuffixMirrors are additional URLs tried in order when
+	// PublicSuffixURL fails
+	PublicSuffixMirrors []string
+
+	// Retry configures retries and backoff for downloads; each URL is
+	// tried once when nil
+	Retry *RetryPolicy
+
 	// PublicSuffixFile is a local file containing the public suffix list
 	PublicSuffixFile string
 
+	// TLDDatabaseFile is a TLD database written by gentld.go that replaces
+	// the built-in one; see ReloadTLDDatabase
+	TLDDatabaseFile string
+
+	// Source provides the public suffix list and takes precedence over
+	// PublicSuffixFile and PublicSuffixURL
+	Source Source
+
+	// Integrity configures optional verification of loaded lists
+	Integrity *Integrity
+
+	// FallbackToBuiltin keeps the built-in table instead of failing when
+	// the configured source cannot be loaded
+	FallbackToBuiltin bool
+
+	// Logger receives load, refresh, fallback and parse warning events;
+	// nil disables logging
+	Logger *slog.Logger
+
+	// ValidateHostnames checks host syntax with ValidateHostname before
+	// every lookup
+	ValidateHostnames bool
+
+	// HostnameMode is the strictness used by ValidateHostnames
+	HostnameMode HostnameMode
+
+	// CacheSize is the maximum number of hosts whose lookup results are
+	// cached; zero disables the cache
+	CacheSize int
+
+	// Observer receives lookup and load events; nil disables instrumentation
+	Observer Observer
+
 	// Context is used for cancellation
 	Context context.Context
 }
@@ -33,11 +77,22 @@ type Options struct {
 // DefaultOptions returns default options
 func DefaultOptions() *Options {
 	return &Options{
-		AllowPrivateTLDs: false,
-		Timeout:          10 * time.Second,
-		CustomHTTPClient: nil,
-		PublicSuffixURL:  publicSuffixFileURL,
-		PublicSuffixFile: "",
-		Context:          context.Background(),
+		AllowPrivateTLDs:    false,
+		Timeout:             10 * time.Second,
+		CustomHTTPClient:    nil,
+		PublicSuffixURL:     "",
+		PublicSuffixMirrors: nil,
+		Retry:               DefaultRetryPolicy(),
+		PublicSuffixFile:    "",
+		TLDDatabaseFile:     "",
+		Source:              nil,
+		Integrity:           nil,
+		FallbackToBuiltin:   false,
+		Logger:              nil,
+		ValidateHostnames:   false,
+		HostnameMode:        HostnameLDH,
+		CacheSize:           0,
+		Observer:            nil,
+		Context:             context.Background(),
 	}
 }
@@ -0,0 +1,41 @@
+// file: parents.go
+// description: enumeration of parent domains up to the registrable domain
+
+package gotld
+
+import (
+	"iter"
+	"strings"
+)
+
+// Parents returns an iterator over the host of srcURL and each of its
+// parent domains, from the host itself up to and including the registrable
+// domain. Public suffixes are never yielded, so a.b.example.co.uk yields
+// a.b.example.co.uk, b.example.co.uk and example.co.uk. Private suffixes
+// are treated as public suffixes when AllowPrivateTLDs is set.
+func (f *FQDN) Parents(srcURL string) (iter.Seq[string], error) {
+	host, err := f.hostname(srcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	_, registrable, err := f.splitHost(host, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(string) bool) {
+		name := host
+		for {
+			if !yield(name) || len(name) <= len(registrable) {
+				return
+			}
+
+			i := strings.IndexByte(name, '.')
+			if i < 0 {
+				return
+			}
+			name = name[i+1:]
+		}
+	}, nil
+}
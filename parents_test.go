@@ -0,0 +1,109 @@
+// file: parents_test.go
+// description: tests for parent domain enumeration
+
+package gotld
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestParents tests enumerating parents up to the registrable domain
+func TestParents(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	private, err := newFQDN(&Options{AllowPrivateTLDs: true})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		fqdn    *FQDN
+		input   string
+		want    []string
+		wantErr error
+	}{
+		{
+			name:  "Multi-label suffix",
+			fqdn:  fqdn,
+			input: "a.b.example.co.uk",
+			want:  []string{"a.b.example.co.uk", "b.example.co.uk", "example.co.uk"},
+		},
+		{
+			name:  "URL input",
+			fqdn:  fqdn,
+			input: "https://WWW.Example.com:8443/path",
+			want:  []string{"www.example.com", "example.com"},
+		},
+		{
+			name:  "Registrable domain",
+			fqdn:  fqdn,
+			input: "example.com",
+			want:  []string{"example.com"},
+		},
+		{
+			name:  "Private suffix ignored",
+			fqdn:  fqdn,
+			input: "docs.user.github.io",
+			want:  []string{"docs.user.github.io", "user.github.io", "github.io"},
+		},
+		{
+			name:  "Private suffix honored",
+			fqdn:  private,
+			input: "docs.user.github.io",
+			want:  []string{"docs.user.github.io", "user.github.io"},
+		},
+		{name: "Public suffix", fqdn: fqdn, input: "co.uk", wantErr: ErrInvalidURL},
+		{name: "Unknown suffix", fqdn: fqdn, input: "example.invalidtld", wantErr: ErrInvalidTLD},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq, err := tt.fqdn.Parents(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Parents(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parents(%q) error = %v", tt.input, err)
+			}
+
+			if got := slices.Collect(seq); !slices.Equal(got, tt.want) {
+				t.Errorf("Parents(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParentsStop tests stopping the iteration early
+func TestParentsStop(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	seq, err := fqdn.Parents("a.b.c.example.com")
+	if err != nil {
+		t.Fatalf("Parents() error = %v", err)
+	}
+
+	var got []string
+	for name := range seq {
+		got = append(got, name)
+		if name == "b.c.example.com" {
+			break
+		}
+	}
+
+	if want := []string{"a.b.c.example.com", "b.c.example.com"}; !slices.Equal(got, want) {
+		t.Errorf("Parents() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+// file: parse.go
+// description: structured parsing of URLs and hosts
+
+package gotld
+
+import (
+	"strings"
+)
+
+// ParseResult holds the components of a parsed URL or host
+type ParseResult struct {
+	// Input is the string that was parsed
+	Input string `json:"input"`
+
+	// Scheme is the URL scheme, empty for bare hosts
+	Scheme string `json:"scheme,omitempty"`
+
+	// Host is the lowercase host name
+	Host string `json:"host"`
+
+	// Port is the port, if present
+	Port string `json:"port,omitempty"`
+
+	// Subdomain is the part of Host left of the registrable domain
+	Subdomain string `json:"subdomain,omitempty"`
+
+	// Domain is the label directly left of the public suffix
+	Domain string `json:"domain"`
+
+	// TLD is the public suffix (eTLD), e.g. "co.uk"
+	TLD string `json:"tld"`
+
+	// RegistrableDomain is Domain plus TLD, as returned by GetFQDN
+	RegistrableDomain string `json:"registrable_domain"`
+}
+
+// Parse splits a URL or bare host into its components
+func (f *FQDN) Parse(srcURL string) (*ParseResult, error) {
+	parsedURL, hadScheme, err := f.parseURL(srcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	host := strings.ToLower(parsedURL.Hostname())
+
+	eTLD, registrable, err := f.splitHost(host, true)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ParseResult{
+		Input:             srcURL,
+		Host:              host,
+		Port:              parsedURL.Port(),
+		Subdomain:         strings.TrimSuffix(strings.TrimSuffix(host, registrable), "."),
+		Domain:            strings.TrimSuffix(registrable, "."+eTLD),
+		TLD:               eTLD,
+		RegistrableDomain: registrable,
+	}
+
+	if hadScheme {
+		res.Scheme = parsedURL.Scheme
+	}
+
+	return res, nil
+}
+
+// SameSite reports whether a and b share a registrable domain. The
+// comparison is schemeless, so http and https URLs of the same site match.
+func (f *FQDN) SameSite(a, b string) (bool, error) {
+	siteA, err := f.getFQDN(a)
+	if err != nil {
+		return false, err
+	}
+
+	siteB, err := f.getFQDN(b)
+	if err != nil {
+		return false, err
+	}
+
+	return siteA == siteB, nil
+}
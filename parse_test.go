@@ -0,0 +1,89 @@
+// file: parse_test.go
+// description: tests for structured parsing
+
+package gotld
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParse tests splitting URLs into their components
+func TestParse(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("Failed to create FQDN manager: %v", err)
+	}
+
+	tests := []struct {
+		input    string
+		expected ParseResult
+		err      error
+	}{
+		{
+			input: "https://a.b.Example.co.uk:8443/path?q=1",
+			expected: ParseResult{
+				Scheme:            "https",
+				Host:              "a.b.example.co.uk",
+				Port:              "8443",
+				Subdomain:         "a.b",
+				Domain:            "example",
+				TLD:               "co.uk",
+				RegistrableDomain: "example.co.uk",
+			},
+		},
+		{
+			input: "example.com",
+			expected: ParseResult{
+				Host:              "example.com",
+				Domain:            "example",
+				TLD:               "com",
+				RegistrableDomain: "example.com",
+			},
+		},
+		{input: "invalid", err: ErrInvalidURL},
+		{input: "example.invalidtld", err: ErrInvalidTLD},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := fqdn.Parse(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Parse() error = %v, want %v", err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+
+			tt.expected.Input = tt.input
+			if *got != tt.expected {
+				t.Errorf("Parse() = %+v, want %+v", *got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSameSite tests registrable domain comparison
+func TestSameSite(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("Failed to create FQDN manager: %v", err)
+	}
+
+	tests := []struct {
+		a, b    string
+		want    bool
+		wantErr bool
+	}{
+		{"https://www.example.com", "http://api.example.com:8080", true, false},
+		{"example.co.uk", "other.co.uk", false, false},
+		{"example.com", "invalid", false, true},
+	}
+
+	for _, tt := range tests {
+		got, err := fqdn.SameSite(tt.a, tt.b)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("SameSite(%q, %q) = %v, %v, want %v", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// file: redirect.go
+// description: open-redirect safe validation of redirect targets
+
+package gotld
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// RedirectPolicy lists the destinations a redirect may lead to besides the
+// origin of the base URL, which is always allowed
+type RedirectPolicy struct {
+	// AllowSameSite allows targets on the registrable domain of the base,
+	// e.g. "https://login.example.com" from "https://www.example.com"
+	AllowSameSite bool
+
+	// AllowedDomains are registrable domains whose hosts are allowed, e.g.
+	// "example.org" allows "https://www.example.org/"
+	AllowedDomains []string
+
+	// AllowedSchemes are the schemes a target may use; empty allows http
+	// and https
+	AllowedSchemes []string
+
+	// AllowDowngrade allows http targets from an https base
+	AllowDowngrade bool
+}
+
+// defaultRedirectSchemes are the schemes allowed when the policy lists none
+var defaultRedirectSchemes = []string{"http", "https"}
+
+// IsSafeRedirect reports whether redirecting to target from base is safe
+// under policy. See CheckRedirect.
+func (f *FQDN) IsSafeRedirect(target, base string, policy *RedirectPolicy) bool {
+	_, err := f.CheckRedirect(target, base, policy)
+	return err == nil
+}
+
+// CheckRedirect resolves target, typically a "next" parameter, against the
+// absolute base URL and returns the destination if the redirect is safe.
+// Tabs, newlines and surrounding whitespace are stripped and backslashes
+// become slashes before the query, as browsers do. Scheme-relative targets
+// such as "//evil.com", schemes without an authority such as
+// "https:evil.com", userinfo and schemes outside the policy, such as
+// "javascript:", are rejected. The destination must then share the origin
+// of base or be allowed by policy; a nil policy allows the origin only.
+func (f *FQDN) CheckRedirect(target, base string, policy *RedirectPolicy) (*url.URL, error) {
+	if policy == nil {
+		policy = &RedirectPolicy{}
+	}
+
+	baseURL, err := url.Parse(base)
+	if err != nil || !isWebScheme(baseURL.Scheme) || baseURL.Host == "" {
+		return nil, wrapError(ErrInvalidURL, "base must be an absolute http or https URL")
+	}
+
+	target = normalizeRedirect(target)
+	if strings.HasPrefix(target, "//") {
+		return nil, wrapError(ErrUnsafeRedirect, "scheme-relative target")
+	}
+
+	ref, err := url.Parse(target)
+	if err != nil {
+		return nil, wrapError(ErrUnsafeRedirect, err.Error())
+	}
+
+	if ref.Scheme != "" {
+		schemes := policy.AllowedSchemes
+		if len(schemes) == 0 {
+			schemes = defaultRedirectSchemes
+		}
+		if !slices.Contains(schemes, strings.ToLower(ref.Scheme)) {
+			return nil, wrapError(ErrUnsafeRedirect, "scheme "+ref.Scheme+" is not allowed")
+		}
+
+		// Browsers read "https:evil.com" as "https://evil.com" when the
+		// schemes differ, so an absolute target needs an explicit authority
+		if ref.Opaque != "" || ref.Host == "" {
+			return nil, wrapError(ErrUnsafeRedirect, "absolute target without authority")
+		}
+	}
+
+	if ref.User != nil {
+		return nil, wrapError(ErrUnsafeRedirect, "target contains userinfo")
+	}
+
+	dest := baseURL.ResolveReference(ref)
+	if sameOrigin(dest, baseURL) {
+		return dest, nil
+	}
+
+	if baseURL.Scheme == "https" && dest.Scheme == "http" && !policy.AllowDowngrade {
+		return nil, wrapError(ErrUnsafeRedirect, "downgrade from https to http")
+	}
+
+	host := redirectHost(dest)
+	if host == "" {
+		return nil, wrapError(ErrUnsafeRedirect, "target has no host")
+	}
+
+	// IP addresses and other hosts without a registrable domain only match
+	// the origin or an allowed domain exactly
+	registrable, _ := f.getFQDN(host)
+
+	if policy.AllowSameSite && registrable != "" {
+		if baseSite, err := f.getFQDN(redirectHost(baseURL)); err == nil && baseSite == registrable {
+			return dest, nil
+		}
+	}
+
+	for _, allowed := range policy.AllowedDomains {
+		allowed = strings.TrimSuffix(strings.ToLower(allowed), ".")
+		if allowed == host || allowed == registrable {
+			return dest, nil
+		}
+	}
+
+	return nil, wrapError(ErrUnsafeRedirect, "destination "+host+" is not allowed")
+}
+
+// normalizeRedirect applies the browser URL parser's preprocessing: it
+// trims C0 controls and spaces, removes tabs and newlines, and converts
+// backslashes to slashes before the query or fragment
+func normalizeRedirect(s string) string {
+	s = strings.TrimFunc(s, func(r rune) bool { return r <= ' ' })
+	s = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(s)
+
+	end := strings.IndexAny(s, "?#")
+	if end < 0 {
+		end = len(s)
+	}
+
+	return strings.ReplaceAll(s[:end], `\`, "/") + s[end:]
+}
+
+// isWebScheme reports whether scheme is http or https
+func isWebScheme(scheme string) bool {
+	return scheme == "http" || scheme == "https"
+}
+
+// redirectHost returns the lowercase host of u without a trailing dot
+func redirectHost(u *url.URL) string {
+	return strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+}
+
+// sameOrigin reports whether a and b share scheme, host and port
+func sameOrigin(a, b *url.URL) bool {
+	return strings.EqualFold(a.Scheme, b.Scheme) && redirectHost(a) == redirectHost(b) && effectivePort(a) == effectivePort(b)
+}
+
+// effectivePort returns the port of u, or the default port of its scheme
+func effectivePort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http":
+		return "80"
+	case "https":
+		return "443"
+	}
+	return ""
+}
@@ -0,0 +1,98 @@
+// file: redirect_test.go
+// description: tests for open-redirect safe validation
+
+package gotld
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestCheckRedirect tests redirect validation against common bypasses
+func TestCheckRedirect(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	base := "https://www.example.com/login?next=x"
+	sameSite := &RedirectPolicy{AllowSameSite: true}
+	allowList := &RedirectPolicy{AllowedDomains: []string{"trusted.org", "api.partner.net"}}
+
+	tests := []struct {
+		name   string
+		target string
+		policy *RedirectPolicy
+		want   string
+	}{
+		{"Relative path", "/account?tab=1", nil, "https://www.example.com/account?tab=1"},
+		{"Relative file", "settings", nil, "https://www.example.com/settings"},
+		{"Fragment", "#top", nil, "https://www.example.com/login?next=x#top"},
+		{"Same origin", "https://www.example.com/home", nil, "https://www.example.com/home"},
+		{"Same origin default port", "https://WWW.example.com:443/", nil, "https://WWW.example.com:443/"},
+		{"Whitespace", "  /home\n", nil, "https://www.example.com/home"},
+		{"Backslash in query kept", `/search?q=a\b`, nil, `https://www.example.com/search?q=a\b`},
+		{"Other host", "https://evil.com/", nil, ""},
+		{"Other port", "https://www.example.com:8443/", nil, ""},
+		{"Scheme relative", "//evil.com", nil, ""},
+		{"Triple slash", "///evil.com", nil, ""},
+		{"Backslash scheme relative", `/\evil.com`, nil, ""},
+		{"Double backslash", `\\evil.com`, nil, ""},
+		{"Tab in slashes", "/\t/evil.com", nil, ""},
+		{"Scheme without authority", "https:evil.com", nil, ""},
+		{"Scheme with one slash", "https:/evil.com", nil, ""},
+		{"Backslash authority", `https:\\evil.com`, nil, ""},
+		{"Userinfo", "https://www.example.com@evil.com/", nil, ""},
+		{"Userinfo on origin", "https://user@www.example.com/", nil, ""},
+		{"Backslash before at", `https://evil.com\@www.example.com/`, nil, ""},
+		{"Javascript", "javascript:alert(1)", nil, ""},
+		{"Javascript with whitespace", " java\tscript:alert(1)", nil, ""},
+		{"Uppercase javascript", "JAVASCRIPT:alert(1)", nil, ""},
+		{"Data", "data:text/html,<script>alert(1)</script>", nil, ""},
+		{"Same site denied by default", "https://login.example.com/", nil, ""},
+		{"Same site", "https://login.example.com/", sameSite, "https://login.example.com/"},
+		{"Same site downgrade", "http://login.example.com/", sameSite, ""},
+		{"Same site downgrade allowed", "http://login.example.com/", &RedirectPolicy{AllowSameSite: true, AllowDowngrade: true}, "http://login.example.com/"},
+		{"Lookalike site", "https://example.com.evil.com/", sameSite, ""},
+		{"Suffix is not a site", "https://evil.co.uk/", &RedirectPolicy{AllowSameSite: true}, ""},
+		{"Allowed domain", "https://www.trusted.org/cb", allowList, "https://www.trusted.org/cb"},
+		{"Allowed host", "https://api.partner.net/", allowList, "https://api.partner.net/"},
+		{"Allowed host only", "https://www.partner.net/", allowList, ""},
+		{"Allowed domain trailing dot", "https://trusted.org./", allowList, "https://trusted.org./"},
+		{"Suffix of allowed domain", "https://eviltrusted.org/", allowList, ""},
+		{"Disallowed scheme", "ftp://www.example.com/", nil, ""},
+		{"Allowed scheme", "myapp://www.example.com/cb", &RedirectPolicy{AllowedSchemes: []string{"myapp"}, AllowedDomains: []string{"example.com"}}, "myapp://www.example.com/cb"},
+		{"IP address", "https://127.0.0.1/", sameSite, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dest, err := fqdn.CheckRedirect(tt.target, base, tt.policy)
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("CheckRedirect(%q) = %v, want error", tt.target, dest)
+				}
+				if !errors.Is(err, ErrUnsafeRedirect) {
+					t.Errorf("CheckRedirect(%q) error = %v, want ErrUnsafeRedirect", tt.target, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CheckRedirect(%q) error = %v", tt.target, err)
+			}
+			if dest.String() != tt.want {
+				t.Errorf("CheckRedirect(%q) = %q, want %q", tt.target, dest, tt.want)
+			}
+			if !fqdn.IsSafeRedirect(tt.target, base, tt.policy) {
+				t.Errorf("IsSafeRedirect(%q) = false", tt.target)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "/relative", "javascript:alert(1)", "//www.example.com"} {
+		if _, err := fqdn.CheckRedirect("/", bad, nil); !errors.Is(err, ErrInvalidURL) {
+			t.Errorf("CheckRedirect() with base %q error = %v, want ErrInvalidURL", bad, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// file: rules.go
+// description: queries over the loaded public suffix rules
+
+package gotld
+
+import (
+	"iter"
+	"strings"
+)
+
+// RuleType is the kind of a public suffix rule
+type RuleType int
+
+const (
+	// RuleNormal is a plain rule such as "co.uk"
+	RuleNormal RuleType = iota
+
+	// RuleWildcard is a wildcard rule such as "*.ck"
+	RuleWildcard
+
+	// RuleException is an exception rule such as "!www.ck"
+	RuleException
+)
+
+// String returns the name of the rule type
+func (t RuleType) String() string {
+	switch t {
+	case RuleNormal:
+		return "normal"
+	case RuleWildcard:
+		return "wildcard"
+	case RuleException:
+		return "exception"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t RuleType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// Rule is a single rule of the public suffix list
+type Rule struct {
+	// Name is the rule as written in the list, e.g. "*.kawasaki.jp"
+	Name string `json:"name"`
+
+	// Suffix is the domain the rule applies to, without "*." or "!"
+	Suffix string `json:"suffix"`
+
+	// Type is the kind of rule
+	Type RuleType `json:"type"`
+
+	// Private is true for rules from the PRIVATE DOMAINS section
+	Private bool `json:"private"`
+}
+
+// String returns the rule as written in the list
+func (r Rule) String() string {
+	return r.Name
+}
+
+// parent returns the domain the rule is listed under, e.g. "kawasaki.jp"
+// for both "*.kawasaki.jp" and "!city.kawasaki.jp"
+func (r Rule) parent() string {
+	_, parent, _ := strings.Cut(strings.TrimPrefix(r.Name, "!"), ".")
+	return parent
+}
+
+// rulesAt returns the rules stored at index i of the table
+func (t *suffixTable) rulesAt(i int) []Rule {
+	key, flags := t.key(i), t.flags[i]
+	private := flags&rulePrivate != 0
+
+	var rules []Rule
+	if flags&ruleNormal != 0 {
+		rules = append(rules, Rule{Name: key, Suffix: key, Type: RuleNormal, Private: private})
+	}
+	if flags&ruleWildcard != 0 {
+		rules = append(rules, Rule{Name: "*." + key, Suffix: key, Type: RuleWildcard, Private: private})
+	}
+	if flags&ruleException != 0 {
+		rules = append(rules, Rule{Name: "!" + key, Suffix: key, Type: RuleException, Private: private})
+	}
+	return rules
+}
+
+// snapshot returns the active table; tables are immutable, so the result
+// stays consistent even if a reload replaces it
+func (f *FQDN) snapshot() *suffixTable {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.rules
+}
+
+// Rules returns an iterator over every loaded rule, ICANN and private,
+// ordered by suffix. It iterates over the list that was active when
+// called, so concurrent reloads do not affect it.
+func (f *FQDN) Rules() iter.Seq[Rule] {
+	table := f.snapshot()
+
+	return func(yield func(Rule) bool) {
+		for i := 0; i < table.Len(); i++ {
+			for _, rule := range table.rulesAt(i) {
+				if !yield(rule) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Children returns the rules listed directly under suffix, e.g. "ac.jp"
+// for "jp" but not "*.kawasaki.jp", which is listed under "kawasaki.jp".
+// Unicode and punycode suffixes are accepted; an empty suffix lists the
+// top-level rules.
+func (f *FQDN) Children(suffix string) []Rule {
+	// Rules are keyed by the Unicode form of internationalized suffixes
+	suffix = tableForm(normalizeSuffix(suffix))
+
+	var children []Rule
+	for rule := range f.Rules() {
+		if rule.parent() == suffix {
+			children = append(children, rule)
+		}
+	}
+
+	return children
+}
+
+// IsPublicSuffix reports whether host is itself a public suffix, such as
+// "co.uk". Private rules count only when AllowPrivateTLDs is set. As in
+// the list's implicit "*" rule, any single label is a public suffix.
+func (f *FQDN) IsPublicSuffix(host string) bool {
+	host = normalizeSuffix(host)
+	if host == "" {
+		return false
+	}
+
+	return f.isPublicSuffix(host, f.Options.AllowPrivateTLDs)
+}
+
+// RuleCounts returns the rule counts of the loaded list by section and type
+func (f *FQDN) RuleCounts() RuleCounts {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.counts
+}
+
+// normalizeSuffix lowercases a suffix and trims its leading and trailing
+// dots; punycode is left to the shared lookup path
+func normalizeSuffix(s string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(s, "."), "."))
+}
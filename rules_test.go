@@ -0,0 +1,191 @@
+// file: rules_test.go
+// description: tests for querying the loaded rules
+
+package gotld
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newTestListFQDN creates a manager loaded with testList
+func newTestListFQDN(t *testing.T, opts *Options) *FQDN {
+	t.Helper()
+
+	opts.Source = NewReaderSource(strings.NewReader(testList))
+	fqdn, err := newFQDN(opts)
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+	return fqdn
+}
+
+// TestRules tests iterating every loaded rule
+func TestRules(t *testing.T) {
+	fqdn := newTestListFQDN(t, &Options{})
+
+	var names []string
+	for rule := range fqdn.Rules() {
+		names = append(names, rule.String())
+
+		if rule.Name == "github.io" && (!rule.Private || rule.Type != RuleNormal) {
+			t.Errorf("github.io rule = %+v", rule)
+		}
+		if rule.Name == "*.kawasaki.jp" && (rule.Suffix != "kawasaki.jp" || rule.Type != RuleWildcard) {
+			t.Errorf("*.kawasaki.jp rule = %+v", rule)
+		}
+		if rule.Name == "!city.kawasaki.jp" && rule.Type != RuleException {
+			t.Errorf("!city.kawasaki.jp rule = %+v", rule)
+		}
+	}
+
+	want := "ac.jp,!city.kawasaki.jp,co.uk,com,github.io,jp,*.kawasaki.jp,org,uk"
+	if got := strings.Join(names, ","); got != want {
+		t.Errorf("Rules() = %s, want %s", got, want)
+	}
+
+	if len(names) != fqdn.RuleCounts().Total {
+		t.Errorf("Rules() yielded %d rules, RuleCounts().Total = %d", len(names), fqdn.RuleCounts().Total)
+	}
+
+	// Stopping early is honored
+	n := 0
+	for range fqdn.Rules() {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("iteration did not stop, got %d rules", n)
+	}
+
+	data, err := json.Marshal(Rule{Name: "*.ck", Suffix: "ck", Type: RuleWildcard})
+	if err != nil || !strings.Contains(string(data), `"type":"wildcard"`) {
+		t.Errorf("json.Marshal() = %s, %v", data, err)
+	}
+}
+
+// TestChildren tests listing the rules under a suffix
+func TestChildren(t *testing.T) {
+	fqdn := newTestListFQDN(t, &Options{})
+
+	tests := map[string]string{
+		"jp":           "ac.jp",
+		".JP.":         "ac.jp",
+		"kawasaki.jp":  "!city.kawasaki.jp,*.kawasaki.jp",
+		"uk":           "co.uk",
+		"io":           "github.io",
+		"":             "com,jp,org,uk",
+		"example.com":  "",
+		"xn--p1ai":     "",
+		"invalid..tld": "",
+	}
+
+	for suffix, want := range tests {
+		var names []string
+		for _, rule := range fqdn.Children(suffix) {
+			names = append(names, rule.Name)
+		}
+		if got := strings.Join(names, ","); got != want {
+			t.Errorf("Children(%q) = %s, want %s", suffix, got, want)
+		}
+	}
+
+	// Punycode is converted to the Unicode form used by the list
+	builtin, _ := newFQDN(&Options{})
+	if children := builtin.Children("xn--90a3ac"); len(children) != 6 {
+		t.Errorf("Children(xn--90a3ac) = %v, want the 6 срб rules", children)
+	}
+}
+
+// TestIsPublicSuffix tests checking whether a name is a public suffix
+func TestIsPublicSuffix(t *testing.T) {
+	icann := newTestListFQDN(t, &Options{})
+	private := newTestListFQDN(t, &Options{AllowPrivateTLDs: true})
+
+	tests := []struct {
+		host    string
+		icann   bool
+		private bool
+	}{
+		{"com", true, true},
+		{"CO.UK.", true, true},
+		{"example.co.uk", false, false},
+		{"foo.kawasaki.jp", true, true},
+		{"city.kawasaki.jp", false, false},
+		{"github.io", false, true},
+		{"user.github.io", false, false},
+		{"unknown", true, true},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		if got := icann.IsPublicSuffix(tt.host); got != tt.icann {
+			t.Errorf("IsPublicSuffix(%q) = %v, want %v", tt.host, got, tt.icann)
+		}
+		if got := private.IsPublicSuffix(tt.host); got != tt.private {
+			t.Errorf("IsPublicSuffix(%q) with private = %v, want %v", tt.host, got, tt.private)
+		}
+	}
+
+	// Punycode and Unicode spellings agree with GetFQDN
+	builtin, _ := newFQDN(&Options{})
+	for _, suffix := range []string{"公司.cn", "xn--55qx5d.cn", "XN--55QX5D.CN."} {
+		if !builtin.IsPublicSuffix(suffix) {
+			t.Errorf("IsPublicSuffix(%q) = false, want true", suffix)
+		}
+
+		name := strings.TrimSuffix(suffix, ".")
+		if got, err := builtin.GetFQDN("www.example." + name); err != nil || !strings.EqualFold(got, "example."+name) {
+			t.Errorf("GetFQDN(www.example.%s) = %v, %v, want example.%s", name, got, err, name)
+		}
+	}
+
+	if builtin.IsPublicSuffix("example.xn--55qx5d.cn") {
+		t.Error("IsPublicSuffix(example.xn--55qx5d.cn) = true, want false")
+	}
+}
+
+// TestRulesConcurrentReload tests iterating while the list is reloaded
+func TestRulesConcurrentReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.dat")
+	if err := os.WriteFile(path, []byte(testList), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	fqdn, err := newFQDN(&Options{PublicSuffixFile: path})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			_ = fqdn.Reload()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			n := 0
+			for range fqdn.Rules() {
+				n++
+			}
+			if n != 9 {
+				t.Errorf("Rules() yielded %d rules, want 9", n)
+				return
+			}
+			_ = fqdn.Children("jp")
+			_ = fqdn.IsPublicSuffix("co.uk")
+		}
+	}()
+
+	wg.Wait()
+}
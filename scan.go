@@ -0,0 +1,264 @@
+// file: scan.go
+// description: extraction of URLs and host names from free text
+
+package gotld
+
+import (
+	"net"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is a URL or host name found in text
+type Match struct {
+	// Start and End are the byte offsets of the match in the scanned text
+	Start int `json:"start"`
+	End   int `json:"end"`
+
+	// Text is the matched text as it appears in the input
+	Text string `json:"text"`
+
+	// Value is the refanged URL or host, e.g. "http://example.com" for
+	// "hxxp://example[.]com"
+	Value string `json:"value"`
+
+	// URL is true when the match has a scheme; bare host names are
+	// reported without any path that follows them
+	URL bool `json:"url"`
+
+	// Defanged is true when the text was written in a defanged form
+	Defanged bool `json:"defanged"`
+
+	// Result is the structured parse of Value
+	Result *ParseResult `json:"result"`
+}
+
+// defangs maps defanged notations to their plain form; longer notations
+// come first so they win over their prefixes
+var defangs = []struct{ from, to string }{
+	{"[://]", "://"},
+	{"[dot]", "."},
+	{"(dot)", "."},
+	{"{dot}", "."},
+	{"[.]", "."},
+	{"(.)", "."},
+	{"{.}", "."},
+	{"[:]", ":"},
+}
+
+// Scan finds URLs and host names in text, including defanged forms such as
+// "hxxps://example[.]com". Candidates are reported only when they end in a
+// public suffix of the loaded list, which filters out file names such as
+// "report.txt". Matches are returned in the order they appear.
+func (f *FQDN) Scan(text string) []Match {
+	var matches []Match
+
+	for start := 0; start < len(text); {
+		r, size := utf8.DecodeRuneInString(text[start:])
+		if isTokenDelimiter(r) {
+			start += size
+			continue
+		}
+
+		end := start
+		for end < len(text) {
+			r, size := utf8.DecodeRuneInString(text[end:])
+			if isTokenDelimiter(r) {
+				break
+			}
+			end += size
+		}
+
+		if m, ok := f.scanToken(text, start, end); ok {
+			matches = append(matches, m)
+		}
+		start = end
+	}
+
+	return matches
+}
+
+// scanToken checks whether text[start:end] holds a URL or host name
+func (f *FQDN) scanToken(text string, start, end int) (Match, bool) {
+	start, end = trimToken(text, start, end)
+	if end-start < 4 {
+		return Match{}, false
+	}
+
+	token := text[start:end]
+	value, pos := refang(token)
+	defanged := value != token
+
+	// Prefer a URL when the token holds a supported scheme
+	if i := strings.Index(value, "://"); i > 0 {
+		s := i
+		for s > 0 && isSchemeByte(value[s-1]) {
+			s--
+		}
+
+		candidate := strings.ToLower(value[s:i]) + value[i:]
+		if _, ok := f.hasScheme(candidate, false); ok {
+			res, err := f.Parse(candidate)
+			if err != nil || !validHostLabels(res.Host) {
+				return Match{}, false
+			}
+
+			mStart, mEnd := start+pos[s], end
+			return Match{
+				Start:    mStart,
+				End:      mEnd,
+				Text:     text[mStart:mEnd],
+				Value:    candidate,
+				URL:      true,
+				Defanged: defanged,
+				Result:   res,
+			}, true
+		}
+	}
+
+	// Otherwise take the host of a bare "host[:port][/path]" or "user@host"
+	hs, he := 0, len(value)
+	if i := strings.IndexAny(value, "/?#"); i >= 0 {
+		he = i
+	}
+	if i := strings.LastIndexByte(value[:he], '@'); i >= 0 {
+		hs = i + 1
+	}
+	if i := strings.LastIndexByte(value[hs:he], ':'); i >= 0 && isDigits(value[hs+i+1:he]) {
+		he = hs + i
+	}
+	he = hs + len(strings.TrimRight(value[hs:he], "."))
+
+	host := value[hs:he]
+	if !strings.Contains(host, ".") || net.ParseIP(host) != nil || !validHostLabels(host) {
+		return Match{}, false
+	}
+
+	res, err := f.Parse(host)
+	if err != nil {
+		return Match{}, false
+	}
+
+	mStart, mEnd := start+pos[hs], start+pos[he]
+	return Match{
+		Start:    mStart,
+		End:      mEnd,
+		Text:     text[mStart:mEnd],
+		Value:    strings.ToLower(host),
+		Defanged: text[mStart:mEnd] != host,
+		Result:   res,
+	}, true
+}
+
+// refang replaces defanged notations in token. pos maps each byte of the
+// result to its offset in token, with pos[len(result)] == len(token).
+func refang(token string) (string, []int) {
+	var (
+		out strings.Builder
+		pos = make([]int, 0, len(token)+1)
+	)
+
+	// Defanged schemes keep their length, e.g. hxxp and hXXps
+	lower := strings.ToLower(token)
+	skip := 0
+	for _, scheme := range []string{"hxxps", "hxxp", "fxp"} {
+		if strings.HasPrefix(lower, scheme) {
+			plain := strings.NewReplacer("hxxp", "http", "fxp", "ftp").Replace(scheme)
+			out.WriteString(plain)
+			for i := range plain {
+				pos = append(pos, i)
+			}
+			skip = len(scheme)
+			break
+		}
+	}
+
+next:
+	for i := skip; i < len(token); {
+		for _, d := range defangs {
+			if len(lower)-i >= len(d.from) && lower[i:i+len(d.from)] == d.from {
+				out.WriteString(d.to)
+				for range d.to {
+					pos = append(pos, i)
+				}
+				i += len(d.from)
+				continue next
+			}
+		}
+
+		out.WriteByte(token[i])
+		pos = append(pos, i)
+		i++
+	}
+
+	return out.String(), append(pos, len(token))
+}
+
+// trimToken strips surrounding punctuation, keeping closing brackets that
+// are balanced within the token such as in "wiki/Go_(language)"
+func trimToken(text string, start, end int) (int, int) {
+	for start < end && strings.IndexByte("([{*<", text[start]) >= 0 {
+		start++
+	}
+
+	for end > start {
+		c := text[end-1]
+		switch {
+		case strings.IndexByte(".,;:!?*", c) >= 0:
+			end--
+		case c == ')' && strings.Count(text[start:end], "(") < strings.Count(text[start:end], ")"),
+			c == ']' && strings.Count(text[start:end], "[") < strings.Count(text[start:end], "]"),
+			c == '}' && strings.Count(text[start:end], "{") < strings.Count(text[start:end], "}"):
+			end--
+		default:
+			return start, end
+		}
+	}
+
+	return start, end
+}
+
+// isTokenDelimiter reports whether r separates candidate tokens
+func isTokenDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("<>\"'`|\\^", r) || r == utf8.RuneError
+}
+
+// isSchemeByte reports whether c may appear in a URL scheme
+func isSchemeByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '+' || c == '-' || c == '.'
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validHostLabels reports whether every label of host is a plausible DNS
+// label of letters, digits and inner hyphens
+func validHostLabels(host string) bool {
+	if len(host) > maxDomainLength {
+		return false
+	}
+
+	for _, label := range strings.Split(host, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, r := range label {
+			if r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
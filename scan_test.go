@@ -0,0 +1,98 @@
+// file: scan_test.go
+// description: tests for extracting URLs and host names from text
+
+package gotld
+
+import (
+	"testing"
+)
+
+// TestScan tests finding URLs and hosts with their byte offsets
+func TestScan(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	text := "Ticket: user reported hxxps://login.example[.]co.uk/reset?id=1, " +
+		"see (www.example.com) and mail bob@corp.example.org. " +
+		"Attached file.txt and report.exe, version 1.2.3, co.uk alone, " +
+		"link:https://en.wikipedia.org/wiki/Go_(language) and host evil[dot]example(.)net:8080/x."
+
+	want := []struct {
+		text        string
+		value       string
+		url         bool
+		defanged    bool
+		registrable string
+	}{
+		{"hxxps://login.example[.]co.uk/reset?id=1", "https://login.example.co.uk/reset?id=1", true, true, "example.co.uk"},
+		{"www.example.com", "www.example.com", false, false, "example.com"},
+		{"corp.example.org", "corp.example.org", false, false, "example.org"},
+		{"https://en.wikipedia.org/wiki/Go_(language)", "https://en.wikipedia.org/wiki/Go_(language)", true, false, "wikipedia.org"},
+		{"evil[dot]example(.)net", "evil.example.net", false, true, "example.net"},
+	}
+
+	got := fqdn.Scan(text)
+	if len(got) != len(want) {
+		for _, m := range got {
+			t.Logf("match %q", m.Text)
+		}
+		t.Fatalf("Scan() found %d matches, want %d", len(got), len(want))
+	}
+
+	for i, w := range want {
+		m := got[i]
+		if m.Text != w.text || m.Value != w.value || m.URL != w.url || m.Defanged != w.defanged {
+			t.Errorf("match %d = %+v, want %+v", i, m, w)
+		}
+
+		if text[m.Start:m.End] != m.Text {
+			t.Errorf("match %d offsets [%d:%d] = %q, want %q", i, m.Start, m.End, text[m.Start:m.End], m.Text)
+		}
+
+		if m.Result == nil || m.Result.RegistrableDomain != w.registrable {
+			t.Errorf("match %d result = %+v, want %s", i, m.Result, w.registrable)
+		}
+	}
+}
+
+// TestScanNoMatches tests text without valid candidates
+func TestScanNoMatches(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	inputs := []string{
+		"",
+		"nothing to see here",
+		"open config.yaml, main.go.bak or 10.0.0.1",
+		"bad-.example.com and exa_mple.com",
+		"mailto:someone and ftp:// alone",
+	}
+
+	for _, input := range inputs {
+		if got := fqdn.Scan(input); len(got) != 0 {
+			t.Errorf("Scan(%q) = %+v, want none", input, got)
+		}
+	}
+}
+
+// TestRefang tests the offset mapping of refanged tokens
+func TestRefang(t *testing.T) {
+	token := "hXXp[:]//a[.]b"
+	got, pos := refang(token)
+	if got != "http://a.b" {
+		t.Fatalf("refang() = %q, want %q", got, "http://a.b")
+	}
+
+	if len(pos) != len(got)+1 || pos[len(got)] != len(token) {
+		t.Fatalf("refang() positions = %v", pos)
+	}
+
+	// "a" and "b" map back to their original bytes
+	if token[pos[7]] != 'a' || token[pos[9]] != 'b' {
+		t.Errorf("refang() positions = %v", pos)
+	}
+}
@@ -0,0 +1,312 @@
+// file: server/server.go
+// description: HTTP JSON service exposing gotld lookups
+
+// Package server exposes an FQDN manager as an HTTP JSON service so that
+// non-Go services get exactly the same suffix logic as the library.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// Config holds the service limits
+type Config struct {
+	// MaxBatchSize is the largest number of inputs accepted by /v1/batch
+	MaxBatchSize int
+
+	// MaxBodyBytes limits the size of request bodies
+	MaxBodyBytes int64
+
+	// MaxInputLength limits the length of a single URL or host
+	MaxInputLength int
+
+	// MaxListAge makes /healthz fail when the list is older; zero disables
+	MaxListAge time.Duration
+
+	// ShutdownTimeout bounds graceful shutdown
+	ShutdownTimeout time.Duration
+}
+
+// DefaultConfig returns the default service limits
+func DefaultConfig() *Config {
+	return &Config{
+		MaxBatchSize:    1000,
+		MaxBodyBytes:    1 << 20,
+		MaxInputLength:  2048,
+		MaxListAge:      0,
+		ShutdownTimeout: 10 * time.Second,
+	}
+}
+
+// Server serves the JSON API for an FQDN manager
+type Server struct {
+	fqdn *gotld.FQDN
+	cfg  *Config
+	mux  *http.ServeMux
+}
+
+// New creates a Server for f; a nil cfg uses DefaultConfig
+func New(f *gotld.FQDN, cfg *Config) *Server {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+
+	s := &Server{fqdn: f, cfg: cfg, mux: http.NewServeMux()}
+	s.mux.HandleFunc("GET /v1/parse", s.handleParse)
+	s.mux.HandleFunc("GET /v1/registrable-domain", s.handleRegistrableDomain)
+	s.mux.HandleFunc("GET /v1/same-site", s.handleSameSite)
+	s.mux.HandleFunc("POST /v1/batch", s.handleBatch)
+	s.mux.HandleFunc("GET /healthz", s.handleHealth)
+	s.mux.HandleFunc("GET /status", s.handleStatus)
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// ListenAndServe serves on addr until ctx is cancelled, then shuts down
+// gracefully, waiting up to ShutdownTimeout for requests to finish
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, ln)
+}
+
+// Serve is like ListenAndServe but accepts connections on ln
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	srv := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       15 * time.Second,
+		WriteTimeout:      15 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		MaxHeaderBytes:    16 << 10,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// errorResponse is the body of failed requests
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// batchRequest is the body of /v1/batch
+type batchRequest struct {
+	URLs []string `json:"urls"`
+}
+
+// batchItem is one result of /v1/batch
+type batchItem struct {
+	*gotld.ParseResult
+	Input string `json:"input"`
+	Error string `json:"error,omitempty"`
+}
+
+// handleParse serves GET /v1/parse?url=
+func (s *Server) handleParse(w http.ResponseWriter, r *http.Request) {
+	input, ok := s.input(w, r, "url")
+	if !ok {
+		return
+	}
+
+	res, err := s.fqdn.Parse(input)
+	if err != nil {
+		s.writeLookupError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// handleRegistrableDomain serves GET /v1/registrable-domain?host=
+func (s *Server) handleRegistrableDomain(w http.ResponseWriter, r *http.Request) {
+	input, ok := s.input(w, r, "host")
+	if !ok {
+		return
+	}
+
+	domain, err := s.fqdn.GetFQDN(input)
+	if err != nil {
+		s.writeLookupError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"input":              input,
+		"registrable_domain": domain,
+	})
+}
+
+// handleSameSite serves GET /v1/same-site?a=&b=
+func (s *Server) handleSameSite(w http.ResponseWriter, r *http.Request) {
+	a, ok := s.input(w, r, "a")
+	if !ok {
+		return
+	}
+
+	b, ok := s.input(w, r, "b")
+	if !ok {
+		return
+	}
+
+	same, err := s.fqdn.SameSite(a, b)
+	if err != nil {
+		s.writeLookupError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"a":         a,
+		"b":         b,
+		"same_site": same,
+	})
+}
+
+// handleBatch serves POST /v1/batch
+func (s *Server) handleBatch(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, s.cfg.MaxBodyBytes)
+
+	var req batchRequest
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse{Error: "request body too large"})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid JSON body"})
+		return
+	}
+
+	if len(req.URLs) > s.cfg.MaxBatchSize {
+		writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse{Error: "too many urls in batch"})
+		return
+	}
+
+	results := make([]batchItem, len(req.URLs))
+	for i, input := range req.URLs {
+		results[i].Input = input
+		if len(input) > s.cfg.MaxInputLength {
+			results[i].Error = "input too long"
+			continue
+		}
+
+		res, err := s.fqdn.Parse(input)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].ParseResult = res
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"results": results})
+}
+
+// handleHealth serves GET /healthz
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	status := s.fqdn.Status()
+	if !status.Healthy(s.cfg.MaxListAge, time.Now()) {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unhealthy"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleStatus serves GET /status
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	status := s.fqdn.Status()
+
+	resp := map[string]any{
+		"source":       status.Source,
+		"version":      status.Version,
+		"commit":       status.Commit,
+		"rules":        status.Rules,
+		"builtin":      status.Builtin,
+		"fallback":     status.Fallback,
+		"loaded_at":    status.LoadedAt,
+		"last_refresh": status.LastRefresh,
+		"healthy":      status.Healthy(s.cfg.MaxListAge, time.Now()),
+	}
+	if age, ok := status.Age(time.Now()); ok {
+		resp["age_seconds"] = age.Seconds()
+	}
+	if status.LastError != nil {
+		resp["last_error"] = status.LastError.Error()
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// input reads and validates a query parameter
+func (s *Server) input(w http.ResponseWriter, r *http.Request, name string) (string, bool) {
+	value := r.URL.Query().Get(name)
+	switch {
+	case value == "":
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "missing " + name + " parameter"})
+		return "", false
+	case len(value) > s.cfg.MaxInputLength:
+		writeJSON(w, http.StatusRequestURITooLong, errorResponse{Error: name + " parameter too long"})
+		return "", false
+	}
+
+	return value, true
+}
+
+// writeLookupError maps lookup errors to HTTP status codes
+func (s *Server) writeLookupError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, gotld.ErrInvalidURL):
+		code = http.StatusBadRequest
+	case errors.Is(err, gotld.ErrInvalidTLD):
+		code = http.StatusUnprocessableEntity
+	}
+
+	writeJSON(w, code, errorResponse{Error: err.Error()})
+}
+
+// writeJSON writes v as a JSON response
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,195 @@
+// file: server/server_test.go
+// description: tests for the HTTP JSON service
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// newTestServer creates a Server backed by the built-in table
+func newTestServer(t *testing.T, cfg *Config) *httptest.Server {
+	t.Helper()
+
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ts := httptest.NewServer(New(f, cfg))
+	t.Cleanup(ts.Close)
+
+	return ts
+}
+
+// getJSON performs a request and decodes the JSON response
+func getJSON(t *testing.T, method, url, body string) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var out map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatalf("invalid JSON response: %v", err)
+	}
+
+	return resp.StatusCode, out
+}
+
+// TestEndpoints tests each endpoint
+func TestEndpoints(t *testing.T) {
+	ts := newTestServer(t, nil)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		code   int
+		key    string
+		value  any
+	}{
+		{"Parse", "GET", "/v1/parse?url=https://www.example.co.uk/x", "", 200, "registrable_domain", "example.co.uk"},
+		{"Parse subdomain", "GET", "/v1/parse?url=a.b.example.com", "", 200, "subdomain", "a.b"},
+		{"Parse invalid", "GET", "/v1/parse?url=invalid", "", 400, "error", "invalid URL"},
+		{"Parse unknown TLD", "GET", "/v1/parse?url=example.invalidtld", "", 422, "error", "invalid TLD"},
+		{"Parse missing", "GET", "/v1/parse", "", 400, "error", "missing url parameter"},
+		{"Registrable domain", "GET", "/v1/registrable-domain?host=api.example.com", "", 200, "registrable_domain", "example.com"},
+		{"Same site", "GET", "/v1/same-site?a=www.example.com&b=example.com", "", 200, "same_site", true},
+		{"Not same site", "GET", "/v1/same-site?a=www.example.com&b=example.org", "", 200, "same_site", false},
+		{"Health", "GET", "/healthz", "", 200, "status", "ok"},
+		{"Status", "GET", "/status", "", 200, "builtin", true},
+		{"Batch bad JSON", "POST", "/v1/batch", "{", 400, "error", "invalid JSON body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out := getJSON(t, tt.method, ts.URL+tt.path, tt.body)
+			if code != tt.code {
+				t.Errorf("status = %d, want %d (%v)", code, tt.code, out)
+			}
+			if out[tt.key] != tt.value {
+				t.Errorf("%s = %v, want %v", tt.key, out[tt.key], tt.value)
+			}
+		})
+	}
+}
+
+// TestStatusKeys tests that /status uses snake_case keys throughout
+func TestStatusKeys(t *testing.T) {
+	ts := newTestServer(t, nil)
+
+	code, out := getJSON(t, "GET", ts.URL+"/status", "")
+	if code != 200 {
+		t.Fatalf("status = %d, want 200", code)
+	}
+
+	source, ok := out["source"].(map[string]any)
+	if !ok {
+		t.Fatalf("source = %v, want an object", out["source"])
+	}
+
+	for _, key := range []string{"name", "version", "fetched_at", "fallback"} {
+		if _, ok := source[key]; !ok {
+			t.Errorf("source has no %q key: %v", key, source)
+		}
+	}
+	for key := range source {
+		if strings.ToLower(key) != key {
+			t.Errorf("source key %q is not snake_case", key)
+		}
+	}
+
+	if source["name"] != "builtin" {
+		t.Errorf("source name = %v, want builtin", source["name"])
+	}
+}
+
+// TestBatch tests the batch endpoint and its limits
+func TestBatch(t *testing.T) {
+	ts := newTestServer(t, &Config{MaxBatchSize: 3, MaxBodyBytes: 256, MaxInputLength: 32})
+
+	code, out := getJSON(t, "POST", ts.URL+"/v1/batch", `{"urls":["www.example.com","invalid","`+strings.Repeat("a", 40)+`.com"]}`)
+	if code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (%v)", code, out)
+	}
+
+	results, ok := out["results"].([]any)
+	if !ok || len(results) != 3 {
+		t.Fatalf("results = %v", out["results"])
+	}
+
+	first := results[0].(map[string]any)
+	if first["input"] != "www.example.com" || first["registrable_domain"] != "example.com" {
+		t.Errorf("first result = %v", first)
+	}
+	if results[1].(map[string]any)["error"] != "invalid URL" {
+		t.Errorf("second result = %v", results[1])
+	}
+	if results[2].(map[string]any)["error"] != "input too long" {
+		t.Errorf("third result = %v", results[2])
+	}
+
+	code, _ = getJSON(t, "POST", ts.URL+"/v1/batch", `{"urls":["a.com","b.com","c.com","d.com"]}`)
+	if code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized batch status = %d, want 413", code)
+	}
+
+	code, _ = getJSON(t, "POST", ts.URL+"/v1/batch", `{"urls":["`+strings.Repeat("a", 300)+`"]}`)
+	if code != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized body status = %d, want 413", code)
+	}
+}
+
+// TestGracefulShutdown tests that Serve returns once the context ends
+func TestGracefulShutdown(t *testing.T) {
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- New(f, nil).Serve(ctx, ln)
+	}()
+
+	resp, err := http.Get("http://" + ln.Addr().String() + "/healthz")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Serve() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve() did not return after cancellation")
+	}
+}
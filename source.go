@@ -0,0 +1,297 @@
+// file: source.go
+// description: pluggable sources for the public suffix list
+
+package gotld
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"time"
+)
+
+// maxDataSize is the largest public suffix list that will be read in bytes
+const maxDataSize = 10 * 1024 * 1024
+
+// SourceInfo describes where a public suffix list came from
+type SourceInfo struct {
+	// Name is a human readable description of the source
+	Name string `json:"name"`
+
+	// Version is the list version if known by the source
+	Version string `json:"version,omitempty"`
+
+	// ETag is the HTTP entity tag if the list was downloaded
+	ETag string `json:"etag,omitempty"`
+
+	// FetchedAt is the time the list was opened
+	FetchedAt time.Time `json:"fetched_at"`
+
+	// Fallback is true when a chained source or mirror other than the
+	// first one provided the list
+	Fallback bool `json:"fallback"`
+}
+
+// Source provides the raw public suffix list
+type Source interface {
+	// Open returns a reader for the list; the caller must close it
+	Open(ctx context.Context) (io.ReadCloser, SourceInfo, error)
+}
+
+// URLSource downloads the public suffix list over HTTP(S)
+type URLSource struct {
+	// URL is the address of the list
+	URL string
+
+	// Client is the HTTP client to use; a secure default is used when nil
+	Client *http.Client
+}
+
+// NewURLSource creates a URLSource for the given URL and client
+func NewURLSource(fileURL string, client *http.Client) *URLSource {
+	return &URLSource{URL: fileURL, Client: client}
+}
+
+// Open downloads the list and returns it as a reader
+func (s *URLSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	client := s.Client
+	if client == nil {
+		client = newHTTPClient(0)
+	}
+
+	res, err := download(ctx, client, s.URL)
+	if err != nil {
+		return nil, SourceInfo{}, err
+	}
+
+	return io.NopCloser(bytes.NewReader(res.data)), res.info, nil
+}
+
+// fetchResult holds the outcome of a single download attempt
+type fetchResult struct {
+	data       []byte
+	info       SourceInfo
+	status     int
+	retryAfter time.Duration
+}
+
+// download fetches the list from fileURL. On failure the result still
+// carries the HTTP status and Retry-After hint when the server sent them.
+func download(ctx context.Context, client *http.Client, fileURL string) (fetchResult, error) {
+	var res fetchResult
+
+	if fileURL == "" {
+		fileURL = publicSuffixFileURL
+	}
+
+	// Create request with context
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+	if err != nil {
+		return res, wrapError(ErrPublicSuffixDownload, err.Error())
+	}
+
+	// Set appropriate headers
+	req.Header.Set("User-Agent", "GoTLD/1.0")
+
+	// Get the data
+	resp, err := client.Do(req)
+	if err != nil {
+		return res, wrapError(ErrPublicSuffixDownload, err.Error())
+	}
+	defer resp.Body.Close()
+
+	res.status = resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		res.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		return res, wrapError(ErrPublicSuffixDownload, "unexpected status code: "+resp.Status)
+	}
+
+	// Read the response body
+	respData, err := io.ReadAll(io.LimitReader(resp.Body, maxDataSize))
+	if err != nil {
+		return res, wrapError(ErrPublicSuffixParse, err.Error())
+	}
+
+	if len(respData) < minDataSize {
+		return res, wrapError(ErrPublicSuffixParse, "response data size too small for public suffix file")
+	}
+
+	res.data = respData
+	res.info = SourceInfo{
+		Name:      fileURL,
+		ETag:      resp.Header.Get("ETag"),
+		FetchedAt: time.Now(),
+	}
+
+	return res, nil
+}
+
+// FileSource reads the public suffix list from a local file
+type FileSource struct {
+	// Path is the location of the list
+	Path string
+}
+
+// NewFileSource creates a FileSource for the given path
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+// Open opens the file
+func (s *FileSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	if s.Path == "" {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, "no file path provided")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, err.Error())
+	}
+
+	file, err := os.Open(s.Path)
+	if err != nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, err.Error())
+	}
+
+	return file, SourceInfo{Name: "file:" + s.Path, FetchedAt: time.Now()}, nil
+}
+
+// EmbeddedSource reads the public suffix list from a file system, typically
+// an embed.FS compiled into the calling program
+type EmbeddedSource struct {
+	// FS is the file system holding the list
+	FS fs.FS
+
+	// Name is the path of the list within FS
+	Name string
+
+	// Version is reported as the list version when set
+	Version string
+}
+
+// NewEmbeddedSource creates an EmbeddedSource for the named file in fsys
+func NewEmbeddedSource(fsys fs.FS, name string) *EmbeddedSource {
+	return &EmbeddedSource{FS: fsys, Name: name}
+}
+
+// Open opens the embedded file
+func (s *EmbeddedSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	if s.FS == nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, "no file system provided")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, err.Error())
+	}
+
+	file, err := s.FS.Open(s.Name)
+	if err != nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, err.Error())
+	}
+
+	info := SourceInfo{
+		Name:      "embedded:" + s.Name,
+		Version:   s.Version,
+		FetchedAt: time.Now(),
+	}
+
+	return file, info, nil
+}
+
+// ReaderSource reads the public suffix list from an io.Reader. The reader is
+// consumed by the first Open, so a ReaderSource can only be loaded once.
+type ReaderSource struct {
+	// Reader holds the list
+	Reader io.Reader
+
+	// Name is reported as the source name
+	Name string
+}
+
+// NewReaderSource creates a ReaderSource for r
+func NewReaderSource(r io.Reader) *ReaderSource {
+	return &ReaderSource{Reader: r, Name: "reader"}
+}
+
+// Open returns the reader
+func (s *ReaderSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	if s.Reader == nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, "no reader provided")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, err.Error())
+	}
+
+	if rc, ok := s.Reader.(io.ReadCloser); ok {
+		return rc, SourceInfo{Name: s.Name, FetchedAt: time.Now()}, nil
+	}
+
+	return io.NopCloser(s.Reader), SourceInfo{Name: s.Name, FetchedAt: time.Now()}, nil
+}
+
+// ChainSource tries each source in order and returns the first that opens.
+// When a manager loads a ChainSource it also moves on to the next source
+// if a list fails integrity verification or parsing.
+type ChainSource struct {
+	// Sources are tried in order
+	Sources []Source
+}
+
+// NewChainSource creates a ChainSource over the given sources
+func NewChainSource(sources ...Source) *ChainSource {
+	return &ChainSource{Sources: sources}
+}
+
+// Open returns the first source that opens successfully. If all fail the
+// errors of every attempt are joined.
+func (s *ChainSource) Open(ctx context.Context) (io.ReadCloser, SourceInfo, error) {
+	if len(s.Sources) == 0 {
+		return nil, SourceInfo{}, wrapError(ErrPublicSuffixRead, "no sources provided")
+	}
+
+	var errs []error
+	for i, src := range s.Sources {
+		rc, info, err := src.Open(ctx)
+		if err == nil {
+			info.Fallback = info.Fallback || i > 0
+			return rc, info, nil
+		}
+		errs = append(errs, err)
+
+		// Stop early if the caller gave up
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, SourceInfo{}, errors.Join(errs...)
+}
+
+// newHTTPClient creates an HTTP client with proper security settings
+func newHTTPClient(timeout time.Duration) *http.Client {
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       30 * time.Second,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
@@ -0,0 +1,152 @@
+// file: source_test.go
+// description: tests for the public suffix list sources
+
+package gotld
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// testList is a small public suffix list used by the tests
+const testList = `// The Public Suffix List
+// https://publicsuffix.org/list/public_suffix_list.dat
+// VERSION: 2025-01-01_00-00-00_UTC
+// COMMIT: 0123456789abcdef
+
+// ===BEGIN ICANN DOMAINS===
+com
+org
+uk
+co.uk
+jp
+ac.jp
+*.kawasaki.jp
+!city.kawasaki.jp
+// ===END ICANN DOMAINS===
+
+// ===BEGIN PRIVATE DOMAINS===
+github.io
+// ===END PRIVATE DOMAINS===
+`
+
+// TestSources tests loading the list from each built-in source
+func TestSources(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "list.dat")
+	if err := os.WriteFile(path, []byte(testList), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	fsys := fstest.MapFS{"psl/list.dat": &fstest.MapFile{Data: []byte(testList)}}
+
+	tests := []struct {
+		name    string
+		source  Source
+		info    string
+		wantErr bool
+	}{
+		{
+			name:   "File source",
+			source: NewFileSource(path),
+			info:   "file:" + path,
+		},
+		{
+			name:   "Embedded source",
+			source: NewEmbeddedSource(fsys, "psl/list.dat"),
+			info:   "embedded:psl/list.dat",
+		},
+		{
+			name:   "Reader source",
+			source: NewReaderSource(strings.NewReader(testList)),
+			info:   "reader",
+		},
+		{
+			name:   "Chain falls back",
+			source: NewChainSource(NewFileSource(filepath.Join(dir, "missing.dat")), NewFileSource(path)),
+			info:   "file:" + path,
+		},
+		{
+			name:   "Chain falls back on unparsable list",
+			source: NewChainSource(NewReaderSource(strings.NewReader("not a list")), NewFileSource(path)),
+			info:   "file:" + path,
+		},
+		{
+			name:    "Missing file",
+			source:  NewFileSource(filepath.Join(dir, "missing.dat")),
+			wantErr: true,
+		},
+		{
+			name:    "Empty chain",
+			source:  NewChainSource(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fqdn, err := newFQDN(&Options{Source: tt.source})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newFQDN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrPublicSuffixRead) {
+					t.Errorf("newFQDN() error = %v, want %v", err, ErrPublicSuffixRead)
+				}
+				return
+			}
+
+			if fqdn.source.Name != tt.info {
+				t.Errorf("source name = %q, want %q", fqdn.source.Name, tt.info)
+			}
+
+			got, err := fqdn.GetFQDN("www.example.co.uk")
+			if err != nil || got != "example.co.uk" {
+				t.Errorf("GetFQDN() = %v, %v, want example.co.uk", got, err)
+			}
+
+			// Suffixes missing from the test list are not recognised
+			if _, err := fqdn.GetFQDN("example.net"); !errors.Is(err, ErrInvalidTLD) {
+				t.Errorf("GetFQDN() error = %v, want %v", err, ErrInvalidTLD)
+			}
+		})
+	}
+}
+
+// TestURLSource tests downloading the list with ETag metadata
+func TestURLSource(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(testList))
+		w.Write([]byte(strings.Repeat("// padding\n", minDataSize/10)))
+	}))
+	defer ts.Close()
+
+	src := NewURLSource(ts.URL, nil)
+	rc, info, err := src.Open(context.Background())
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	rc.Close()
+
+	if info.ETag != `"v1"` {
+		t.Errorf("ETag = %q, want %q", info.ETag, `"v1"`)
+	}
+
+	if info.Name != ts.URL {
+		t.Errorf("Name = %q, want %q", info.Name, ts.URL)
+	}
+
+	if time.Since(info.FetchedAt) > time.Minute {
+		t.Errorf("FetchedAt = %v, want recent time", info.FetchedAt)
+	}
+}
@@ -0,0 +1,160 @@
+// file: ssrf/classify.go
+// description: classifies IP addresses by the reserved range they belong to
+
+package ssrf
+
+import (
+	"net/netip"
+)
+
+// Class is the kind of range an IP address belongs to
+type Class int
+
+const (
+	// ClassPublic is a globally routable unicast address
+	ClassPublic Class = iota
+
+	// ClassPrivate is a private network address, such as 10.0.0.0/8,
+	// the shared 100.64.0.0/10 range or IPv6 unique local fc00::/7
+	ClassPrivate
+
+	// ClassLoopback is a loopback address, such as 127.0.0.1 or ::1
+	ClassLoopback
+
+	// ClassLinkLocal is a link-local address, such as 169.254.0.0/16
+	ClassLinkLocal
+
+	// ClassMetadata is a cloud instance metadata endpoint, such as
+	// 169.254.169.254
+	ClassMetadata
+
+	// ClassMulticast is a multicast address
+	ClassMulticast
+
+	// ClassUnspecified is an unspecified address, such as 0.0.0.0 or ::
+	ClassUnspecified
+
+	// ClassReserved is any other special-purpose address, such as the
+	// documentation, benchmarking or future-use ranges
+	ClassReserved
+
+	// ClassDenied is an address blocked by the policy
+	ClassDenied
+)
+
+// String returns the name of the class
+func (c Class) String() string {
+	switch c {
+	case ClassPublic:
+		return "public"
+	case ClassPrivate:
+		return "private"
+	case ClassLoopback:
+		return "loopback"
+	case ClassLinkLocal:
+		return "link-local"
+	case ClassMetadata:
+		return "metadata"
+	case ClassMulticast:
+		return "multicast"
+	case ClassUnspecified:
+		return "unspecified"
+	case ClassReserved:
+		return "reserved"
+	case ClassDenied:
+		return "denied"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (c Class) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// classRange is a special-purpose range, checked in order
+type classRange struct {
+	prefix netip.Prefix
+	class  Class
+}
+
+// ranges are the IANA special-purpose ranges; metadata endpoints come
+// before the link-local range they live in
+var ranges = []classRange{
+	// Cloud metadata: AWS, GCP, Azure and others; AWS ECS task metadata;
+	// Alibaba Cloud; AWS IPv6
+	{netip.MustParsePrefix("169.254.169.254/32"), ClassMetadata},
+	{netip.MustParsePrefix("169.254.170.2/32"), ClassMetadata},
+	{netip.MustParsePrefix("100.100.100.200/32"), ClassMetadata},
+	{netip.MustParsePrefix("fd00:ec2::254/128"), ClassMetadata},
+
+	// IPv4, RFC 6890
+	{netip.MustParsePrefix("0.0.0.0/8"), ClassUnspecified},
+	{netip.MustParsePrefix("10.0.0.0/8"), ClassPrivate},
+	{netip.MustParsePrefix("100.64.0.0/10"), ClassPrivate},
+	{netip.MustParsePrefix("127.0.0.0/8"), ClassLoopback},
+	{netip.MustParsePrefix("169.254.0.0/16"), ClassLinkLocal},
+	{netip.MustParsePrefix("172.16.0.0/12"), ClassPrivate},
+	{netip.MustParsePrefix("192.0.0.0/24"), ClassReserved},
+	{netip.MustParsePrefix("192.0.2.0/24"), ClassReserved},
+	{netip.MustParsePrefix("192.88.99.0/24"), ClassReserved},
+	{netip.MustParsePrefix("192.168.0.0/16"), ClassPrivate},
+	{netip.MustParsePrefix("198.18.0.0/15"), ClassReserved},
+	{netip.MustParsePrefix("198.51.100.0/24"), ClassReserved},
+	{netip.MustParsePrefix("203.0.113.0/24"), ClassReserved},
+	{netip.MustParsePrefix("224.0.0.0/4"), ClassMulticast},
+	{netip.MustParsePrefix("240.0.0.0/4"), ClassReserved},
+
+	// IPv6, RFC 6890
+	{netip.MustParsePrefix("::/128"), ClassUnspecified},
+	{netip.MustParsePrefix("::1/128"), ClassLoopback},
+	{netip.MustParsePrefix("::/96"), ClassReserved},
+	{netip.MustParsePrefix("64:ff9b:1::/48"), ClassReserved},
+	{netip.MustParsePrefix("100::/64"), ClassReserved},
+	{netip.MustParsePrefix("2001::/23"), ClassReserved},
+	{netip.MustParsePrefix("2001:db8::/32"), ClassReserved},
+	{netip.MustParsePrefix("fc00::/7"), ClassPrivate},
+	{netip.MustParsePrefix("fe80::/10"), ClassLinkLocal},
+	{netip.MustParsePrefix("fec0::/10"), ClassPrivate},
+	{netip.MustParsePrefix("ff00::/8"), ClassMulticast},
+}
+
+// Translation prefixes that embed an IPv4 address
+var (
+	nat64     = netip.MustParsePrefix("64:ff9b::/96")
+	sixToFour = netip.MustParsePrefix("2002::/16")
+)
+
+// Classify returns the class of addr. IPv4-mapped, NAT64 and 6to4
+// addresses are classified by the IPv4 address they embed, so
+// "::ffff:127.0.0.1" is loopback.
+func Classify(addr netip.Addr) Class {
+	if !addr.IsValid() {
+		return ClassReserved
+	}
+	addr = embeddedIPv4(addr.WithZone(""))
+
+	for _, r := range ranges {
+		if r.prefix.Contains(addr) {
+			return r.class
+		}
+	}
+	return ClassPublic
+}
+
+// embeddedIPv4 returns the IPv4 address embedded in a mapped, NAT64 or
+// 6to4 address, or addr itself
+func embeddedIPv4(addr netip.Addr) netip.Addr {
+	if addr.Is4In6() {
+		return addr.Unmap()
+	}
+
+	b := addr.As16()
+	switch {
+	case nat64.Contains(addr):
+		return netip.AddrFrom4([4]byte{b[12], b[13], b[14], b[15]})
+	case sixToFour.Contains(addr):
+		return netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]})
+	}
+	return addr
+}
@@ -0,0 +1,265 @@
+// file: ssrf/dialer.go
+// description: dialer that blocks connections to internal addresses
+
+// Package ssrf protects outbound requests to user-supplied URLs, such as
+// webhooks and link previews, against server-side request forgery. The
+// Dialer resolves each host itself, checks every resolved address against
+// the reserved ranges and dials the checked address directly, so a DNS
+// answer that changes between check and connect (DNS rebinding) cannot
+// reach an internal service. Hosts can also be restricted to an allow
+// list of registrable domains.
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+var (
+	// ErrBlockedAddress is returned when a host resolves to a blocked address
+	ErrBlockedAddress = errors.New("address is blocked")
+
+	// ErrDomainNotAllowed is returned when a host is outside the allowed domains
+	ErrDomainNotAllowed = errors.New("domain is not allowed")
+
+	// ErrPortNotAllowed is returned when a port is outside the allowed ports
+	ErrPortNotAllowed = errors.New("port is not allowed")
+)
+
+// BlockedError describes a connection refused because of its address
+type BlockedError struct {
+	// Host is the host that was dialed
+	Host string
+
+	// Addr is the blocked address
+	Addr netip.Addr
+
+	// Class is the range Addr belongs to
+	Class Class
+}
+
+// Error implements error
+func (e *BlockedError) Error() string {
+	if e.Host == e.Addr.String() {
+		return fmt.Sprintf("%s is %s: %v", e.Host, e.Class, ErrBlockedAddress)
+	}
+	return fmt.Sprintf("%s resolves to %s address %s: %v", e.Host, e.Class, e.Addr, ErrBlockedAddress)
+}
+
+// Unwrap returns ErrBlockedAddress
+func (e *BlockedError) Unwrap() error {
+	return ErrBlockedAddress
+}
+
+// Resolver resolves host names; *net.Resolver implements it
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// Policy configures which destinations the Dialer may connect to
+type Policy struct {
+	// AllowedDomains restricts hosts to these gotld.HostMatcher patterns:
+	// registrable domains, exact host names or "*." suffix patterns. Empty
+	// allows any host; IP literals never match.
+	AllowedDomains []string
+
+	// AllowedPorts restricts the ports that may be dialed; empty allows any
+	AllowedPorts []int
+
+	// AllowedPrefixes are exempt from blocking, e.g. an internal range
+	// that hosts trusted services
+	AllowedPrefixes []netip.Prefix
+
+	// BlockedPrefixes are blocked in addition to the reserved ranges
+	BlockedPrefixes []netip.Prefix
+}
+
+// Dialer dials only addresses allowed by its policy
+type Dialer struct {
+	// Resolver resolves host names; nil uses net.DefaultResolver
+	Resolver Resolver
+
+	// Dialer makes the connections; nil uses a zero net.Dialer
+	Dialer *net.Dialer
+
+	policy  Policy
+	allowed *gotld.HostMatcher
+}
+
+// New creates a Dialer. The manager determines registrable domains for
+// Policy.AllowedDomains; nil uses the global manager. It fails if an
+// allowed domain is not a valid pattern.
+func New(f *gotld.FQDN, policy Policy) (*Dialer, error) {
+	d := &Dialer{policy: policy}
+	if len(policy.AllowedDomains) == 0 {
+		return d, nil
+	}
+
+	if f == nil {
+		var err error
+		if f, err = gotld.Manager(); err != nil {
+			return nil, err
+		}
+	}
+
+	allowed, err := f.NewHostMatcher(policy.AllowedDomains)
+	if err != nil {
+		return nil, fmt.Errorf("allowed domains: %w", err)
+	}
+	d.allowed = allowed
+
+	return d, nil
+}
+
+// Transport returns a clone of http.DefaultTransport that dials through d.
+// Proxies are disabled, as a proxy would connect on the caller's behalf.
+func (d *Dialer) Transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = d.DialContext
+	return t
+}
+
+// DialContext connects to address like net.Dialer.DialContext after
+// checking the port, the host and every address it resolves to. If any
+// address is blocked the connection is refused, as an attacker controlling
+// DNS could otherwise mix internal and public answers.
+func (d *Dialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q", portStr)
+	}
+	if len(d.policy.AllowedPorts) > 0 && !slices.Contains(d.policy.AllowedPorts, int(port)) {
+		return nil, fmt.Errorf("%w: %d", ErrPortNotAllowed, port)
+	}
+
+	addrs, err := d.resolve(ctx, ipNetwork(network), host)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := d.Dialer
+	if dialer == nil {
+		dialer = &net.Dialer{}
+	}
+
+	// Dial the checked addresses, not the name, so DNS is not consulted again
+	for _, addr := range addrs {
+		conn, dialErr := dialer.DialContext(ctx, network, netip.AddrPortFrom(addr, uint16(port)).String())
+		if dialErr == nil {
+			return conn, nil
+		}
+		err = dialErr
+	}
+
+	return nil, err
+}
+
+// Check resolves host, a host name or IP literal, and returns its
+// addresses if the policy allows connecting to all of them
+func (d *Dialer) Check(ctx context.Context, host string) ([]netip.Addr, error) {
+	return d.resolve(ctx, "ip", host)
+}
+
+// resolve checks host against the allowed domains, resolves it on network
+// ("ip", "ip4" or "ip6") and checks the addresses
+func (d *Dialer) resolve(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	host = strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), ".")
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if d.allowed != nil {
+			return nil, fmt.Errorf("%w: %s is an IP address", ErrDomainNotAllowed, host)
+		}
+		if err := d.checkAddr(host, addr); err != nil {
+			return nil, err
+		}
+		return []netip.Addr{addr.Unmap()}, nil
+	}
+
+	if err := d.checkDomain(host); err != nil {
+		return nil, err
+	}
+
+	resolver := d.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	addrs, err := resolver.LookupNetIP(ctx, network, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	for i, addr := range addrs {
+		if err := d.checkAddr(host, addr); err != nil {
+			return nil, err
+		}
+		addrs[i] = addr.Unmap()
+	}
+
+	return addrs, nil
+}
+
+// checkDomain checks host against Policy.AllowedDomains
+func (d *Dialer) checkDomain(host string) error {
+	if d.allowed == nil {
+		return nil
+	}
+
+	if _, ok := d.allowed.Match(host); !ok {
+		return fmt.Errorf("%w: %s", ErrDomainNotAllowed, host)
+	}
+
+	return nil
+}
+
+// checkAddr returns a BlockedError if the policy blocks addr
+func (d *Dialer) checkAddr(host string, addr netip.Addr) error {
+	addr = addr.WithZone("")
+
+	for _, prefix := range d.policy.AllowedPrefixes {
+		if prefix.Contains(addr.Unmap()) {
+			return nil
+		}
+	}
+
+	class := Classify(addr)
+	for _, prefix := range d.policy.BlockedPrefixes {
+		if class == ClassPublic && prefix.Contains(addr.Unmap()) {
+			class = ClassDenied
+		}
+	}
+
+	if class != ClassPublic {
+		return &BlockedError{Host: host, Addr: addr, Class: class}
+	}
+	return nil
+}
+
+// ipNetwork returns the resolver network for a dial network
+func ipNetwork(network string) string {
+	switch {
+	case strings.HasSuffix(network, "4"):
+		return "ip4"
+	case strings.HasSuffix(network, "6"):
+		return "ip6"
+	}
+	return "ip"
+}
@@ -0,0 +1,219 @@
+// file: ssrf/ssrf_test.go
+// description: tests for the SSRF-protecting dialer and classifier
+
+package ssrf
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/AndrewDonelson/gotld"
+)
+
+// stubResolver answers lookups from a fixed table
+type stubResolver map[string][]string
+
+// LookupNetIP implements Resolver
+func (r stubResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	answers, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	addrs := make([]netip.Addr, len(answers))
+	for i, a := range answers {
+		addrs[i] = netip.MustParseAddr(a)
+	}
+	return addrs, nil
+}
+
+// newDialer creates a Dialer and fails the test on error
+func newDialer(t *testing.T, f *gotld.FQDN, policy Policy) *Dialer {
+	t.Helper()
+
+	d, err := New(f, policy)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return d
+}
+
+// TestClassify tests address classification
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		addr string
+		want Class
+	}{
+		{"93.184.216.34", ClassPublic},
+		{"2606:2800:220:1::1", ClassPublic},
+		{"10.1.2.3", ClassPrivate},
+		{"172.31.255.255", ClassPrivate},
+		{"172.32.0.1", ClassPublic},
+		{"192.168.1.1", ClassPrivate},
+		{"100.64.0.1", ClassPrivate},
+		{"127.0.0.1", ClassLoopback},
+		{"127.255.255.254", ClassLoopback},
+		{"::1", ClassLoopback},
+		{"169.254.1.1", ClassLinkLocal},
+		{"fe80::1%eth0", ClassLinkLocal},
+		{"169.254.169.254", ClassMetadata},
+		{"fd00:ec2::254", ClassMetadata},
+		{"100.100.100.200", ClassMetadata},
+		{"224.0.0.1", ClassMulticast},
+		{"ff02::1", ClassMulticast},
+		{"0.0.0.0", ClassUnspecified},
+		{"::", ClassUnspecified},
+		{"192.0.2.1", ClassReserved},
+		{"255.255.255.255", ClassReserved},
+		{"2001:db8::1", ClassReserved},
+		{"fd12:3456::1", ClassPrivate},
+		{"::ffff:127.0.0.1", ClassLoopback},
+		{"::ffff:169.254.169.254", ClassMetadata},
+		{"64:ff9b::a00:1", ClassPrivate},
+		{"2002:7f00:1::", ClassLoopback},
+		{"2002:5db8:d822::", ClassPublic},
+	}
+
+	for _, tt := range tests {
+		if got := Classify(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("Classify(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+// TestCheck tests host checks against the policy
+func TestCheck(t *testing.T) {
+	f, err := gotld.New(&gotld.Options{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	resolver := stubResolver{
+		"www.example.com":      {"93.184.216.34"},
+		"api.example.com":      {"93.184.216.35", "2606:2800:220:1::1"},
+		"rebind.example.com":   {"93.184.216.34", "10.0.0.1"},
+		"metadata.example.com": {"169.254.169.254"},
+		"www.example.org":      {"93.184.216.36"},
+		"internal.example.com": {"10.20.0.5"},
+		"api.example.org":      {"93.184.216.37"},
+		"cdn.example.net":      {"93.184.216.38"},
+		"xn--bcher-kva.de":     {"93.184.216.39"},
+	}
+
+	open := newDialer(t, f, Policy{})
+	open.Resolver = resolver
+
+	restricted := newDialer(t, f, Policy{
+		AllowedDomains:  []string{"example.com"},
+		AllowedPrefixes: []netip.Prefix{netip.MustParsePrefix("10.20.0.0/16")},
+		BlockedPrefixes: []netip.Prefix{netip.MustParsePrefix("93.184.216.35/32")},
+	})
+	restricted.Resolver = resolver
+
+	// Allowed domains follow gotld.HostMatcher rules
+	matched := newDialer(t, f, Policy{AllowedDomains: []string{"API.Example.org", "*.example.net", "bücher.de"}})
+	matched.Resolver = resolver
+
+	tests := []struct {
+		dialer *Dialer
+		host   string
+		err    error
+		class  Class
+	}{
+		{open, "www.example.com", nil, 0},
+		{open, "WWW.Example.COM.", nil, 0},
+		{open, "api.example.com", nil, 0},
+		{open, "rebind.example.com", ErrBlockedAddress, ClassPrivate},
+		{open, "metadata.example.com", ErrBlockedAddress, ClassMetadata},
+		{open, "169.254.169.254", ErrBlockedAddress, ClassMetadata},
+		{open, "[::1]", ErrBlockedAddress, ClassLoopback},
+		{open, "internal.example.com", ErrBlockedAddress, ClassPrivate},
+		{open, "93.184.216.34", nil, 0},
+		{restricted, "www.example.com", nil, 0},
+		{restricted, "internal.example.com", nil, 0},
+		{restricted, "api.example.com", ErrBlockedAddress, ClassDenied},
+		{restricted, "www.example.org", ErrDomainNotAllowed, 0},
+		{restricted, "93.184.216.34", ErrDomainNotAllowed, 0},
+		{matched, "api.example.org", nil, 0},
+		{matched, "www.example.org", ErrDomainNotAllowed, 0},
+		{matched, "cdn.example.net", nil, 0},
+		{matched, "example.net", ErrDomainNotAllowed, 0},
+		{matched, "xn--bcher-kva.de", nil, 0},
+	}
+
+	if _, err := New(f, Policy{AllowedDomains: []string{"*.*.com"}}); err == nil {
+		t.Error("New() error = nil for an invalid allowed domain")
+	}
+
+	for _, tt := range tests {
+		_, err := tt.dialer.Check(context.Background(), tt.host)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Check(%q) error = %v, want %v", tt.host, err, tt.err)
+			continue
+		}
+
+		var blocked *BlockedError
+		if errors.As(err, &blocked) && blocked.Class != tt.class {
+			t.Errorf("Check(%q) class = %v, want %v", tt.host, blocked.Class, tt.class)
+		}
+	}
+}
+
+// TestTransport tests requests through the dialer against local listeners
+func TestTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	defer srv.Close()
+
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	resolver := stubResolver{
+		"hook.example.com":   {"127.0.0.1"},
+		"rebind.example.com": {"127.0.0.1"},
+	}
+
+	// The listener is on loopback, which is only reachable when exempted
+	trusted := newDialer(t, nil, Policy{AllowedPrefixes: []netip.Prefix{netip.MustParsePrefix("127.0.0.1/32")}})
+	trusted.Resolver = resolver
+
+	client := &http.Client{Transport: trusted.Transport()}
+	resp, err := client.Get("http://hook.example.com:" + port + "/")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want ok", body)
+	}
+
+	// Without the exemption the same request is refused before connecting
+	strict := newDialer(t, nil, Policy{})
+	strict.Resolver = resolver
+
+	client = &http.Client{Transport: strict.Transport()}
+	if _, err := client.Get("http://rebind.example.com:" + port + "/"); !errors.Is(err, ErrBlockedAddress) {
+		t.Errorf("Get() error = %v, want ErrBlockedAddress", err)
+	}
+	if _, err := client.Get(srv.URL); !errors.Is(err, ErrBlockedAddress) {
+		t.Errorf("Get(%s) error = %v, want ErrBlockedAddress", srv.URL, err)
+	}
+
+	// Ports outside the allow list are refused
+	ports := newDialer(t, nil, Policy{AllowedPorts: []int{443}, AllowedPrefixes: []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}})
+	ports.Resolver = resolver
+	if _, err := ports.DialContext(context.Background(), "tcp", "hook.example.com:"+port); !errors.Is(err, ErrPortNotAllowed) {
+		t.Errorf("DialContext() error = %v, want ErrPortNotAllowed", err)
+	}
+
+	if _, err := strict.DialContext(context.Background(), "tcp", "missing"); err == nil || !strings.Contains(err.Error(), "missing port") {
+		t.Errorf("DialContext() error = %v, want missing port", err)
+	}
+}
@@ -0,0 +1,144 @@
+// file: status.go
+// description: status and health introspection of the FQDN manager
+
+package gotld
+
+import (
+	"time"
+)
+
+// RuleCounts tallies the rules of a loaded list
+type RuleCounts struct {
+	// Total is the number of rules of every type and section
+	Total int
+
+	// ICANN is the number of rules in the ICANN section
+	ICANN int
+
+	// Private is the number of rules in the PRIVATE section
+	Private int
+
+	// Normal is the number of plain rules such as "co.uk"
+	Normal int
+
+	// Wildcard is the number of wildcard rules such as "*.ck"
+	Wildcard int
+
+	// Exception is the number of exception rules such as "!www.ck"
+	Exception int
+}
+
+// Status describes the list loaded by an FQDN manager
+type Status struct {
+	// Source describes where the active list came from
+	Source SourceInfo
+
+	// Version is the VERSION header of the active list
+	Version string
+
+	// Commit is the COMMIT header of the active list
+	Commit string
+
+	// Rules are the rule counts of the active list
+	Rules RuleCounts
+
+	// Builtin is true when the built-in table is active
+	Builtin bool
+
+	// Fallback is true when the active list did not come from the
+	// preferred source, either because a later mirror or chained source
+	// was used or because loading failed and the built-in table was kept
+	Fallback bool
+
+	// LoadedAt is the time the active list was loaded
+	LoadedAt time.Time
+
+	// LastRefresh is the time of the most recent load attempt
+	LastRefresh time.Time
+
+	// LastError is the error of the most recent load attempt, if it failed
+	LastError error
+}
+
+// ListTime returns the time encoded in the VERSION header, if any
+func (s Status) ListTime() (time.Time, bool) {
+	t, err := parseListVersion(s.Version)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Age returns how old the active list is according to its VERSION header.
+// The age is unknown for a list without a dated VERSION, since the load
+// time says nothing about how stale the list itself is.
+func (s Status) Age(now time.Time) (time.Duration, bool) {
+	t, ok := s.ListTime()
+	if !ok {
+		return 0, false
+	}
+	return now.Sub(t), true
+}
+
+// Healthy reports whether a list is loaded and it is not older than maxAge.
+// A zero maxAge disables the age check; otherwise a list of unknown age is
+// unhealthy.
+func (s Status) Healthy(maxAge time.Duration, now time.Time) bool {
+	if s.Rules.Total == 0 {
+		return false
+	}
+	if maxAge == 0 {
+		return true
+	}
+	age, ok := s.Age(now)
+	return ok && age <= maxAge
+}
+
+// Status returns a snapshot of the manager's state
+func (f *FQDN) Status() Status {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return Status{
+		Source:      f.source,
+		Version:     f.version,
+		Commit:      f.commit,
+		Rules:       f.counts,
+		Builtin:     f.rules == &builtinTable,
+		Fallback:    f.fallback || f.source.Fallback,
+		LoadedAt:    f.loadedAt,
+		LastRefresh: f.lastRefresh,
+		LastError:   f.lastErr,
+	}
+}
+
+// counts tallies the rules in the table
+func (t *suffixTable) counts() RuleCounts {
+	var c RuleCounts
+
+	for i := 0; i < t.Len(); i++ {
+		flags := t.flags[i]
+		n := 0
+		if flags&ruleNormal != 0 {
+			c.Normal++
+			n++
+		}
+		if flags&ruleWildcard != 0 {
+			c.Wildcard++
+			n++
+		}
+		if flags&ruleException != 0 {
+			c.Exception++
+			n++
+		}
+
+		c.Total += n
+		if flags&rulePrivate != 0 {
+			c.Private += n
+		} else {
+			c.ICANN += n
+		}
+	}
+
+	return c
+}
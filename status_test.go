@@ -0,0 +1,141 @@
+// file: status_test.go
+// description: tests for manager status introspection
+
+package gotld
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestStatus tests the status of a manager loaded from a source
+func TestStatus(t *testing.T) {
+	fqdn, err := newFQDN(&Options{Source: NewReaderSource(strings.NewReader(testList))})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	status := fqdn.Status()
+
+	want := RuleCounts{Total: 9, ICANN: 8, Private: 1, Normal: 7, Wildcard: 1, Exception: 1}
+	if status.Rules != want {
+		t.Errorf("Rules = %+v, want %+v", status.Rules, want)
+	}
+
+	if status.Version != "2025-01-01_00-00-00_UTC" || status.Commit != "0123456789abcdef" {
+		t.Errorf("Version, Commit = %q, %q, want header values", status.Version, status.Commit)
+	}
+
+	if status.Source.Name != "reader" || status.Builtin || status.Fallback {
+		t.Errorf("Source = %q, Builtin = %v, Fallback = %v", status.Source.Name, status.Builtin, status.Fallback)
+	}
+
+	if status.LoadedAt.IsZero() || status.LastRefresh.IsZero() || status.LastError != nil {
+		t.Errorf("LoadedAt = %v, LastRefresh = %v, LastError = %v", status.LoadedAt, status.LastRefresh, status.LastError)
+	}
+
+	listTime, ok := status.ListTime()
+	if !ok || !listTime.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("ListTime() = %v, %v", listTime, ok)
+	}
+
+	if !status.Healthy(0, time.Now()) {
+		t.Error("Healthy() = false with no age limit")
+	}
+
+	if status.Healthy(24*time.Hour, listTime.Add(48*time.Hour)) {
+		t.Error("Healthy() = true for a list older than the limit")
+	}
+}
+
+// TestStatusFallback tests the status after failed loads
+func TestStatusFallback(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.dat")
+
+	// A missing file falls back to the built-in table
+	fqdn, err := newFQDN(&Options{PublicSuffixFile: path, FallbackToBuiltin: true})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	status := fqdn.Status()
+	if !status.Builtin || !status.Fallback || !errors.Is(status.LastError, ErrPublicSuffixRead) {
+		t.Errorf("Builtin = %v, Fallback = %v, LastError = %v", status.Builtin, status.Fallback, status.LastError)
+	}
+
+	if status.Rules.Total != builtinTable.counts().Total || status.Rules.Total == 0 {
+		t.Errorf("Rules.Total = %d, want built-in count", status.Rules.Total)
+	}
+
+	// A successful reload clears the fallback
+	if err := os.WriteFile(path, []byte(testList), 0o600); err != nil {
+		t.Fatalf("Failed to write list: %v", err)
+	}
+
+	if err := fqdn.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	status = fqdn.Status()
+	if status.Builtin || status.Fallback || status.LastError != nil || status.Rules.Total != 9 {
+		t.Errorf("after reload Builtin = %v, Fallback = %v, LastError = %v, Total = %d",
+			status.Builtin, status.Fallback, status.LastError, status.Rules.Total)
+	}
+
+	// A chained source reports when a later source was used
+	fqdn, err = newFQDN(&Options{Source: NewChainSource(NewFileSource(path+".missing"), NewFileSource(path))})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	if !fqdn.Status().Fallback {
+		t.Error("Fallback = false for the second chained source")
+	}
+
+	// So does a chain whose first list opens but fails verification
+	sum := sha256.Sum256([]byte(testList))
+	fqdn, err = newFQDN(&Options{
+		Source:    NewChainSource(NewReaderSource(strings.NewReader(testList+"// tampered\n")), NewFileSource(path)),
+		Integrity: &Integrity{SHA256: []string{hex.EncodeToString(sum[:])}},
+	})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	if !fqdn.Status().Fallback {
+		t.Error("Fallback = false after the first chained list failed verification")
+	}
+}
+
+// TestStatusUndated tests the health of a list without a dated VERSION
+func TestStatusUndated(t *testing.T) {
+	list := strings.Replace(testList, "// VERSION: 2025-01-01_00-00-00_UTC\n", "", 1)
+	fqdn, err := newFQDN(&Options{Source: NewReaderSource(strings.NewReader(list))})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	status := fqdn.Status()
+	if status.Version != "" {
+		t.Fatalf("Version = %q, want none", status.Version)
+	}
+
+	// The load time is no substitute for the list's own date
+	if age, ok := status.Age(time.Now()); ok {
+		t.Errorf("Age() = %v, true, want unknown", age)
+	}
+
+	if !status.Healthy(0, time.Now()) {
+		t.Error("Healthy() = false with no age limit")
+	}
+
+	if status.Healthy(24*time.Hour, time.Now()) {
+		t.Error("Healthy() = true for a list of unknown age")
+	}
+}
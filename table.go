@@ -0,0 +1,138 @@
+// file: table.go
+// description: compact sorted suffix table used for eTLD lookups
+
+package gotld
+
+import (
+	"sort"
+	"strings"
+)
+
+// Rule flags stored for each suffix in a table
+const (
+	// ruleNormal marks a plain rule such as "co.uk"
+	ruleNormal uint8 = 1 << iota
+
+	// ruleWildcard marks a wildcard rule such as "*.ck" (stored as "ck")
+	ruleWildcard
+
+	// ruleException marks an exception rule such as "!www.ck" (stored as "www.ck")
+	ruleException
+
+	// rulePrivate marks a rule from the PRIVATE DOMAINS section
+	rulePrivate
+)
+
+// suffixTable is an immutable, sorted table of public suffix rules.
+// All suffixes are concatenated into text and addressed by offsets so the
+// generated built-in table needs no parsing or allocation at start-up.
+type suffixTable struct {
+	text    string
+	offsets []uint32
+	flags   []uint8
+}
+
+// Len returns the number of suffixes in the table
+func (t *suffixTable) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.flags)
+}
+
+// key returns the suffix stored at index i
+func (t *suffixTable) key(i int) string {
+	return t.text[t.offsets[i]:t.offsets[i+1]]
+}
+
+// find returns the rule flags for an exact suffix
+func (t *suffixTable) find(s string) (uint8, bool) {
+	n := t.Len()
+	idx := sort.Search(n, func(i int) bool { return t.key(i) >= s })
+	if idx < n && t.key(idx) == s {
+		return t.flags[idx], true
+	}
+	return 0, false
+}
+
+// match returns the flags for s, ignoring private rules unless allowed
+func (t *suffixTable) match(s string, allowPrivate bool) uint8 {
+	flags, ok := t.find(s)
+	if !ok || (!allowPrivate && flags&rulePrivate != 0) {
+		return 0
+	}
+	return flags
+}
+
+// tableBuilder accumulates rules and produces a sorted suffixTable
+type tableBuilder struct {
+	rules map[string]uint8
+}
+
+// newTableBuilder creates an empty tableBuilder
+func newTableBuilder() *tableBuilder {
+	return &tableBuilder{rules: make(map[string]uint8)}
+}
+
+// Add parses a single public suffix list rule and records it. Comments and
+// blank lines are ignored and false is returned.
+func (b *tableBuilder) Add(line string, private bool) bool {
+	suffix, flags, ok := parseRule(line)
+	if !ok {
+		return false
+	}
+	if private {
+		flags |= rulePrivate
+	}
+	b.rules[suffix] |= flags
+	return true
+}
+
+// Build returns the sorted table
+func (b *tableBuilder) Build() *suffixTable {
+	keys := make([]string, 0, len(b.rules))
+	for k := range b.rules {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	t := &suffixTable{
+		offsets: make([]uint32, 0, len(keys)+1),
+		flags:   make([]uint8, 0, len(keys)),
+	}
+	for _, k := range keys {
+		t.offsets = append(t.offsets, uint32(sb.Len()))
+		t.flags = append(t.flags, b.rules[k])
+		sb.WriteString(k)
+	}
+	t.offsets = append(t.offsets, uint32(sb.Len()))
+	t.text = sb.String()
+
+	return t
+}
+
+// parseRule converts a list line into its stored suffix and rule flags
+func parseRule(line string) (string, uint8, bool) {
+	// Rules end at the first whitespace
+	line = strings.TrimSpace(line)
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		line = line[:i]
+	}
+	line = strings.ToLower(line)
+
+	if line == "" || strings.HasPrefix(line, "//") {
+		return "", 0, false
+	}
+
+	switch {
+	case strings.HasPrefix(line, "!"):
+		return line[1:], ruleException, len(line) > 1
+	case strings.HasPrefix(line, "*."):
+		return line[2:], ruleWildcard, len(line) > 2
+	case strings.HasPrefix(line, "*"):
+		return "", 0, false
+	}
+
+	return line, ruleNormal, true
+}
@@ -0,0 +1,2416 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+// file: table_data.go
+// description: built-in public suffix table pinned at generation time
+
+package gotld
+
+const (
+	// builtinListVersion is the VERSION header of the built-in list
+	builtinListVersion = "2023-02-09_23-26-35_UTC"
+
+	// builtinListCommit is the COMMIT header of the built-in list
+	builtinListCommit = "9e8325c62adb9f7c6211cb7c4f6970a27fcb67f1"
+
+	// builtinListSHA256 is the SHA-256 digest of the list the table was generated from
+	builtinListSHA256 = "87d2e11f3602b504fc5dbea9218429a4ce3c0f62aa6ce7a1371024add024baed"
+)
+
+// builtinTable holds the rules of the built-in list
+var builtinTable = suffixTable{
+	text:    builtinText,
+	offsets: builtinOffsets,
+	flags:   builtinFlags,
+}
+
+const builtinText = "" +
+	"0.bg001www.com0e.vc0emm.com1.azurestaticapps.net1.bg123hjemmeside.dk" +
+	"123hjemmeside.no123homepage.it123kotisivu.fi123minsida.se123miweb.es" +
+	"123paginaweb.pt123sait.ru123siteweb.fr123webseite.at123webseite.de" +
+	"123website.be123website.ch123website.lu123website.nl12hp.at12hp.ch" +
+	"12hp.de1337.pictures16-b.it1kapp.com2.azurestaticapps.net2.bg2000.hu" +
+	"2038.io2ix.at2ix.ch2ix.de3.bg32-b.it3utilities.com4.bg4lima.at4lima.ch" +
+	"4lima.de4u.com5.bg5g.in6.bg611.to64-b.it6g.in7.bg8.bg9.bg9guacu.br" +
+	"a.bga.prod.fastly.neta.run.appa.sea.ssl.fastly.netaa.noaaaaaa.pro" +
+	"aarborte.noaarpab.caabarthabashiri.hokkaido.jpabbabbottabbvieabc" +
+	"abc.brabeno.osaka.jpabiko.chiba.jpabira.hokkaido.jpabkhazia.suable" +
+	"abo.paabogadoabr.itabruzzo.itabu.yamaguchi.jpabudhabiacac.aeac.at" +
+	"ac.beac.ciac.cnac.crac.cyac.fjac.gnac.gov.brac.idac.ilac.imac.in" +
+	"ac.irac.jpac.keac.krac.leg.brac.lkac.lsac.maac.meac.muac.mwac.mz" +
+	"ac.niac.nzac.paac.prac.rsac.ruac.rwac.seac.szac.thac.tjac.tzac.ug" +
+	"ac.ukac.vnac.zaac.zmac.zwaca.proacademia.boacademyacademy.museum" +
+	"accentureaccesscam.orgaccident-investigation.aeroaccident-prevention.aero" +
+	"accountantaccountantsacct.proachi.nagano.jpacoact.auact.edu.auactivetrail.biz" +
+	"actoradad.jpadachi.tokyo.jpadimo.co.ukadm.bradobeaemcloud.comadobeaemcloud.net" +
+	"adobeio-static.netadobeioruntime.netadsadultadult.htadv.bradv.mz" +
+	"advisor.wsadygeya.ruadygeya.suaeae.orgaegaejrie.noaeroaero.mvaero.tt" +
+	"aerobatic.aeroaeroclub.aeroaerodrome.aeroaeroport.fraetnaafaffinitylottery.org.uk" +
+	"afjord.noaflafricaafrica.bjafrica.comagag.itaga.niigata.jpagakhan" +
+	"agano.niigata.jpagdenes.noagematsu.nagano.jpagencyagents.aeroagr.br" +
+	"agrar.huagric.zaagriculture.museumagrigento.itagro.bjagro.boagro.pl" +
+	"aguni.okinawa.jpah.cnah.noaiai.inaibetsu.hokkaido.jpaichi.jpaid.pl" +
+	"aigaikawa.kanagawa.jpainan.ehime.jpaioi.hyogo.jpaip.eeair-surveillance.aero" +
+	"air-traffic-control.aeroair.museumairbusaircraft.aeroairforceairguard.museum" +
+	"airkitapps-au.comairkitapps.comairkitapps.euairline.aeroairport.aero" +
+	"airtelairtraffic.aeroaisai.aichi.jpaisho.shiga.jpaivencloud.comaizubange.fukushima.jp" +
+	"aizumi.tokushima.jpaizumisato.fukushima.jpaizuwakamatsu.fukushima.jp" +
+	"aju.brak.usakabira.hokkaido.jpakadns.netakagi.shimane.jpakaiwa.okayama.jp" +
+	"akamai-staging.netakamai.netakamaiedge-staging.netakamaiedge.net" +
+	"akamaihd-staging.netakamaihd.netakamaiorigin-staging.netakamaiorigin.net" +
+	"akamaized-staging.netakamaized.netakashi.hyogo.jpakdnaki.kochi.jp" +
+	"akiruno.tokyo.jpakishima.tokyo.jpakita.akita.jpakita.jpakkeshi.hokkaido.jp" +
+	"aknoluokta.noako.hyogo.jpakrehamn.noaktyubinsk.suakune.kagoshima.jp" +
+	"alal.eu.orgal.gov.bral.ital.leg.bral.noal.usalabama.museumalaheadju.no" +
+	"aland.fialaska.museumalces.networkalessandria.italesund.noalfaromeo" +
+	"algard.noalibabaalipayallfinanzallstateallyalp1.ae.flow.chalpha-myqnapcloud.com" +
+	"alpha.bounty-full.comalsacealstahaug.noalstomalt.zaalta.noaltervista.org" +
+	"alto-adige.italtoadige.italvdal.noalwaysdata.netamam.bram.gov.br" +
+	"am.inam.leg.brama.aichi.jpama.shimane.jpamagasaki.hyogo.jpamakusa.kumamoto.jp" +
+	"amami.kagoshima.jpamazonamber.museumambulance.aeroambulance.museum" +
+	"american.museumamericana.museumamericanantiques.museumamericanart.museum" +
+	"americanexpressamericanfamilyamexamfamami.ibaraki.jpamicaamli.no" +
+	"amot.noamscompute.comamsterdamamsterdam.museumamusement.aeroan.it" +
+	"analyticsanamizu.ishikawa.jpanan.nagano.jpanan.tokushima.jpanani.br" +
+	"ancona.itand.museumandasuolo.noandebu.noando.nara.jpandoy.noandria-barletta-trani.it" +
+	"andria-trani-barletta.itandriabarlettatrani.itandriatranibarletta.it" +
+	"androidandøy.noangry.jpanjo.aichi.jpann-arbor.mi.usannaka.gunma.jp" +
+	"annefrank.museumanpachi.gifu.jpanquananthro.museumanthropology.museum" +
+	"antiques.museumanzaoao.itaogaki.hyogo.jpaogashima.tokyo.jpaoki.nagano.jp" +
+	"aolaomori.aomori.jpaomori.jpaosta-valley.itaosta.itaostavalley.it" +
+	"aoste.itap-northeast-1.elasticbeanstalk.comap-northeast-2.elasticbeanstalk.com" +
+	"ap-northeast-3.elasticbeanstalk.comap-south-1.elasticbeanstalk.com" +
+	"ap-southeast-1.elasticbeanstalk.comap-southeast-2.elasticbeanstalk.com" +
+	"ap.gov.brap.gov.plap.itap.leg.braparecida.brapartmentsapi.gov.uk" +
+	"api.stdlib.comapigee.ioappapp.banzaicloud.ioapp.brapp.gpapp.lmpm.com" +
+	"app.os.fedoraproject.orgapp.os.stg.fedoraproject.orgapp.render.com" +
+	"appchizi.comappengine.flow.chappleapplinzi.comapps.fbsbx.comapps.lair.io" +
+	"appspacehosted.comappspaceusercontent.comappspot.comappudo.netaq" +
+	"aq.itaquarelleaquarium.museumaquila.itarar.comar.itar.usarabarai.shizuoka.jp" +
+	"arakawa.saitama.jparakawa.tokyo.jparamcoarao.kumamoto.jparboretum.museum" +
+	"archaeological.museumarchaeology.museumarchiarchitectes.bjarchitecture.museum" +
+	"ardal.noaremark.noarendal.noarezzo.itariake.saga.jparida.wakayama.jp" +
+	"aridagawa.wakayama.jparita.saga.jparkhangelsk.suarmenia.suarmyarna.no" +
+	"arpaarq.brartart.brart.doart.dzart.htart.museumart.plart.snartanddesign.museum" +
+	"artcenter.museumartdeco.museumartearte.boarteducation.museumartgallery.museum" +
+	"arts.coarts.museumarts.nfarts.roarts.veartsandcrafts.museumarvo.network" +
+	"asas.usasago.hyogo.jpasahi.chiba.jpasahi.ibaraki.jpasahi.mie.jpasahi.nagano.jp" +
+	"asahi.toyama.jpasahi.yamagata.jpasahikawa.hokkaido.jpasaka.saitama.jp" +
+	"asakawa.fukushima.jpasakuchi.okayama.jpasaminami.hiroshima.jpascoli-piceno.it" +
+	"ascolipiceno.itasdaaseral.noashgabad.suashibetsu.hokkaido.jpashikaga.tochigi.jp" +
+	"ashiya.fukuoka.jpashiya.hyogo.jpashoro.hokkaido.jpasiaasker.noaskim.no" +
+	"askoy.noaskvoll.noaskøy.noasmatart.museumasn.auasn.lvasnes.noaso.kumamoto.jp" +
+	"ass.kmassabu.hokkaido.jpassassination.museumassisi.museumassn.lk" +
+	"asso.ciasso.dzasso.eu.orgasso.frasso.gpasso.htasso.kmasso.mcasso.nc" +
+	"asso.reassociatesassociation.aeroassociation.museumassur.bjasti.it" +
+	"astronomy.museumasuke.aichi.jpatat-band-camp.netat.eu.orgat.itat.md" +
+	"at.vgatami.shizuoka.jpath.cxathletaatl.jelastic.vps-host.netatlanta.museum" +
+	"atm.plato.bratsugi.kanagawa.jpatsuma.hokkaido.jpattorneyauau.eu.org" +
+	"auctionaudiaudibleaudioaudnedaln.noaugustow.plaukra.noaure.noaurland.no" +
+	"aurskog-holand.noaurskog-høland.noaus.basketballauspostaustevoll.no" +
+	"austin.museumaustralia.museumaustrheim.noauthgear-staging.comauthgearapps.com" +
+	"authorauthor.aeroautoauto.plautocode.devautomotive.museumautosav.it" +
+	"av.travellino.itaveroy.noaverøy.noaviancaaviation.museumavocat.fr" +
+	"avocat.proavocats.bjavoues.frawawaji.hyogo.jpawdev.caawsawsglobalaccelerator.com" +
+	"awsmppl.comaxaxaaxis.museumaya.miyazaki.jpayabe.kyoto.jpayagawa.kagawa.jp" +
+	"ayase.kanagawa.jpazaz.usazerbaijan.suazimuth.networkazumino.nagano.jp" +
+	"azureazure-mobile.netazurecontainer.ioazurestaticapps.netazurewebsites.net" +
+	"aéroport.cib-data.iob.bgb.brb.seb.ssl.fastly.netbaba.gov.brba.it" +
+	"ba.leg.brbabia-gora.plbabybabyblue.jpbabymilk.jpbackdrop.jpbackplaneapp.io" +
+	"backyards.banzaicloud.iobadaddja.nobadajoz.museumbaghdad.museumbahcavuotna.no" +
+	"bahccavuotna.nobahn.museumbaidar.nobaidubajddar.nobalashov.subalat.no" +
+	"bale.museumbalena-devices.combalestrand.noballangen.noballooning.aero" +
+	"balsan-sudtirol.itbalsan-suedtirol.itbalsan-südtirol.itbalsan.it" +
+	"balsfjord.nobaltimore.museumbambina.jpbamble.nobanamexbananarepublic" +
+	"bandbandai.fukushima.jpbando.ibaraki.jpbankbanzai.cloudbarbar.pro" +
+	"bar0.netbar1.netbar2.netbarcelonabarcelona.museumbarclaycardbarclays" +
+	"bardu.nobarefootbargainsbari.itbarletta-trani-andria.itbarlettatraniandria.it" +
+	"barrel-of-knowledge.infobarrell-of-knowledge.infobarsy.bgbarsy.ca" +
+	"barsy.clubbarsy.co.ukbarsy.debarsy.eubarsy.inbarsy.infobarsy.iobarsy.me" +
+	"barsy.menubarsy.mobibarsy.netbarsy.onlinebarsy.orgbarsy.probarsy.pub" +
+	"barsy.robarsy.shopbarsy.sitebarsy.supportbarsy.ukbarsycenter.com" +
+	"barsyonline.co.ukbarsyonline.combarueri.brbarum.nobas.itbase.ecbase.shop" +
+	"baseballbaseball.museumbasel.museumbashkiria.rubashkiria.subasicserver.io" +
+	"basilicata.itbasketballbaths.museumbato.tochigi.jpbatsfjord.nobauern.museum" +
+	"bauhausbayernbbbbcbbs.trbbtbbvabc.cabc.platform.shbcgbci.dnstrace.pro" +
+	"bcnbdbd.sebebe.axbe.eu.orgbe.gybeagleboard.iobearalvahki.nobearalváhki.no" +
+	"beardu.nobeatsbeautybeauxarts.museumbedzin.plbeeldengeluid.museum" +
+	"beep.plbeerbeget.appbeiarn.nobel.trbelau.pwbelem.brbellevue.museum" +
+	"belluno.itbenevento.itbentleybeppu.oita.jpberg.nobergamo.itbergbau.museum" +
+	"bergen.noberkeley.museumberlevag.noberlevåg.noberlinberlin.museum" +
+	"bern.museumbeskidy.plbestbestbuybetbet.arbeta.bounty-full.combeta.tailscale.net" +
+	"betainabox.combetter-than.tvbfbgbg.eu.orgbg.itbhbhartibhz.brbibi.it" +
+	"bialowieza.plbialystok.plbib.brbib.vebibai.hokkaido.jpbiblebible.museum" +
+	"bidbiei.hokkaido.jpbielawa.plbiella.itbieszczady.plbievat.nobievát.no" +
+	"bifuka.hokkaido.jpbihar.inbihoro.hokkaido.jpbikebilbao.museumbill.museum" +
+	"bindal.nobingbingobiobio.brbip.shbir.rubiratori.hokkaido.jpbirdart.museum" +
+	"birkenes.nobirthplace.museumbitbridge.netbitbucket.iobitter.jpbiz" +
+	"biz.atbiz.azbiz.bbbiz.cybiz.dkbiz.etbiz.fjbiz.glbiz.idbiz.inbiz.ki" +
+	"biz.lsbiz.mvbiz.mwbiz.mybiz.nibiz.nrbiz.pkbiz.plbiz.prbiz.ssbiz.tj" +
+	"biz.trbiz.ttbiz.uabiz.vnbiz.wfbiz.zmbizen.okayama.jpbjbj.cnbjarkoy.no" +
+	"bjarkøy.nobjerkreim.nobjugn.nobl.itblackblackbaudcdn.netblackfriday" +
+	"blockbusterblogblog.boblog.brblog.gtblog.kgblog.vublogdns.comblogdns.net" +
+	"blogdns.orgblogsite.orgblogsite.xyzblogspot.aeblogspot.alblogspot.am" +
+	"blogspot.bablogspot.beblogspot.bgblogspot.bjblogspot.cablogspot.cf" +
+	"blogspot.chblogspot.clblogspot.co.atblogspot.co.idblogspot.co.il" +
+	"blogspot.co.keblogspot.co.nzblogspot.co.ukblogspot.co.zablogspot.com" +
+	"blogspot.com.arblogspot.com.aublogspot.com.brblogspot.com.byblogspot.com.co" +
+	"blogspot.com.cyblogspot.com.eeblogspot.com.egblogspot.com.esblogspot.com.mt" +
+	"blogspot.com.ngblogspot.com.trblogspot.com.uyblogspot.cvblogspot.cz" +
+	"blogspot.deblogspot.dkblogspot.fiblogspot.frblogspot.grblogspot.hk" +
+	"blogspot.hrblogspot.hublogspot.ieblogspot.inblogspot.isblogspot.it" +
+	"blogspot.jpblogspot.krblogspot.liblogspot.ltblogspot.lublogspot.md" +
+	"blogspot.mkblogspot.mrblogspot.mxblogspot.myblogspot.nlblogspot.no" +
+	"blogspot.peblogspot.ptblogspot.qablogspot.reblogspot.roblogspot.rs" +
+	"blogspot.rublogspot.seblogspot.sgblogspot.siblogspot.skblogspot.sn" +
+	"blogspot.tdblogspot.twblogspot.ugblogspot.vnblogsyte.combloomberg" +
+	"bloxcms.combluebluebite.ioblush.jpbmbmd.brbmoattachments.orgbmsbmw" +
+	"bnbn.itbnpparibasbnr.labobo.itbo.nordland.nobo.telemark.noboatsboavista.br" +
+	"bodo.nobodø.noboehringerbofabokn.noboldlygoingnowhere.orgboleslawiec.pl" +
+	"bolivia.bobologna.itbolt.hubolzano-altoadige.itbolzano.itbombomlo.no" +
+	"bondbonn.museumbooboo.jpbookbookingbookonline.appboomla.netbosch" +
+	"bostikbostonboston.museumbotbotanical.museumbotanicalgarden.museum" +
+	"botanicgarden.museumbotany.museumbounceme.netbounty-full.comboutique" +
+	"boutir.comboxboxfuse.ioboy.jpboyfriend.jpbozen-sudtirol.itbozen-suedtirol.it" +
+	"bozen-südtirol.itbozen.itbplaced.combplaced.debplaced.netbrbr.com" +
+	"br.itbradescobrand.sebrandywinevalley.museumbrasil.museumbrasilia.me" +
+	"bremanger.nobrescia.itbridgestonebrindisi.itbristol.museumbritish.museum" +
+	"britishcolumbia.museumbroadcast.museumbroadwaybroke-it.netbroker" +
+	"broker.aerobronnoy.nobronnoysund.nobrotherbrowsersafetymark.iobrumunddal.no" +
+	"brunel.museumbrussel.museumbrusselsbrussels.museumbruxelles.museum" +
+	"bryansk.subryne.nobrønnøy.nobrønnøysund.nobsbs.itbsb.brbss.design" +
+	"btbt.itbu.nobudejju.nobuildbuild.runbuilder.code.combuildersbuilding.museum" +
+	"builtwithdark.combukhara.subulsan-sudtirol.itbulsan-suedtirol.it" +
+	"bulsan-südtirol.itbulsan.itbungoono.oita.jpbungotakada.oita.jpbunkyo.tokyo.jp" +
+	"burghof.museumbus.museumbusan.krbushey.museumbusinessbusiness.in" +
+	"but.jpbuybuyshop.jpbuyshouses.netbuzen.fukuoka.jpbuzzbvbwbybydgoszcz.pl" +
+	"byen.sitebygland.nobykle.nobytom.plbzbz.itbzhbzz.dapps.earthbáhcavuotna.no" +
+	"báhccavuotna.nobáidár.nobájddar.nobálát.nobådåddjå.nobåtsfjord.no" +
+	"bærum.nobø.nordland.nobø.telemark.nobømlo.noc.bgc.cdn77.orgc.la" +
+	"c.sec66.mecaca-central-1.elasticbeanstalk.comca.eu.orgca.inca.it" +
+	"ca.naca.reclaim.cloudca.uscaa.aerocaa.licabcable-modem.orgcadaques.museum" +
+	"cafecafjs.comcagliari.itcahcesuolo.nocalcal.itcalabria.itcalifornia.museum" +
+	"callcaltanissetta.itcalvinkleincamcam.itcambridge.museumcamdvr.org" +
+	"cameracampcampaign.gov.ukcampania.itcampidano-medio.itcampidanomedio.it" +
+	"campinagrande.brcampinas.brcampobasso.itcan.museumcanada.museumcandypop.jp" +
+	"canoncanva-apps.cncanva-apps.comcapebreton.museumcapetowncapital" +
+	"capitalonecapoo.jpcarcaracal.mythic-beasts.comcaravancarbonia-iglesias.it" +
+	"carboniaiglesias.itcardscarecareercareerscargo.aerocarrara-massa.it" +
+	"carraramassa.itcarrd.cocarrier.museumcarscartoonart.museumcasacasacam.net" +
+	"casadelamoneda.museumcasecaserta.itcashcasinocasino.hucastle.museum" +
+	"castres.museumcatcat.axcatania.itcatanzaro.itcateringcatering.aero" +
+	"catfood.jpcatholiccatholic.edu.aucaxias.brcb.itcbacbg.rucbncbrecbs" +
+	"cccc.ak.uscc.al.uscc.ar.uscc.as.uscc.az.uscc.ca.uscc.co.uscc.ct.us" +
+	"cc.dc.uscc.de.uscc.fl.uscc.ga.uscc.gu.uscc.hi.uscc.hncc.ia.uscc.id.us" +
+	"cc.il.uscc.in.uscc.ks.uscc.ky.uscc.la.uscc.ma.uscc.md.uscc.me.us" +
+	"cc.mi.uscc.mn.uscc.mo.uscc.ms.uscc.mt.uscc.nacc.nc.uscc.nd.uscc.ne.us" +
+	"cc.nh.uscc.nj.uscc.nm.uscc.nv.uscc.ny.uscc.oh.uscc.ok.uscc.or.us" +
+	"cc.pa.uscc.pr.uscc.ri.uscc.sc.uscc.sd.uscc.tn.uscc.tx.uscc.uacc.ut.us" +
+	"cc.va.uscc.vi.uscc.vt.uscc.wa.uscc.wi.uscc.wv.uscc.wy.uscci.frcd" +
+	"cd.eu.orgcdn-edges.netcdn.prod.atlassian-dev.netcdn77-ssl.netce.gov.br" +
+	"ce.itce.leg.brcechire.comceltic.museumcentercenter.museumcentralus.azurestaticapps.net" +
+	"ceocerncertification.aerocertmgr.orgcesena-forli.itcesena-forlì.it" +
+	"cesenaforli.itcesenaforlì.itcfcf-ipfs.comcfacfdcgchch.eu.orgch.it" +
+	"ch.tcch.trendhosting.cloudchambagri.frchampionship.aerochanelchannel" +
+	"channelsdvr.netcharitycharter.aerochasechatchattanooga.museumcheap" +
+	"cheap.jpcheltenham.museumcherkassy.uacherkasy.uachernigov.uachernihiv.ua" +
+	"chernivtsi.uachernovtsy.uachesapeakebay.museumchiba.jpchicago.museum" +
+	"chicappa.jpchichibu.saitama.jpchieti.itchigasaki.kanagawa.jpchihayaakasaka.osaka.jp" +
+	"chijiwa.nagasaki.jpchikugo.fukuoka.jpchikuho.fukuoka.jpchikuhoku.nagano.jp" +
+	"chikujo.fukuoka.jpchikuma.nagano.jpchikusei.ibaraki.jpchikushino.fukuoka.jp" +
+	"chikuzen.fukuoka.jpchildren.museumchildrens.museumchildrensgarden.museum" +
+	"chillout.jpchimkent.suchino.nagano.jpchintaichippubetsu.hokkaido.jp" +
+	"chips.jpchiropractic.museumchirurgiens-dentistes-en-france.frchirurgiens-dentistes.fr" +
+	"chiryu.aichi.jpchita.aichi.jpchitose.hokkaido.jpchiyoda.gunma.jp" +
+	"chiyoda.tokyo.jpchizu.tottori.jpchocolate.museumchofu.tokyo.jpchonan.chiba.jp" +
+	"chosei.chiba.jpchoshi.chiba.jpchowder.jpchoyo.kumamoto.jpchristiansburg.museum" +
+	"christmaschromechtr.k12.ma.uschu.jpchungbuk.krchungnam.krchuo.chiba.jp" +
+	"chuo.fukuoka.jpchuo.osaka.jpchuo.tokyo.jpchuo.yamanashi.jpchurch" +
+	"cici.itciao.jpciencia.bocieszyn.plcim.brcincinnati.museumcinema.museum" +
+	"ciprianicirclecircus.museumciscociscofreak.comcistron.nlcitadelciti" +
+	"citiccitycity.hucity.kawasaki.jpcity.kitakyushu.jpcity.kobe.jpcity.nagoya.jp" +
+	"city.sapporo.jpcity.sendai.jpcity.yokohama.jpcityeatscivilaviation.aero" +
+	"civilisation.museumcivilization.museumcivilwar.museumckck.uaclcl.it" +
+	"claimsclan.ripcleaningclerk.appclerkstage.appcleverapps.ioclickclicketcloud.com" +
+	"clickrising.netcliniccliniqueclinton.museumclock.museumclothingcloud" +
+	"cloud-fr1.unispace.iocloud.fedoraproject.orgcloud.googcloud.interhostsolutions.be" +
+	"cloud.jelastic.open.tim.itcloud.metacentrum.czcloud.nospamproxy.com" +
+	"cloud66.wscloud66.zonecloudaccess.hostcloudaccess.netcloudapp.net" +
+	"cloudapps.digitalcloudcontrolapp.comcloudcontrolled.comcloudera.site" +
+	"cloudflare-ipfs.comcloudfront.netcloudfunctions.netcloudjiffy.net" +
+	"cloudns.asiacloudns.bizcloudns.cccloudns.clubcloudns.eucloudns.in" +
+	"cloudns.infocloudns.orgcloudns.procloudns.pwcloudns.uscloudsite.builders" +
+	"cloudycluster.netclubclub.aeroclub.twclubmedcmcncn-north-1.eb.amazonaws.com.cn" +
+	"cn-northwest-1.eb.amazonaws.com.cncn.comcn.eu.orgcn.incn.itcn.ua" +
+	"cn.vucng.brcnpy.gdncns.joyent.comcnt.brcoco.aeco.agco.amco.aoco.at" +
+	"co.bbco.bico.bjco.bnco.businessco.bwco.caco.cico.clco.cmco.comco.cr" +
+	"co.czco.dkco.educationco.eventsco.financialco.ggco.glco.gyco.huco.id" +
+	"co.ilco.imco.inco.irco.itco.jeco.jpco.keco.krco.krdco.lcco.lsco.ma" +
+	"co.meco.mgco.muco.mwco.mzco.naco.networkco.nico.nlco.noco.nzco.om" +
+	"co.plco.placeco.pnco.pwco.roco.rsco.rwco.stco.szco.technologyco.th" +
+	"co.tjco.tmco.ttco.tzco.uaco.ugco.ukco.usco.uzco.veco.vico.zaco.zm" +
+	"co.zwcoachcoal.museumcoastaldefence.museumcocotte.jpcode.runcodeberg.page" +
+	"codescodespot.comcody.museumcoffeecog.mi.uscol.ngcoldwar.museumcollection.museum" +
+	"collegecollegefan.orgcolognecolonialwilliamsburg.museumcoloradoplateau.museum" +
+	"columbia.museumcolumbus.museumcomcom.accom.afcom.agcom.aicom.alcom.am" +
+	"com.arcom.aucom.awcom.azcom.bacom.bbcom.bhcom.bicom.bjcom.bmcom.bn" +
+	"com.bocom.brcom.bscom.btcom.bycom.bzcom.cicom.cmcom.cncom.cocom.cu" +
+	"com.cvcom.cwcom.cycom.decom.dmcom.docom.dzcom.eccom.eecom.egcom.es" +
+	"com.etcom.fjcom.fmcom.frcom.gecom.ghcom.gicom.glcom.gncom.gpcom.gr" +
+	"com.gtcom.gucom.gycom.hkcom.hncom.hrcom.htcom.imcom.incom.iocom.iq" +
+	"com.iscom.jocom.kgcom.kicom.kmcom.kpcom.kwcom.kycom.kzcom.lacom.lb" +
+	"com.lccom.lkcom.lrcom.lvcom.lycom.mgcom.mkcom.mlcom.mocom.mscom.mt" +
+	"com.mucom.mvcom.mwcom.mxcom.mycom.nacom.nfcom.ngcom.nicom.nrcom.om" +
+	"com.pacom.pecom.pfcom.phcom.pkcom.plcom.prcom.pscom.ptcom.pycom.qa" +
+	"com.recom.rocom.rucom.sacom.sbcom.sccom.sdcom.secom.sgcom.shcom.sl" +
+	"com.sncom.socom.sscom.stcom.svcom.sycom.tjcom.tmcom.tncom.tocom.tr" +
+	"com.ttcom.twcom.uacom.ugcom.uycom.uzcom.vccom.vecom.vicom.vncom.vu" +
+	"com.wscom.yecom.zmcomcastcommbankcommune.amcommunication.museumcommunications.museum" +
+	"communitycommunity-pro.decommunity-pro.netcommunity.museumcomo.it" +
+	"companycomparecompute-1.amazonaws.comcompute.amazonaws.comcompute.amazonaws.com.cn" +
+	"compute.estatecomputercomputer.museumcomputerhistory.museumcomsec" +
+	"comunicações.museumcondosconf.auconf.lvconf.seconference.aeroconn.uk" +
+	"constructionconsulado.stconsultant.aeroconsultingconsulting.aero" +
+	"contactcontagem.brcontemporary.museumcontemporaryart.museumcontractors" +
+	"control.aeroconvent.museumcookingcookingchannelcoolcoolblog.jpcoop" +
+	"coop.arcoop.brcoop.htcoop.incoop.kmcoop.mvcoop.mwcoop.pycoop.rwcoop.tt" +
+	"cooperativa.bocopenhagen.museumcopro.ukcorporation.museumcorreios-e-telecomunicações.museum" +
+	"corsicacorvette.museumcosenza.itcostume.museumcouchpotatofries.org" +
+	"council.aerocountrycountryestate.museumcounty.museumcouponcoupons" +
+	"coursescoz.brcpacpa.procq.cncrcr.itcr.uacrafting.xyzcrafts.museum" +
+	"cranbrook.museumcranky.jpcrd.cocreation.museumcreditcreditcardcreditunion" +
+	"cremona.itcrew.aerocri.brcri.nzcricketcrimea.uacrotone.itcrowncrs" +
+	"cruisecruisescryptonomic.netcs.incs.itcs.keliweb.cloudcsx.ccct.it" +
+	"ct.uscucuiaba.brcuisinellacultural.museumculturalcenter.museumculture.museum" +
+	"cuneo.itcupcake.iscuritiba.brcurv.devcust.dev.thingdust.iocust.disrec.thingdust.io" +
+	"cust.prod.thingdust.iocust.retrosnub.co.ukcust.testing.thingdust.io" +
+	"custom.metacentrum.czcustomer-oci.comcustomer.mythic-beasts.comcustomer.speedpartner.de" +
+	"cutegirl.jpcvcv.uacwcxcx.uacycy.eu.orgcya.ggcyber.museumcymrucymru.museum" +
+	"cyon.linkcyon.sitecyouczcz.eu.orgcz.itczeladz.plczest.pld.bgd.gv.vc" +
+	"d.sedaa.jpdaburdaddaegu.krdaejeon.krdaemon.panel.ggdagestan.rudagestan.su" +
+	"daigo.ibaraki.jpdaisen.akita.jpdaito.osaka.jpdaiwa.hiroshima.jpdali.museum" +
+	"dallas.museumdamnserver.comdancedaplie.medapps.earthdatadatabase.museum" +
+	"database.rundatedate.fukushima.jpdate.hokkaido.jpdatingdatsundattolocal.com" +
+	"dattolocal.netdattorelay.comdattoweb.comdavvenjarga.nodavvenjárga.no" +
+	"davvesiida.nodaydazaifu.fukuoka.jpdc.usdclkdd-dns.deddns.meddns.net" +
+	"ddns5.comddnsfree.comddnsgeek.comddnsking.comddnslive.comddnss.de" +
+	"ddnss.orgddr.museumddsdede.comde.coolde.eu.orgde.gtde.lsde.mdde.trendhosting.cloud" +
+	"de.usdealdealerdealsdeatnu.nodebian.netdeca.jpdeci.jpdecorativearts.museum" +
+	"dedibox.frdedyn.iodef.brdefinima.iodefinima.netdegreedelaware.museum" +
+	"delhi.indeliverydelldell-ogliastra.itdellogliastra.itdelmenhorst.museum" +
+	"deloittedeltademo.datacenter.fidemo.datadetect.comdemo.jelastic.com" +
+	"democracia.bodemocratdemon.nldenmark.museumdeno-staging.devdeno.dev" +
+	"dentaldentistdep.nodeporte.bodepot.museumdes.brdesa.iddesidesign" +
+	"design.aerodesign.museumdet.brdeta.appdeta.devdetroit.museumdevdev-builder.code.com" +
+	"dev-myqnapcloud.comdev.adobeaemcloud.comdev.brdev.static.landdev.vu" +
+	"devcdnaccesso.comdeveloper.appdevelopment.rundevices.resinstaging.io" +
+	"df.gov.brdf.leg.brdgca.aerodh.bytemark.co.ukdhldiadem.clouddiamonds" +
+	"dielddanuorri.nodietdigick.jpdigitaldigitaloceanspaces.comdiher.solutions" +
+	"dinosaur.museumdirectdirect.quickconnect.cndirect.quickconnect.to" +
+	"directorydiscordsays.comdiscordsez.comdiscountdiscourse.groupdiscourse.team" +
+	"discoverdiscovery.museumdishdiskstation.eudiskstation.mediskstation.org" +
+	"diskussionsbereich.deditchyourip.comdivtasvuodna.nodivttasvuotna.no" +
+	"diydjdkdk.eu.orgdlugoleka.pldmdn.uadnepropetrovsk.uadni.usdnipropetrovsk.ua" +
+	"dnpdnsalias.comdnsalias.netdnsalias.orgdnsdojo.comdnsdojo.netdnsdojo.org" +
+	"dnsfor.mednshome.dednsiskinky.comdnsking.chdnsup.netdnsupdate.info" +
+	"dnsupdater.dedodocsdoctordoes-it.netdoesntexist.comdoesntexist.org" +
+	"dogdolls.museumdomainsdonetsk.uadonna.nodonostia.museumdontexist.com" +
+	"dontexist.netdontexist.orgdoomdns.comdoomdns.orgdopaas.comdoshi.yamanashi.jp" +
+	"dotdovre.nodownloaddp.uadr.indr.nadr.trdrammen.nodrangedal.nodray-dns.de" +
+	"drayddns.comdraydns.dedreamhosters.comdrivedrobak.nodrr.acdrud.io" +
+	"drud.usdrøbak.nodscloud.bizdscloud.medscloud.mobidsmynas.comdsmynas.net" +
+	"dsmynas.orgdst.mi.usdtvdubaiduckdns.orgdunlopdupontdurbandurham.museum" +
+	"dvagdvrdvrcam.infodvrdns.orgdweb.linkdy.fidyn-berlin.dedyn-ip24.de" +
+	"dyn-o-saur.comdyn-vpn.dedyn.cosidns.dedyn.ddnss.dedyn.home-webserver.de" +
+	"dyn53.iodynalias.comdynalias.netdynalias.orgdynamic-dns.infodynamisches-dns.de" +
+	"dynathome.netdyndns-at-home.comdyndns-at-work.comdyndns-blog.com" +
+	"dyndns-free.comdyndns-home.comdyndns-ip.comdyndns-mail.comdyndns-office.com" +
+	"dyndns-pics.comdyndns-remote.comdyndns-server.comdyndns-web.comdyndns-wiki.com" +
+	"dyndns-work.comdyndns.bizdyndns.dappnode.iodyndns.ddnss.dedyndns.info" +
+	"dyndns.orgdyndns.tvdyndns.wsdyndns1.dedynns.comdynserv.orgdynu.net" +
+	"dynv6.netdynvpn.dedyroy.nodyrøy.nodzdønna.noe.bge.see12.vee164.arpa" +
+	"e4.czeartheast-kazakhstan.sueastafrica.museumeastasia.azurestaticapps.net" +
+	"eastcoast.museumeastus2.azurestaticapps.neteasypanel.appeasypanel.host" +
+	"eateating-organic.neteaton.mi.usebetsu.hokkaido.jpebina.kanagawa.jp" +
+	"ebino.miyazaki.jpebiz.twecechizen.fukui.jpecn.brecoeco.bjeco.brecologia.bo" +
+	"ecommerce-shop.plecono.bjeconomia.boed.aoed.cied.cred.jped.pwedeka" +
+	"edgeapp.netedgecompute.appedgekey-staging.netedgekey.netedgestack.me" +
+	"edgesuite-staging.netedgesuite.neteditorx.ioedogawa.tokyo.jpeduedu.ac" +
+	"edu.afedu.aledu.aredu.auedu.azedu.baedu.bbedu.bhedu.biedu.bjedu.bm" +
+	"edu.bnedu.boedu.bredu.bsedu.btedu.bzedu.ciedu.cnedu.coedu.cuedu.cv" +
+	"edu.cwedu.dmedu.doedu.dzedu.ecedu.eeedu.egedu.esedu.etedu.eu.org" +
+	"edu.fmedu.gdedu.geedu.ghedu.giedu.gledu.gnedu.gpedu.gredu.gtedu.gu" +
+	"edu.gyedu.hkedu.hnedu.htedu.inedu.iqedu.isedu.itedu.joedu.kgedu.ki" +
+	"edu.kmedu.knedu.kpedu.krdedu.kwedu.kyedu.kzedu.laedu.lbedu.lcedu.lk" +
+	"edu.lredu.lsedu.lvedu.lyedu.meedu.mgedu.mkedu.mledu.mnedu.moedu.ms" +
+	"edu.mtedu.mvedu.mwedu.mxedu.myedu.mzedu.ngedu.niedu.nredu.omedu.pa" +
+	"edu.peedu.pfedu.phedu.pkedu.pledu.pnedu.predu.psedu.ptedu.pyedu.qa" +
+	"edu.rsedu.ruedu.saedu.sbedu.scedu.scotedu.sdedu.sgedu.sledu.snedu.so" +
+	"edu.ssedu.stedu.svedu.syedu.tjedu.tmedu.toedu.tredu.ttedu.twedu.ua" +
+	"edu.uyedu.vcedu.veedu.vnedu.vuedu.wsedu.yeedu.zaedu.zmeducationeducation.museum" +
+	"educational.museumeducator.aeroedugit.ioeeee.eu.orgeero-stage.online" +
+	"eero.onlineegegersund.noegoism.jpegyptian.museumehime.jpeid.noeidfjord.no" +
+	"eidsberg.noeidskog.noeidsvoll.noeigersund.noeiheiji.fukui.jpeisenbahn.museum" +
+	"ekloges.cyelasticbeanstalk.comelb.amazonaws.comelb.amazonaws.com.cn" +
+	"elblag.plelburg.museumelementor.cloudelementor.coolelk.plelvendrell.museum" +
+	"elverum.noemailemb.kwembaixada.stembetsu.hokkaido.jpembroidery.museum" +
+	"emerckemergency.aeroemilia-romagna.itemiliaromagna.itemp.brempresa.bo" +
+	"emr.iten-root.fren.itena.gifu.jpencoreapi.comencr.appencyclopedic.museum" +
+	"endofinternet.netendofinternet.orgendoftheinternet.orgenebakk.no" +
+	"energyenf.breng.breng.proengerdal.noengine.aeroengineerengineer.aero" +
+	"engineeringengland.museumeniwa.hokkaido.jpenna.itens.tnenscaled.sg" +
+	"ent.platform.shenterprisecloud.nuenterprisesentertainment.aeroentomology.museum" +
+	"environment.museumenvironmentalconservation.museumepilepsy.museum" +
+	"epsonequipmentequipment.aeroerer.inericssonerimo.hokkaido.jperni" +
+	"erotica.huerotika.hueses-1.axarnet.cloudes.axes.eu.orges.gov.bres.kr" +
+	"es.leg.bresan.hokkaido.jpesashi.hokkaido.jpesp.bresqessex.museum" +
+	"est-a-la-maison.comest-a-la-masion.comest-le-patron.comest-mon-blogueur.com" +
+	"est.prestateestate.museumetetajima.hiroshima.jpetc.brethnology.museum" +
+	"eti.bretisalatetne.noetnedal.noeueu-1.evennode.comeu-2.evennode.com" +
+	"eu-3.evennode.comeu-4.evennode.comeu-central-1.elasticbeanstalk.com" +
+	"eu-west-1.elasticbeanstalk.comeu-west-2.elasticbeanstalk.comeu-west-3.elasticbeanstalk.com" +
+	"eu.axeu.comeu.encoway.cloudeu.inteu.meteorapp.comeu.orgeu.platform.sh" +
+	"eu.pythonanywhere.comeun.egeurodir.rueurovisioneusevenassi.noevenes.no" +
+	"eventsevenášši.noevje-og-hornnes.noex.futurecms.atex.ortsinfo.at" +
+	"exchangeexchange.aeroexeter.museumexhibition.museumexnet.suexpert" +
+	"experts-comptables.frexposedexpressexpress.aeroextraspaceezproxy.kuleuven.be" +
+	"f.bgf.sefagefailfairwindsfaithfakefur.jpfam.pkfamilyfamily.museum" +
+	"familyds.comfamilyds.netfamilyds.orgfanfansfantasyleague.ccfar.br" +
+	"farmfarm.museumfarmequipment.museumfarmersfarmers.museumfarmstead.museum" +
+	"farsund.nofashionfashionstore.jpfastfastly-edge.comfastly-terrarium.com" +
+	"fastlylb.netfaststacks.netfastvps-server.comfastvps.hostfastvps.site" +
+	"fauske.nofbx-os.frfbxos.frfc.itfe.itfed.usfederation.aerofedexfedje.no" +
+	"fedorainfracloud.orgfedorapeople.orgfeedbackfeira.brfem.jpfentiger.mythic-beasts.com" +
+	"fermo.itferrara.itferrariferrerofeste-ip.netfet.nofetsund.nofg.it" +
+	"fh-muenster.iofh.sefhs.nofhsk.sefhv.sefifi.cloudplatform.fifi.cr" +
+	"fi.eu.orgfi.itfiatfidelityfidofie.eefield.museumfigueres.museumfilatelia.museum" +
+	"filegear-au.mefilegear-de.mefilegear-gb.mefilegear-ie.mefilegear-jp.me" +
+	"filegear-sg.mefilegear.mefilmfilm.hufilm.museumfin.cifin.ecfin.tn" +
+	"finalfinancefinancialfineart.museumfinearts.museumfinland.museum" +
+	"finnoy.nofinnøy.nofirefirebaseapp.comfirenet.chfirenze.itfirestone" +
+	"firewall-gateway.comfirewall-gateway.defirewall-gateway.netfirewalledreplit.co" +
+	"fireweb.appfirm.cofirm.dkfirm.htfirm.infirm.nffirm.ngfirm.rofirm.ve" +
+	"firmdalefishfishingfitfitjar.nofitnessfjfj.cnfjaler.nofjell.nofk" +
+	"fl.usfla.noflakstad.noflanders.museumflap.idflatanger.nofldrv.com" +
+	"flekkefjord.noflesberg.noflickrflier.jpflight.aeroflightsflirflog.br" +
+	"floppy.jpflora.noflorence.itflorida.museumfloripa.brfloristfloro.no" +
+	"florø.noflowersflt.cloud.muni.czflyfly.devflynnhosting.netflå.no" +
+	"fmfm.brfm.itfm.nofnc.fr-par.scw.cloudfnd.brfnwk.sitefofoggia.itfolionetwork.site" +
+	"folkebibl.nofolldal.nofoofoodfoodnetworkfool.jpfootballfor-better.biz" +
+	"for-more.bizfor-our.infofor-some.bizfor-the.bizforce.museumfordforde.no" +
+	"forexforgeblocks.comforgot.her.nameforgot.his.nameforli-cesena.it" +
+	"forlicesena.itforlì-cesena.itforlìcesena.itforsaleforsand.nofortal.br" +
+	"forte.idfortmissoula.museumfortworth.museumforumforum.huforumz.info" +
+	"fosnes.nofot.brfoundationfoundation.museumfoxfoz.brfrfr-1.paas.massivegrid.net" +
+	"fr-par-1.baremetal.scw.cloudfr-par-2.baremetal.scw.cloudfr.eu.org" +
+	"fr.itfra1-de.cloudjiffy.netframer.appframer.mediaframer.photosframer.website" +
+	"framer.wikiframercanvas.comfrana.nofrancaise.museumfrankfurt.museum" +
+	"franziskaner.museumfredrikstad.nofreefree.hrfreebox-os.comfreebox-os.fr" +
+	"freeboxos.comfreeboxos.frfreeddns.orgfreeddns.usfreedesktop.orgfreemasonry.museum" +
+	"freemyip.comfreesite.hostfreetls.fastly.netfrei.nofreiburg.museum" +
+	"frenchkiss.jpfreseniusfribourg.museumfriuli-v-giulia.itfriuli-ve-giulia.it" +
+	"friuli-vegiulia.itfriuli-venezia-giulia.itfriuli-veneziagiulia.it" +
+	"friuli-vgiulia.itfriuliv-giulia.itfriulive-giulia.itfriulivegiulia.it" +
+	"friulivenezia-giulia.itfriuliveneziagiulia.itfriulivgiulia.itfrl" +
+	"frog.museumfrogansfrogn.nofroland.nofrom-ak.comfrom-al.comfrom-ar.com" +
+	"from-az.netfrom-ca.comfrom-co.netfrom-ct.comfrom-dc.comfrom-de.com" +
+	"from-fl.comfrom-ga.comfrom-hi.comfrom-ia.comfrom-id.comfrom-il.com" +
+	"from-in.comfrom-ks.comfrom-ky.comfrom-la.netfrom-ma.comfrom-md.com" +
+	"from-me.orgfrom-mi.comfrom-mn.comfrom-mo.comfrom-ms.comfrom-mt.com" +
+	"from-nc.comfrom-nd.comfrom-ne.comfrom-nh.comfrom-nj.comfrom-nm.com" +
+	"from-nv.comfrom-ny.netfrom-oh.comfrom-ok.comfrom-or.comfrom-pa.com" +
+	"from-pr.comfrom-ri.comfrom-sc.comfrom-sd.comfrom-tn.comfrom-tx.com" +
+	"from-ut.comfrom-va.comfrom-vt.comfrom-wa.comfrom-wi.comfrom-wv.com" +
+	"from-wy.comfrom.hrfrontdoorfrontierfrosinone.itfrosta.nofroya.no" +
+	"frusky.defræna.nofrøya.nofst.brftpaccess.ccftrfuchu.hiroshima.jp" +
+	"fuchu.tokyo.jpfuchu.toyama.jpfudai.iwate.jpfuefuki.yamanashi.jpfuel.aero" +
+	"fuettertdasnetz.defuji.shizuoka.jpfujieda.shizuoka.jpfujiidera.osaka.jp" +
+	"fujikawa.shizuoka.jpfujikawa.yamanashi.jpfujikawaguchiko.yamanashi.jp" +
+	"fujimi.nagano.jpfujimi.saitama.jpfujimino.saitama.jpfujinomiya.shizuoka.jp" +
+	"fujioka.gunma.jpfujisato.akita.jpfujisawa.iwate.jpfujisawa.kanagawa.jp" +
+	"fujishiro.ibaraki.jpfujitsufujiyoshida.yamanashi.jpfukagawa.hokkaido.jp" +
+	"fukaya.saitama.jpfukuchi.fukuoka.jpfukuchiyama.kyoto.jpfukudomi.saga.jp" +
+	"fukui.fukui.jpfukui.jpfukumitsu.toyama.jpfukuoka.jpfukuroi.shizuoka.jp" +
+	"fukusaki.hyogo.jpfukushima.fukushima.jpfukushima.hokkaido.jpfukushima.jp" +
+	"fukuyama.hiroshima.jpfunfunabashi.chiba.jpfunagata.yamagata.jpfunahashi.toyama.jp" +
+	"functions.fnc.fr-par.scw.cloudfundfundacio.museumfuoisku.nofuossko.no" +
+	"furano.hokkaido.jpfurniturefurniture.museumfurubira.hokkaido.jpfurudono.fukushima.jp" +
+	"furukawa.miyagi.jpfusa.nofuso.aichi.jpfussa.tokyo.jpfutaba.fukushima.jp" +
+	"futbolfutsu.nagasaki.jpfuttsu.chiba.jpfuturecms.atfuturehosting.at" +
+	"futuremailing.atfvg.itfyifylkesbibl.nofyresdal.noførde.nog.bgg.se" +
+	"g.vbrplsbx.iog12.brgaga.usgaivuotna.nogalgallerygallery.museumgallo" +
+	"gallupgalsa.nogamagori.aichi.jpgamegame-host.orggame-server.ccgame.tw" +
+	"gamesgames.hugamo.shiga.jpgamvik.nogangaviika.nogangwon.krgapgarden" +
+	"garden.museumgateway.devgateway.museumgaular.nogausdal.nogaygbgb.net" +
+	"gbizgc.cagdgd.cngda.plgdansk.plgdngdynia.plgege.itgeageek.nzgeekgalaxy.com" +
+	"geelvinck.museumgehirn.ne.jpgeisei.kochi.jpgemological.museumgen.in" +
+	"gen.mi.usgen.nggen.nzgen.trgenkai.saga.jpgenoa.itgenova.itgentgentapps.com" +
+	"gentinggentlentapis.comgeo.brgeology.museumgeometre-expert.frgeorge" +
+	"georgia.museumgeorgia.sugetmyip.comgets-it.netgfgggg.axggeeggf.br" +
+	"ghghost.iogigiehtavuoatna.nogiessen.museumgiftgiftsgifu.gifu.jpgifu.jp" +
+	"giize.comgildeskal.nogildeskål.noginan.gifu.jpginowan.okinawa.jp" +
+	"ginoza.okinawa.jpgirlfriend.jpgirly.jpgiske.nogit-pages.rit.edugit-repos.de" +
+	"gitapp.sigithub.iogithubpreview.devgithubusercontent.comgitlab.io" +
+	"gitpage.sigivesgivinggjemnes.nogjerdrum.nogjerstad.nogjesdal.nogjovik.no" +
+	"gjøvik.noglglas.museumglassglass.museumglegleeze.comgliding.aero" +
+	"glitch.megliwice.plglobalglobal.prod.fastly.netglobal.ssl.fastly.net" +
+	"globoglogow.plgloomy.jpgloppen.noglug.org.ukgmgmailgmbhgmina.plgmo" +
+	"gmxgngniezno.plgo.cigo.crgo.dyndns.orggo.gov.brgo.idgo.itgo.jpgo.ke" +
+	"go.krgo.leg.brgo.pwgo.thgo.tjgo.tzgo.uggob.argob.bogob.clgob.dogob.ec" +
+	"gob.esgob.gtgob.hngob.mxgob.nigob.pagob.pegob.pkgob.svgob.vegobo.wakayama.jp" +
+	"godaddygodo.gifu.jpgoiania.brgoip.degojome.akita.jpgok.pkgokase.miyazaki.jp" +
+	"gol.nogoldgoldpointgolfgolffan.usgon.pkgonna.jpgonohe.aomori.jpgoo" +
+	"goodyeargooggooglegoogleapis.comgooglecode.comgopgop.pkgorge.museum" +
+	"gorizia.itgorlice.plgos.pkgose.nara.jpgosen.niigata.jpgoshiki.hyogo.jp" +
+	"gotgotdns.chgotdns.comgotdns.orggotemba.shizuoka.jpgoto.nagasaki.jp" +
+	"gotpantheon.comgotsu.shimane.jpgoupile.frgouv.cigouv.frgouv.htgouv.km" +
+	"gouv.mlgouv.sngovgov.acgov.aegov.afgov.algov.argov.asgov.augov.az" +
+	"gov.bagov.bbgov.bfgov.bhgov.bmgov.bngov.brgov.bsgov.btgov.bygov.bz" +
+	"gov.cdgov.clgov.cmgov.cngov.cogov.cugov.cxgov.cygov.dmgov.dogov.dz" +
+	"gov.ecgov.eegov.eggov.etgov.fjgov.gdgov.gegov.ghgov.gigov.gngov.gr" +
+	"gov.gugov.gygov.hkgov.iegov.ilgov.ingov.iqgov.irgov.isgov.itgov.jo" +
+	"gov.kggov.kigov.kmgov.kngov.kpgov.kwgov.kzgov.lagov.lbgov.lcgov.lk" +
+	"gov.lrgov.lsgov.ltgov.lvgov.lygov.magov.megov.mggov.mkgov.mlgov.mn" +
+	"gov.mogov.mrgov.msgov.mugov.mvgov.mwgov.mygov.mzgov.nc.trgov.nggov.nl" +
+	"gov.nrgov.omgov.phgov.pkgov.plgov.pngov.prgov.psgov.ptgov.pygov.qa" +
+	"gov.rsgov.rugov.rwgov.sagov.sbgov.scgov.scotgov.sdgov.sggov.shgov.sl" +
+	"gov.sogov.ssgov.sxgov.sygov.tjgov.tlgov.tmgov.tngov.togov.trgov.tt" +
+	"gov.twgov.uagov.ukgov.vcgov.vegov.vngov.wsgov.yegov.zagov.zmgov.zw" +
+	"government.aerogovt.nzgpgqgrgr.comgr.eu.orggr.itgr.jpgraingergrajewo.pl" +
+	"gran.nograndrapids.museumgrane.nogranvin.nographicsgraphox.usgratangen.no" +
+	"gratisgraz.museumgreater.jpgreengreta.frgrimstad.nogripegriw.gov.pl" +
+	"grocerygroks-the.infogroks-this.infogrondar.zagrong.nogrosseto.it" +
+	"groundhandling.aerogroupgroup.aerogrozny.rugrozny.sugrp.lkgru.br" +
+	"grue.nogsgs.aa.nogs.ah.nogs.bu.nogs.cngs.fm.nogs.hl.nogs.hm.nogs.jan-mayen.no" +
+	"gs.mr.nogs.nl.nogs.nt.nogs.of.nogs.ol.nogs.oslo.nogs.rl.nogs.sf.no" +
+	"gs.st.nogs.svalbard.nogs.tm.nogs.tr.nogs.va.nogs.vf.nogsj.bzgsm.pl" +
+	"gtgugu.usguam.guguardiangub.uygucciguernsey.museumgugeguideguitars" +
+	"gujarat.ingujo.gifu.jpgulen.nogunma.jpguovdageaidnu.nogurugushikami.okinawa.jp" +
+	"gv.aogv.atgv.vcgwgwangju.krgx.cngygyeongbuk.krgyeonggi.krgyeongnam.kr" +
+	"gyokuto.kumamoto.jpgz.cngáivuotna.nogálsá.nogáŋgaviika.nogünstigbestellen.de" +
+	"günstigliefern.deh.bgh.seha.cnha.nohabikino.osaka.jphabmer.nohaboro.hokkaido.jp" +
+	"hacca.jphachijo.tokyo.jphachinohe.aomori.jphachioji.tokyo.jphachirogata.akita.jp" +
+	"hadano.kanagawa.jphadsel.nohaebaru.okinawa.jphaga.tochigi.jphagebostad.no" +
+	"hagi.yamaguchi.jphaibara.shizuoka.jphairhakata.fukuoka.jphakodate.hokkaido.jp" +
+	"hakone.kanagawa.jphakuba.nagano.jphakui.ishikawa.jphakusan.ishikawa.jp" +
+	"halden.nohalf.hosthalloffame.museumhalsa.noham-radio-op.nethamada.shimane.jp" +
+	"hamamatsu.shizuoka.jphamar.nohamaroy.nohamatama.saga.jphamatonbetsu.hokkaido.jp" +
+	"hamburghamburg.museumhammarfeasta.nohammerfest.nohamura.tokyo.jp" +
+	"hanamaki.iwate.jphanamigawa.chiba.jphanawa.fukushima.jphanda.aichi.jp" +
+	"handcrafted.jphandson.museumhanggliding.aerohangouthannan.osaka.jp" +
+	"hanno.saitama.jphanyu.saitama.jphapmir.nohappou.akita.jphara.nagano.jp" +
+	"haram.nohareid.noharima.hyogo.jpharstad.noharvestcelebration.museum" +
+	"hasama.oita.jphasami.nagasaki.jphashbang.shhashikami.aomori.jphashima.gifu.jp" +
+	"hashimoto.wakayama.jphasuda.saitama.jphasura-app.iohasura.apphasvik.no" +
+	"hatogaya.saitama.jphatoyama.saitama.jphatsukaichi.hiroshima.jphattfjelldal.no" +
+	"haugesund.nohaushawaii.museumhayakawa.yamanashi.jphayashima.okayama.jp" +
+	"hazu.aichi.jphb.cldmail.ruhb.cnhbohdfchdfcbankhe.cnhealthhealth-carereform.com" +
+	"health.museumhealth.nzhealth.vnhealthcareheavy.jpheguri.nara.jpheimatunduhren.museum" +
+	"hekinan.aichi.jphellas.museumhelphelsinkihelsinki.museumhembygdsforbund.museum" +
+	"hemne.nohemnes.nohemsedal.nohepforge.orgher.jpherad.noherehere-for-more.info" +
+	"heritage.museumhermesherokuapp.comherokussl.comheroy.more-og-romsdal.no" +
+	"heroy.nordland.noherøy.møre-og-romsdal.noherøy.nordland.noheteml.net" +
+	"hgtvhi.cnhi.ushicam.nethichiso.gifu.jphida.gifu.jphidaka.hokkaido.jp" +
+	"hidaka.kochi.jphidaka.saitama.jphidaka.wakayama.jphidora.comhigashi.fukuoka.jp" +
+	"higashi.fukushima.jphigashi.okinawa.jphigashiagatsuma.gunma.jphigashichichibu.saitama.jp" +
+	"higashihiroshima.hiroshima.jphigashiizu.shizuoka.jphigashiizumo.shimane.jp" +
+	"higashikagawa.kagawa.jphigashikagura.hokkaido.jphigashikawa.hokkaido.jp" +
+	"higashikurume.tokyo.jphigashimatsushima.miyagi.jphigashimatsuyama.saitama.jp" +
+	"higashimurayama.tokyo.jphigashinaruse.akita.jphigashine.yamagata.jp" +
+	"higashiomi.shiga.jphigashiosaka.osaka.jphigashishirakawa.gifu.jp" +
+	"higashisumiyoshi.osaka.jphigashitsuno.kochi.jphigashiura.aichi.jp" +
+	"higashiyama.kyoto.jphigashiyamato.tokyo.jphigashiyodogawa.osaka.jp" +
+	"higashiyoshino.nara.jphiho.jphiji.oita.jphikari.yamaguchi.jphikawa.shimane.jp" +
+	"hikimi.shimane.jphikone.shiga.jphimeji.hyogo.jphimeshima.oita.jp" +
+	"himi.toyama.jphino.tokyo.jphino.tottori.jphinode.tokyo.jphinohara.tokyo.jp" +
+	"hioki.kagoshima.jphiphophippy.jphirado.nagasaki.jphiraizumi.iwate.jp" +
+	"hirakata.osaka.jphiranai.aomori.jphirara.okinawa.jphirata.fukushima.jp" +
+	"hiratsuka.kanagawa.jphiraya.nagano.jphirogawa.wakayama.jphirokawa.fukuoka.jp" +
+	"hirono.fukushima.jphirono.iwate.jphiroo.hokkaido.jphirosaki.aomori.jp" +
+	"hiroshima.jphisamitsuhisayama.fukuoka.jphistoire.museumhistorical.museum" +
+	"historicalsociety.museumhistorichouses.museumhistorisch.museumhistorisches.museum" +
+	"history.museumhistoryofscience.museumhita.oita.jphitachihitachi.ibaraki.jp" +
+	"hitachinaka.ibaraki.jphitachiomiya.ibaraki.jphitachiota.ibaraki.jp" +
+	"hitra.nohivhizen.saga.jphjartdal.nohjelmeland.nohkhk.cnhk.comhk.org" +
+	"hkthl.cnhl.nohlx.livehlx.pagehlx3.pagehmhm.nohnhn.cnhobby-site.com" +
+	"hobby-site.orghobol.nohobøl.nohockeyhof.nohofu.yamaguchi.jphokkaido.jp" +
+	"hokksund.nohokuryu.hokkaido.jphokuto.hokkaido.jphokuto.yamanashi.jp" +
+	"hol.noholdingshole.noholidayholmestrand.noholtalen.noholtålen.no" +
+	"holy.jphome-webserver.dehome.dyndns.orghomebuilt.aerohomedepothomedns.org" +
+	"homeftp.nethomeftp.orghomegoodshomeip.nethomelink.onehomelinux.com" +
+	"homelinux.nethomelinux.orghomeoffice.gov.ukhomeshomesecuritymac.com" +
+	"homesecuritypc.comhomesensehomesklep.plhomeunix.comhomeunix.nethomeunix.org" +
+	"honai.ehime.jphonbetsu.hokkaido.jphondahonefoss.nohongo.hiroshima.jp" +
+	"honjo.akita.jphonjo.saitama.jphonjyo.akita.jphoplix.shophopto.me" +
+	"hopto.orghornindal.nohorokanai.hokkaido.jphorology.museumhoronobe.hokkaido.jp" +
+	"horsehorten.nohosp.ukhospitalhosthostedpi.comhostinghosting-cluster.nl" +
+	"hosting.myjino.ruhosting.ovh.nethostyhosting.iohothotel.huhotel.lk" +
+	"hotel.tzhoteleshotelshotelwithflight.comhotmailhousehouse.museum" +
+	"howhoyanger.nohoylandet.nohrhr.eu.orghra.healthhs.krhs.runhs.zone" +
+	"hsbchthttpbin.orghuhu.comhu.eu.orghu.nethugheshuissier-justice.fr" +
+	"humanities.museumhungry.jphurdal.nohurum.nohvaler.nohyatthyllestad.no" +
+	"hyogo.jphyuga.miyazaki.jphyundaihzc.iohábmer.nohámmárfeasta.no" +
+	"hápmir.nohäkkinen.fihå.nohægebostad.nohønefoss.nohøyanger.no" +
+	"høylandet.noi.bgi.ngi.phi.sei234.meia.usiamallama.comibara.okayama.jp" +
+	"ibaraki.ibaraki.jpibaraki.jpibaraki.osaka.jpibestad.noibigawa.gifu.jp" +
+	"ibmibxos.itic.gov.plicbciceichiba.tokushima.jpichihara.chiba.jpichikai.tochigi.jp" +
+	"ichikawa.chiba.jpichikawa.hyogo.jpichikawamisato.yamanashi.jpichinohe.iwate.jp" +
+	"ichinomiya.aichi.jpichinomiya.chiba.jpichinoseki.iwate.jpicuicurus.jp" +
+	"idid.auid.firewalledreplit.coid.forgerock.ioid.irid.lvid.lyid.repl.co" +
+	"id.uside.kyoto.jpidf.ilidrett.noidv.hkidv.twieie.eu.orgieeeif.ua" +
+	"ifmiglesias-carbonia.itiglesiascarbonia.itiheya.okinawa.jpiida.nagano.jp" +
+	"iide.yamagata.jpiijima.nagano.jpiitate.fukushima.jpiiyama.nagano.jp" +
+	"iizuka.fukuoka.jpiizuna.nagano.jpikanoikaruga.nara.jpikata.ehime.jp" +
+	"ikawa.akita.jpikeda.fukui.jpikeda.gifu.jpikeda.hokkaido.jpikeda.nagano.jp" +
+	"ikeda.osaka.jpiki.fiiki.nagasaki.jpikoma.nara.jpikusaka.nagano.jp" +
+	"ilil.eu.orgil.usilawa.pliliadboxos.itillustration.museumilovecollege.info" +
+	"imim.itimabari.ehime.jpimageandsound.museumimakane.hokkaido.jpimamat" +
+	"imari.saga.jpimb.brimdbimizu.toyama.jpimmoimmobilienimperia.itimpertrix.com" +
+	"impertrixcdn.cominin-addr.arpain-berlin.dein-brb.dein-butter.dein-dsl.de" +
+	"in-dsl.netin-dsl.orgin-the-band.netin-vpn.dein-vpn.netin-vpn.org" +
+	"in.eu.orgin.futurecms.atin.nain.netin.niin.rsin.thin.uain.usina.ibaraki.jp" +
+	"ina.nagano.jpina.saitama.jpinabe.mie.jpinagawa.hyogo.jpinagi.tokyo.jp" +
+	"inami.toyama.jpinami.wakayama.jpinashiki.ibaraki.jpinatsuki.fukuoka.jp" +
+	"inawashiro.fukushima.jpinazawa.aichi.jpincinc.hkincheon.krind.br" +
+	"ind.gtind.inind.kwind.tnindependent-commission.ukindependent-inquest.uk" +
+	"independent-inquiry.ukindependent-panel.ukindependent-review.ukinderoy.no" +
+	"inderøy.noindian.museumindiana.museumindianapolis.museumindianmarket.museum" +
+	"indie.pornindigena.boindustria.boindustriesine.kyoto.jpinf.brinf.cu" +
+	"inf.mkinf.uainfinitiinfoinfo.atinfo.auinfo.azinfo.bbinfo.bjinfo.bo" +
+	"info.coinfo.cxinfo.ecinfo.etinfo.fjinfo.guinfo.htinfo.huinfo.ininfo.ke" +
+	"info.kiinfo.lainfo.lsinfo.mvinfo.nainfo.nfinfo.niinfo.nrinfo.pkinfo.pl" +
+	"info.prinfo.roinfo.sdinfo.tninfo.trinfo.ttinfo.tzinfo.veinfo.vninfo.zm" +
+	"inging.paingatlan.huinkino.kochi.jpinstance.datadetect.cominstances.spawn.cc" +
+	"instantcloud.cninstituteinsuranceinsurance.aeroinsureintint.arint.az" +
+	"int.boint.ciint.coint.cvint.eu.orgint.inint.isint.laint.lkint.mv" +
+	"int.mwint.niint.ptint.ruint.tjint.ttint.veint.vnintelligence.museum" +
+	"interactive.museuminternationalinternet-dns.deinternet.inintl.tn" +
+	"intuitinuyama.aichi.jpinvestmentsinzai.chiba.jpioio.inio.kgiobb.net" +
+	"iopsys.seip.linodeusercontent.comip6.arpaipifony.netipirangaiqir" +
+	"iraq.museumiris.arpairishiron.museumiruma.saitama.jpisis-a-anarchist.com" +
+	"is-a-blogger.comis-a-bookkeeper.comis-a-bruinsfan.orgis-a-bulls-fan.com" +
+	"is-a-candidate.orgis-a-caterer.comis-a-celticsfan.orgis-a-chef.com" +
+	"is-a-chef.netis-a-chef.orgis-a-conservative.comis-a-cpa.comis-a-cubicle-slave.com" +
+	"is-a-democrat.comis-a-designer.comis-a-doctor.comis-a-financialadvisor.com" +
+	"is-a-geek.comis-a-geek.netis-a-geek.orgis-a-green.comis-a-guru.com" +
+	"is-a-hard-worker.comis-a-hunter.comis-a-knight.orgis-a-landscaper.com" +
+	"is-a-lawyer.comis-a-liberal.comis-a-libertarian.comis-a-linux-user.org" +
+	"is-a-llama.comis-a-musician.comis-a-nascarfan.comis-a-nurse.comis-a-painter.com" +
+	"is-a-patsfan.orgis-a-personaltrainer.comis-a-photographer.comis-a-player.com" +
+	"is-a-republican.comis-a-rockstar.comis-a-socialist.comis-a-soxfan.org" +
+	"is-a-student.comis-a-teacher.comis-a-techie.comis-a-therapist.com" +
+	"is-an-accountant.comis-an-actor.comis-an-actress.comis-an-anarchist.com" +
+	"is-an-artist.comis-an-engineer.comis-an-entertainer.comis-by.usis-certified.com" +
+	"is-found.orgis-gone.comis-into-anime.comis-into-cars.comis-into-cartoons.com" +
+	"is-into-games.comis-leet.comis-lost.orgis-not-certified.comis-saved.org" +
+	"is-slick.comis-uberleet.comis-very-bad.orgis-very-evil.orgis-very-good.org" +
+	"is-very-nice.orgis-very-sweet.orgis-with-theband.comis.eu.orgis.gov.pl" +
+	"is.itisa-geek.comisa-geek.netisa-geek.orgisa-hockeynut.comisa.kagoshima.jp" +
+	"isa.usisahaya.nagasaki.jpise.mie.jpisehara.kanagawa.jpisen.kagoshima.jp" +
+	"isernia.itiserv.deviservschule.deisesaki.gunma.jpishigaki.okinawa.jp" +
+	"ishikari.hokkaido.jpishikawa.fukushima.jpishikawa.jpishikawa.okinawa.jp" +
+	"ishinomaki.miyagi.jpisla.prisleofman.museumismailiisshiki.aichi.jp" +
+	"issmarterthanyou.comististanbulisteingeek.deistmein.deisumi.chiba.jp" +
+	"itit.aoit.comit.eu.orgit1.eur.aruba.jenv-aruba.cloudit1.jenv-aruba.cloud" +
+	"itabashi.tokyo.jpitako.ibaraki.jpitakura.gunma.jpitami.hyogo.jpitano.tokushima.jp" +
+	"itauitayanagi.aomori.jpitcouldbewor.seitigo.jpito.shizuoka.jpitoigawa.niigata.jp" +
+	"itoman.okinawa.jpits.meitvivano-frankivsk.uaivanovo.suiveland.no" +
+	"ivgu.noiwade.wakayama.jpiwafune.tochigi.jpiwaizumi.iwate.jpiwaki.fukushima.jp" +
+	"iwakuni.yamaguchi.jpiwakura.aichi.jpiwama.ibaraki.jpiwamizawa.hokkaido.jp" +
+	"iwanai.hokkaido.jpiwanuma.miyagi.jpiwata.shizuoka.jpiwate.iwate.jp" +
+	"iwate.jpiwatsuki.saitama.jpiwi.nziyo.ehime.jpiz.hrizena.okinawa.jp" +
+	"izu.shizuoka.jpizumi.kagoshima.jpizumi.osaka.jpizumiotsu.osaka.jp" +
+	"izumisano.osaka.jpizumizaki.fukushima.jpizumo.shimane.jpizumozaki.niigata.jp" +
+	"izunokuni.shizuoka.jpj.bgj.layershift.co.ukj.scaleforce.com.cyj.scaleforce.net" +
+	"jab.brjaguarjambyl.sujamison.museumjampa.brjan-mayen.nojavajaworzno.pl" +
+	"jc.neen.itjcbjcloud-ver-jpc.ik-server.comjcloud.ik-server.comjcloud.kz" +
+	"jdevcloud.comjdf.brjejed.wafaicloud.comjeepjefferson.museumjeju.kr" +
+	"jelastic.dogado.eujelastic.regruhosting.rujelastic.saveincloud.net" +
+	"jelastic.teamjelastic.tsukaeru.netjele.cloudjele.clubjele.hostjele.io" +
+	"jele.sitejelenia-gora.pljellybean.jpjeonbuk.krjeonnam.krjerusalem.museum" +
+	"jessheim.nojetztjevnaker.nojewelryjewelry.museumjewish.museumjewishart.museum" +
+	"jfk.museumjgora.pljinsekikogen.hiroshima.jpjiojl.cnjlljls-sto1.elastx.net" +
+	"jls-sto2.elastx.netjls-sto3.elastx.netjmjmpjnjjojoboji.iwate.jpjobs" +
+	"jobs.ttjoburgjoetsu.niigata.jpjogasz.hujohana.toyama.jpjoinville.br" +
+	"jolster.nojondal.nojor.brjorpeland.nojoso.ibaraki.jpjotjotelulu.cloud" +
+	"journal.aerojournalism.museumjournalist.aerojoyjoyo.kyoto.jpjozi.biz" +
+	"jpjp.eu.orgjp.kgjp.mdjp.netjpmorganjpn.comjprsjs.cnjs.orgjs.wpenginepowered.com" +
+	"ju.mpjudaica.museumjudygarland.museumjuedisches.museumjuegosjuif.museum" +
+	"juniperjur.projus.brjx.cnjølster.nojørpeland.nok.bgk.sek12.ak.us" +
+	"k12.al.usk12.ar.usk12.as.usk12.az.usk12.ca.usk12.co.usk12.ct.usk12.dc.us" +
+	"k12.de.usk12.eck12.fl.usk12.ga.usk12.gu.usk12.ia.usk12.id.usk12.il" +
+	"k12.il.usk12.in.usk12.ks.usk12.ky.usk12.la.usk12.ma.usk12.md.usk12.me.us" +
+	"k12.mi.usk12.mn.usk12.mo.usk12.ms.usk12.mt.usk12.nc.usk12.ne.usk12.nh.us" +
+	"k12.nj.usk12.nm.usk12.nv.usk12.ny.usk12.oh.usk12.ok.usk12.or.usk12.pa.us" +
+	"k12.pr.usk12.sc.usk12.tn.usk12.trk12.tx.usk12.ut.usk12.va.usk12.vi" +
+	"k12.vi.usk12.vt.usk12.wa.usk12.wi.usk12.wy.usk8s.fr-par.scw.cloud" +
+	"k8s.nl-ams.scw.cloudk8s.pl-waw.scw.cloudk8s.scw.cloudkaas.ggkadena.okinawa.jp" +
+	"kadogawa.miyazaki.jpkadoma.osaka.jpkafjord.nokaga.ishikawa.jpkagami.kochi.jp" +
+	"kagamiishi.fukushima.jpkagamino.okayama.jpkagawa.jpkagoshima.jpkagoshima.kagoshima.jp" +
+	"kaho.fukuoka.jpkahoku.ishikawa.jpkahoku.yamagata.jpkai.yamanashi.jp" +
+	"kainan.tokushima.jpkainan.wakayama.jpkaisei.kanagawa.jpkaita.hiroshima.jp" +
+	"kaizuka.osaka.jpkakamigahara.gifu.jpkakegawa.shizuoka.jpkakinoki.shimane.jp" +
+	"kakogawa.hyogo.jpkakuda.miyagi.jpkalisz.plkalmykia.rukalmykia.su" +
+	"kaluga.sukamagaya.chiba.jpkamaishi.iwate.jpkamakura.kanagawa.jpkameoka.kyoto.jp" +
+	"kameyama.mie.jpkami.kochi.jpkami.miyagi.jpkamiamakusa.kumamoto.jp" +
+	"kamifurano.hokkaido.jpkamigori.hyogo.jpkamiichi.toyama.jpkamiizumi.saitama.jp" +
+	"kamijima.ehime.jpkamikawa.hokkaido.jpkamikawa.hyogo.jpkamikawa.saitama.jp" +
+	"kamikitayama.nara.jpkamikoani.akita.jpkamimine.saga.jpkaminokawa.tochigi.jp" +
+	"kaminoyama.yamagata.jpkamioka.akita.jpkamisato.saitama.jpkamishihoro.hokkaido.jp" +
+	"kamisu.ibaraki.jpkamisunagawa.hokkaido.jpkamitonda.wakayama.jpkamitsue.oita.jp" +
+	"kamo.kyoto.jpkamo.niigata.jpkamoenai.hokkaido.jpkamogawa.chiba.jp" +
+	"kanagawa.jpkanan.osaka.jpkanazawa.ishikawa.jpkanegasaki.iwate.jp" +
+	"kaneyama.fukushima.jpkaneyama.yamagata.jpkani.gifu.jpkanie.aichi.jp" +
+	"kanmaki.nara.jpkanna.gunma.jpkannami.shizuoka.jpkanonji.kagawa.jp" +
+	"kanoya.kagoshima.jpkanra.gunma.jpkanuma.tochigi.jpkanzaki.saga.jp" +
+	"kapsi.fikaracol.sukaraganda.sukarasjohka.nokarasjok.nokarasuyama.tochigi.jp" +
+	"karate.museumkaratsu.saga.jpkarelia.sukarikatur.museumkariwa.niigata.jp" +
+	"kariya.aichi.jpkarlsoy.nokarmoy.nokarmøy.nokarpacz.plkartuzy.pl" +
+	"karuizawa.nagano.jpkarumai.iwate.jpkasahara.gifu.jpkasai.hyogo.jp" +
+	"kasama.ibaraki.jpkasamatsu.gifu.jpkasaoka.okayama.jpkashiba.nara.jp" +
+	"kashihara.nara.jpkashima.ibaraki.jpkashima.saga.jpkashiwa.chiba.jp" +
+	"kashiwara.osaka.jpkashiwazaki.niigata.jpkasserver.comkasuga.fukuoka.jp" +
+	"kasuga.hyogo.jpkasugai.aichi.jpkasukabe.saitama.jpkasumigaura.ibaraki.jp" +
+	"kasuya.fukuoka.jpkaszuby.plkatagami.akita.jpkatano.osaka.jpkatashina.gunma.jp" +
+	"katori.chiba.jpkatowice.plkatsuragi.nara.jpkatsuragi.wakayama.jp" +
+	"katsushika.tokyo.jpkatsuura.chiba.jpkatsuyama.fukui.jpkaufenkautokeino.no" +
+	"kawaba.gunma.jpkawachinagano.osaka.jpkawagoe.mie.jpkawagoe.saitama.jp" +
+	"kawaguchi.saitama.jpkawahara.tottori.jpkawai.iwate.jpkawai.nara.jp" +
+	"kawaiishop.jpkawajima.saitama.jpkawakami.nagano.jpkawakami.nara.jp" +
+	"kawakita.ishikawa.jpkawamata.fukushima.jpkawaminami.miyazaki.jpkawanabe.kagoshima.jp" +
+	"kawanehon.shizuoka.jpkawanishi.hyogo.jpkawanishi.nara.jpkawanishi.yamagata.jp" +
+	"kawara.fukuoka.jpkawasaki.jpkawasaki.miyagi.jpkawatana.nagasaki.jp" +
+	"kawaue.gifu.jpkawazu.shizuoka.jpkayabe.hokkaido.jpkazimierz-dolny.pl" +
+	"kazo.saitama.jpkazuno.akita.jpkddikekeisen.fukuoka.jpkeliweb.cloud" +
+	"kembuchi.hokkaido.jpkep.trkepno.plkerryhotelskerrylogisticskerryproperties" +
+	"ketrzyn.plkeymachine.dekfhkgkg.krkhkh.uakhakassia.sukharkiv.uakharkov.ua" +
+	"kherson.uakhmelnitskiy.uakhmelnytskyi.uakhplay.nlkikiakibichuo.okayama.jp" +
+	"kicks-ass.netkicks-ass.orgkidskids.museumkids.uskiev.uakiho.mie.jp" +
+	"kihoku.ehime.jpkijo.miyazaki.jpkikirara.jpkikonai.hokkaido.jpkikuchi.kumamoto.jp" +
+	"kikugawa.shizuoka.jpkilatiron.comkill.jpkilo.jpkimkimino.wakayama.jp" +
+	"kimitsu.chiba.jpkimobetsu.hokkaido.jpkin.okinawa.jpkinderkindlekinghost.net" +
+	"kinko.kagoshima.jpkinokawa.wakayama.jpkira.aichi.jpkirkenes.nokirovograd.ua" +
+	"kiryu.gunma.jpkisarazu.chiba.jpkishiwada.osaka.jpkiso.nagano.jpkisofukushima.nagano.jp" +
+	"kisosaki.mie.jpkita.kyoto.jpkita.osaka.jpkita.tokyo.jpkitaaiki.nagano.jp" +
+	"kitaakita.akita.jpkitadaito.okinawa.jpkitagata.gifu.jpkitagata.saga.jp" +
+	"kitagawa.kochi.jpkitagawa.miyazaki.jpkitahata.saga.jpkitahiroshima.hokkaido.jp" +
+	"kitakami.iwate.jpkitakata.fukushima.jpkitakata.miyazaki.jpkitakyushu.jp" +
+	"kitami.hokkaido.jpkitamoto.saitama.jpkitanakagusuku.okinawa.jpkitashiobara.fukushima.jp" +
+	"kitaura.miyazaki.jpkitayama.wakayama.jpkitchenkiwa.mie.jpkiwikiwi.nz" +
+	"kiyama.saga.jpkiyokawa.kanagawa.jpkiyosato.hokkaido.jpkiyose.tokyo.jp" +
+	"kiyosu.aichi.jpkizu.kyoto.jpklabu.noklepp.noklodzko.plklæbu.nokm" +
+	"km.uakmpsp.gov.plknknightpoint.systemsknowsitall.infoknx-server.net" +
+	"kobayashi.miyazaki.jpkobe.jpkobierzyce.plkochi.jpkochi.kochi.jpkodaira.tokyo.jp" +
+	"koebenhavn.museumkoelnkoeln.museumkofu.yamanashi.jpkoga.fukuoka.jp" +
+	"koga.ibaraki.jpkoganei.tokyo.jpkoge.tottori.jpkoka.shiga.jpkokonoe.oita.jp" +
+	"kokubunji.tokyo.jpkolobrzeg.plkomae.tokyo.jpkomagane.nagano.jpkomaki.aichi.jp" +
+	"komatsukomatsu.ishikawa.jpkomatsushima.tokushima.jpkomforb.sekommunalforbund.se" +
+	"kommune.nokomono.mie.jpkomoro.nagano.jpkomvux.sekonan.aichi.jpkonan.shiga.jp" +
+	"kongsberg.nokongsvinger.nokonin.plkonskowola.plkonsulat.gov.plkonyvelo.hu" +
+	"koobin.eventskoori.fukushima.jpkopervik.nokoriyama.fukushima.jpkoryo.nara.jp" +
+	"kosai.shizuoka.jpkosaka.akita.jpkosei.shiga.jpkosherkoshigaya.saitama.jp" +
+	"koshimizu.hokkaido.jpkoshu.yamanashi.jpkosuge.yamanashi.jpkota.aichi.jp" +
+	"koto.shiga.jpkoto.tokyo.jpkotohira.kagawa.jpkotoura.tottori.jpkouhoku.saga.jp" +
+	"kounosu.saitama.jpkouyama.kagoshima.jpkouzushima.tokyo.jpkoya.wakayama.jp" +
+	"koza.wakayama.jpkozagawa.wakayama.jpkozaki.chiba.jpkozow.comkpkpmg" +
+	"kpnkppsp.gov.plkrkr.comkr.eu.orgkr.itkr.uakraanghke.nokragero.no" +
+	"kragerø.nokrakow.plkrasnik.plkrasnodar.sukrdkredkrellian.netkristiansand.no" +
+	"kristiansund.nokrodsherad.nokrokstadelva.nokrym.uakråanghke.nokrødsherad.no" +
+	"ks.uaks.usktistory.comkuchinotsu.nagasaki.jpkudamatsu.yamaguchi.jp" +
+	"kudoyama.wakayama.jpkui.hiroshima.jpkuji.iwate.jpkuju.oita.jpkujukuri.chiba.jp" +
+	"kuki.saitama.jpkuleuven.cloudkumagaya.saitama.jpkumakogen.ehime.jp" +
+	"kumamoto.jpkumamoto.kumamoto.jpkumano.hiroshima.jpkumano.mie.jpkumatori.osaka.jp" +
+	"kumejima.okinawa.jpkumenan.okayama.jpkumiyama.kyoto.jpkunden.ortsinfo.at" +
+	"kunigami.okinawa.jpkunimi.fukushima.jpkunisaki.oita.jpkunitachi.tokyo.jp" +
+	"kunitomi.miyazaki.jpkunneppu.hokkaido.jpkunohe.iwate.jpkunst.museum" +
+	"kunstsammlung.museumkunstunddesign.museumkuokgroupkurashiki.okayama.jp" +
+	"kurate.fukuoka.jpkure.hiroshima.jpkurgan.sukuriyama.hokkaido.jpkurobe.toyama.jp" +
+	"kurogi.fukuoka.jpkuroishi.aomori.jpkuroiso.tochigi.jpkuromatsunai.hokkaido.jp" +
+	"kuron.jpkurotaki.nara.jpkurume.fukuoka.jpkusatsu.gunma.jpkusatsu.shiga.jp" +
+	"kushima.miyazaki.jpkushimoto.wakayama.jpkushiro.hokkaido.jpkustanai.ru" +
+	"kustanai.sukusu.oita.jpkutchan.hokkaido.jpkutno.plkuwana.mie.jpkuzumaki.iwate.jp" +
+	"kv.uakvafjord.nokvalsund.nokvam.nokvanangen.nokvinesdal.nokvinnherad.no" +
+	"kviteseid.nokvitsoy.nokvitsøy.nokvæfjord.nokvænangen.nokwkwp.gov.pl" +
+	"kwpsp.gov.plkyky.uskyiv.uakyonan.chiba.jpkyotamba.kyoto.jpkyotanabe.kyoto.jp" +
+	"kyotango.kyoto.jpkyotokyoto.jpkyowa.akita.jpkyowa.hokkaido.jpkyuragi.saga.jp" +
+	"kzkárášjohka.nokåfjord.nol-o-g-i-n.del.bgl.selala-spezia.itla.us" +
+	"laakesvuemie.nolab.mslabor.museumlabour.museumlacaixalahppi.nolajolla.museum" +
+	"lakas.hulamborghinilamerlanbib.selancashire.museumlancasterlancia" +
+	"landland-4-sale.uslandes.museumlanding.myjino.rulandroverlangevag.no" +
+	"langevåg.nolans.museumlanxesslapy.pllaquila.itlardal.nolarsson.museum" +
+	"larvik.nolasallelaspezia.itlatlatina.itlatinolatrobelavagis.nolavangen.no" +
+	"lawlaw.prolaw.zalawyerlaz.itlazio.itlblclc.itlcl.devlclstage.dev" +
+	"lcube-server.deldsle.itleadpages.coleangaviika.noleaseleasing.aero" +
+	"leaŋgaviika.nolebesby.nolebork.pllebtimnetz.delecce.itlecco.itleclerc" +
+	"leczna.pllefrakleg.brlegallegnica.pllegoleikanger.noleirfjord.no" +
+	"leirvik.noleitungsen.deleka.noleksvik.nolel.brlelux.sitelenug.su" +
+	"lenvik.nolerdal.nolesja.nolevanger.nolewismiller.museumlexuslezajsk.pl" +
+	"lg.jplg.ualgbtlili.itlib.ak.uslib.al.uslib.ar.uslib.as.uslib.az.us" +
+	"lib.ca.uslib.co.uslib.ct.uslib.dc.uslib.de.uslib.eelib.fl.uslib.ga.us" +
+	"lib.gu.uslib.hi.uslib.ia.uslib.id.uslib.il.uslib.in.uslib.ks.uslib.ky.us" +
+	"lib.la.uslib.ma.uslib.md.uslib.me.uslib.mi.uslib.mn.uslib.mo.uslib.ms.us" +
+	"lib.mt.uslib.nc.uslib.nd.uslib.ne.uslib.nh.uslib.nj.uslib.nm.uslib.nv.us" +
+	"lib.ny.uslib.oh.uslib.ok.uslib.or.uslib.pa.uslib.pr.uslib.ri.uslib.sc.us" +
+	"lib.sd.uslib.tn.uslib.tx.uslib.ut.uslib.va.uslib.vi.uslib.vt.uslib.wa.us" +
+	"lib.wi.uslib.wy.uslidllier.nolierne.nolifelifeinsurancelifestyle" +
+	"lig.itlightingliguria.itlikelikes-pie.comlikescandy.comlillehammer.no" +
+	"lillesand.nolillylima-city.atlima-city.chlima-city.delima-city.rocks" +
+	"lima.zonelimanowa.pllimitedlimolincolnlincoln.museumlindas.nolinde" +
+	"lindesnes.nolindås.nolinklinkyard-cloud.chlinkyard.cloudlinodeobjects.com" +
+	"linz.museumlipsylittlestar.jplivelivingliving.museumlivinghistory.museum" +
+	"livorno.itlklk3.rullcllpln.cnlo.itloabat.noloabát.noloanloanslocalhistory.museum" +
+	"localhost.daplie.melocalzone.xyzlockerlocuslodi.itlodingen.nolog.br" +
+	"loginline.apploginline.devloginline.iologinline.servicesloginline.site" +
+	"loginto.melogistics.aerologoip.comlogoip.delohmus.meloisirs.bjlol" +
+	"lolipop.iololipopmc.jplolitapunk.jplom.itlom.nolombardia.itlombardy.it" +
+	"lomo.jplomza.pllon-1.paas.massivegrid.netlon-2.paas.massivegrid.net" +
+	"lon.wafaicloud.comlondonlondon.cloudapps.digitallondon.museumlondrina.br" +
+	"loppa.nolorenskog.nolosangeles.museumloseyourip.comloten.nolotte" +
+	"lottolouvre.museumlovelovepop.jplovesick.jplowicz.plloyalist.museum" +
+	"lpages.colpllplfinanciallpusercontent.comlrlsltlt.eu.orglt.itlt.ua" +
+	"ltdltd.co.imltd.cyltd.giltd.hkltd.lkltd.ngltd.ualtd.ukltdalulu.eu.org" +
+	"lu.itlubartow.pllubin.pllublin.pllucania.itlucca.itlucerne.museum" +
+	"lug.org.uklugansk.ualugs.org.uklukow.pllund.nolundbecklunner.noluroy.no" +
+	"lurøy.noluster.nolutsk.ualuxeluxembourg.museumluxuryluzern.museum" +
+	"lvlv.eu.orglv.ualviv.ualylyngdal.nolyngen.nolynx.mythic-beasts.com" +
+	"láhppi.noläns.museumlærdal.nolødingen.nolørenskog.noløten.no" +
+	"m.bgm.semama.gov.brma.leg.brma.usmacapa.brmaceio.brmacerata.itmachida.tokyo.jp" +
+	"macysmad.museummadridmadrid.museummaebashi.gunma.jpmagazine.aero" +
+	"magentosite.cloudmagnet.pagemaibara.shiga.jpmaifmail.plmain.jpmaintenance.aero" +
+	"maisonmaizuru.kyoto.jpmakeupmakinohara.shizuoka.jpmakurazaki.kagoshima.jp" +
+	"malatvuopmi.nomalbork.plmallorca.museummalopolska.plmalselv.nomalvik.no" +
+	"mamurogawa.yamagata.jpmanmanagementmanaus.brmanchester.museummandal.no" +
+	"mangomangyshlak.sumaniwa.okayama.jpmanno.kagawa.jpmansion.museum" +
+	"mansions.museummantova.itmanx.museummaori.nzmapmap.fastly.netmap.fastlylb.net" +
+	"mar.itmarburg.museummarche.itmarine.rumaringa.brmaritime.museummaritimo.museum" +
+	"marker.nomarketmarketingmarketsmarnardal.nomarriottmarshallsmarugame.kagawa.jp" +
+	"marumori.miyagi.jpmaryland.museummarylhurst.museummasaki.ehime.jp" +
+	"maseratimasfjorden.nomashike.hokkaido.jpmashiki.kumamoto.jpmashiko.tochigi.jp" +
+	"masoy.nomassa-carrara.itmassacarrara.itmasuda.shimane.jpmat.brmatera.it" +
+	"matsubara.osaka.jpmatsubushi.saitama.jpmatsuda.kanagawa.jpmatsudo.chiba.jp" +
+	"matsue.shimane.jpmatsukawa.nagano.jpmatsumae.hokkaido.jpmatsumoto.kagoshima.jp" +
+	"matsumoto.nagano.jpmatsuno.ehime.jpmatsusaka.mie.jpmatsushige.tokushima.jp" +
+	"matsushima.miyagi.jpmatsuura.nagasaki.jpmatsuyama.ehime.jpmatsuzaki.shizuoka.jp" +
+	"matta-varjjat.nomattelmayfirst.infomayfirst.orgmazeplay.commazowsze.pl" +
+	"mazury.plmb.camb.itmbamcmc.axmc.eu.orgmc.itmcdir.memcdir.rumckinsey" +
+	"mcpe.memcpre.rumdmd.cimd.usmeme.eu.orgme.inme.itme.keme.some.ssme.tc" +
+	"me.tzme.ukme.usme.vumedmed.brmed.ecmed.eemed.htmed.lymed.ommed.pa" +
+	"med.plmed.promed.samed.sdmedecin.frmedecin.kmmediamedia.aeromedia.hu" +
+	"media.museummedia.plmediatech.bymediatech.devmedical.museummedicina.bo" +
+	"medio-campidano.itmediocampidano.itmedizinhistorisches.museummeeres.museum" +
+	"meetmeguro.tokyo.jpmein-iserv.demein-vigor.demeinforum.netmeiwa.gunma.jp" +
+	"meiwa.mie.jpmel.cloudlets.com.aumeland.nomelbournemeldal.nomelhus.no" +
+	"meloy.nomeløy.nomembers.linode.commemememorialmemorial.museummemset.net" +
+	"menmenumeraker.nomerckmsdmerseine.numeråker.nomesaverde.museummesserli.app" +
+	"messina.itmesswithdns.commeteorapp.commex.commgmg.gov.brmg.leg.br" +
+	"mhmi.itmi.thmi.usmiamimiasa.nagano.jpmiasta.plmibu.tochigi.jpmichigan.museum" +
+	"microlight.aeromicrosoftmidatlantic.museummidori.chiba.jpmidori.gunma.jp" +
+	"midsund.nomidtre-gauldal.nomie.jpmielec.plmielno.plmifune.kumamoto.jp" +
+	"migration.runmihama.aichi.jpmihama.chiba.jpmihama.fukui.jpmihama.mie.jp" +
+	"mihama.wakayama.jpmihara.hiroshima.jpmihara.kochi.jpmiharu.fukushima.jp" +
+	"miho.ibaraki.jpmikasa.hokkaido.jpmikawa.yamagata.jpmiki.hyogo.jp" +
+	"milmil.acmil.aemil.almil.armil.azmil.bamil.bomil.brmil.bymil.clmil.cn" +
+	"mil.comil.cymil.domil.ecmil.egmil.fjmil.gemil.ghmil.gtmil.hnmil.id" +
+	"mil.inmil.iqmil.jomil.kgmil.kmmil.krmil.kzmil.lvmil.mgmil.mvmil.my" +
+	"mil.mzmil.ngmil.nimil.nomil.nzmil.pemil.phmil.plmil.pymil.qamil.ru" +
+	"mil.rwmil.shmil.stmil.symil.tjmil.tmmil.tomil.trmil.twmil.tzmil.uy" +
+	"mil.vcmil.vemil.yemil.zamil.zmmil.zwmilan.itmilano.itmilitary.museum" +
+	"mill.museummima.tokushima.jpmimata.miyazaki.jpminakami.gunma.jpminamata.kumamoto.jp" +
+	"minami-alps.yamanashi.jpminami.fukuoka.jpminami.kyoto.jpminami.tokushima.jp" +
+	"minamiaiki.nagano.jpminamiashigara.kanagawa.jpminamiawaji.hyogo.jp" +
+	"minamiboso.chiba.jpminamidaito.okinawa.jpminamiechizen.fukui.jpminamifurano.hokkaido.jp" +
+	"minamiise.mie.jpminamiizu.shizuoka.jpminamimaki.nagano.jpminamiminowa.nagano.jp" +
+	"minamioguni.kumamoto.jpminamisanriku.miyagi.jpminamitane.kagoshima.jp" +
+	"minamiuonuma.niigata.jpminamiyamashiro.kyoto.jpminano.saitama.jp" +
+	"minato.osaka.jpminato.tokyo.jpmincom.tnmine.numiners.museumminimining.museum" +
+	"miniserver.comminisite.msminnesota.museummino.gifu.jpminobu.yamanashi.jp" +
+	"minoh.osaka.jpminokamo.gifu.jpminowa.nagano.jpmintmintere.sitemircloud.host" +
+	"mircloud.rumircloud.usmisaki.okayama.jpmisaki.osaka.jpmisasa.tottori.jp" +
+	"misato.akita.jpmisato.miyagi.jpmisato.saitama.jpmisato.shimane.jp" +
+	"misato.wakayama.jpmisawa.aomori.jpmisconfused.orgmishima.fukushima.jp" +
+	"mishima.shizuoka.jpmissile.museummissoula.museummisugi.mie.jpmit" +
+	"mitaka.tokyo.jpmitake.gifu.jpmitane.akita.jpmito.ibaraki.jpmitou.yamaguchi.jp" +
+	"mitoyo.kagawa.jpmitsubishimitsue.nara.jpmitsuke.niigata.jpmiura.kanagawa.jp" +
+	"miyada.nagano.jpmiyagi.jpmiyake.nara.jpmiyako.fukuoka.jpmiyako.iwate.jp" +
+	"miyakonojo.miyazaki.jpmiyama.fukuoka.jpmiyama.mie.jpmiyashiro.saitama.jp" +
+	"miyawaka.fukuoka.jpmiyazaki.jpmiyazaki.miyazaki.jpmiyazu.kyoto.jp" +
+	"miyoshi.aichi.jpmiyoshi.hiroshima.jpmiyoshi.saitama.jpmiyoshi.tokushima.jp" +
+	"miyota.nagano.jpmizuho.tokyo.jpmizumaki.fukuoka.jpmizunami.gifu.jp" +
+	"mizusawa.iwate.jpmjondalen.nomjøndalen.nomkmk.eu.orgmk.uamlmlbmlbfan.org" +
+	"mlsmmmmammafan.bizmnmn.itmn.usmomo-i-rana.nomo-siemens.iomo.cnmo.it" +
+	"mo.usmoareke.nomobara.chiba.jpmobimobi.gpmobi.kemobi.namobi.ngmobi.tt" +
+	"mobi.tzmobilemochizuki.nagano.jpmock.pstmn.iomod.gimodamodalen.no" +
+	"modelling.aeromodena.itmodern.museummods.jpmodum.nomoemoimoka.tochigi.jp" +
+	"mol.itmolde.nomolise.itmommoma.museummombetsu.hokkaido.jpmonashmond.jp" +
+	"moneymoney.bjmoney.museummongolian.jpmonmouth.museummonstermonticello.museum" +
+	"montreal.museummonza-brianza.itmonza-e-della-brianza.itmonza.itmonzabrianza.it" +
+	"monzaebrianza.itmonzaedellabrianza.itmoo.jpmoonscale.iomoonscale.net" +
+	"mordovia.rumordovia.sumorena.brmoriguchi.osaka.jpmorimachi.shizuoka.jp" +
+	"morioka.iwate.jpmoriya.ibaraki.jpmoriyama.shiga.jpmoriyoshi.akita.jp" +
+	"mormonmorotsuka.miyazaki.jpmoroyama.saitama.jpmortgagemoscowmoscow.museum" +
+	"moseushi.hokkaido.jpmosjoen.nomosjøen.nomoskenes.nomoss.nomosvik.no" +
+	"motegi.tochigi.jpmotomotobu.okinawa.jpmotorcycle.museummotorcycles" +
+	"motosu.gifu.jpmotoyama.kochi.jpmovmoviemovimiento.bomozilla-iot.org" +
+	"moåreke.nompmp.brmqmrmr.nomragowo.plmsms.gov.brms.itms.krms.leg.br" +
+	"ms.usmsdmsk.rumsk.sumtmt.eu.orgmt.gov.brmt.itmt.leg.brmt.usmtnmtr" +
+	"mumuenchen.museummuenster.museummugi.tokushima.jpmuika.niigata.jp" +
+	"mukawa.hokkaido.jpmuko.kyoto.jpmulhouse.museummunakata.fukuoka.jp" +
+	"muncie.museummuni.ilmuosat.nomuosát.nomup.gov.plmurakami.niigata.jp" +
+	"murata.miyagi.jpmurayama.yamagata.jpmurmansk.sumuroran.hokkaido.jp" +
+	"muroto.kochi.jpmus.brmus.mi.usmusashimurayama.tokyo.jpmusashino.tokyo.jp" +
+	"museet.museummuseummuseum.mvmuseum.mwmuseum.nomuseum.ommuseum.tt" +
+	"museumcenter.museummuseumvereniging.museummusicmusic.museummusica.ar" +
+	"musica.bomusician.iomutsu.aomori.jpmutsuzawa.chiba.jpmutualmutual.ar" +
+	"mvmwmw.gov.plmxmx.namymy-firewall.orgmy-gateway.demy-router.demy-vigor.de" +
+	"my-wan.demy.eu.orgmy.idmyactivedirectory.commyamaze.netmyasustor.com" +
+	"mycd.eumycloud.bymydatto.commydatto.netmyddns.rocksmydissent.net" +
+	"mydobiss.commydrobo.commyds.memyeffect.netmyfast.hostmyfast.space" +
+	"myfirewall.orgmyforum.communitymyfritz.netmyftp.bizmyftp.orgmyhome-server.de" +
+	"myiphost.commyjino.rumykolaiv.uamymailer.com.twmymediapc.netmyoko.niigata.jp" +
+	"mypep.linkmypets.wsmyphotos.ccmypi.comypsx.netmyqnapcloud.commysecuritycamera.com" +
+	"mysecuritycamera.netmysecuritycamera.orgmyshopblocks.commyshopify.com" +
+	"myspreadshop.atmyspreadshop.bemyspreadshop.camyspreadshop.chmyspreadshop.co.uk" +
+	"myspreadshop.commyspreadshop.com.aumyspreadshop.demyspreadshop.dk" +
+	"myspreadshop.esmyspreadshop.fimyspreadshop.frmyspreadshop.iemyspreadshop.it" +
+	"myspreadshop.netmyspreadshop.nlmyspreadshop.nomyspreadshop.plmyspreadshop.se" +
+	"mytabit.co.ilmytabit.commytis.rumytuleap.commyvnc.commywire.orgmz" +
+	"málatvuopmi.nomátta-várjjat.nomålselv.nomåsøy.nomāori.nzn.bg" +
+	"n.sen4t.conana.itna4u.runaamesjevuemie.nonabnabari.mie.jpnachikatsuura.wakayama.jp" +
+	"nagahama.shiga.jpnagai.yamagata.jpnagano.jpnagano.nagano.jpnaganohara.gunma.jp" +
+	"nagaoka.niigata.jpnagaokakyo.kyoto.jpnagara.chiba.jpnagareyama.chiba.jp" +
+	"nagasaki.jpnagasaki.nagasaki.jpnagasu.kumamoto.jpnagato.yamaguchi.jp" +
+	"nagatoro.saitama.jpnagawa.nagano.jpnagi.okayama.jpnagiso.nagano.jp" +
+	"nago.okinawa.jpnagoyanagoya.jpnaha.okinawa.jpnahari.kochi.jpnaie.hokkaido.jp" +
+	"naka.hiroshima.jpnaka.ibaraki.jpnakadomari.aomori.jpnakagawa.fukuoka.jp" +
+	"nakagawa.hokkaido.jpnakagawa.nagano.jpnakagawa.tokushima.jpnakagusuku.okinawa.jp" +
+	"nakagyo.kyoto.jpnakai.kanagawa.jpnakama.fukuoka.jpnakamichi.yamanashi.jp" +
+	"nakamura.kochi.jpnakaniikawa.toyama.jpnakano.nagano.jpnakano.tokyo.jp" +
+	"nakanojo.gunma.jpnakanoto.ishikawa.jpnakasatsunai.hokkaido.jpnakatane.kagoshima.jp" +
+	"nakatombetsu.hokkaido.jpnakatsugawa.gifu.jpnakayama.yamagata.jpnakijin.okinawa.jp" +
+	"naklo.plnalchik.runalchik.sunamaste.jpnamdalseid.nonamename.azname.eg" +
+	"name.etname.fjname.hrname.joname.mkname.mvname.myname.naname.ngname.pm" +
+	"name.prname.qaname.tjname.trname.ttname.vnnamegata.ibaraki.jpnamegawa.saitama.jp" +
+	"namerikawa.toyama.jpnamie.fukushima.jpnamikata.ehime.jpnamsos.no" +
+	"namsskogan.nonanae.hokkaido.jpnanao.ishikawa.jpnanbu.tottori.jpnanbu.yamanashi.jp" +
+	"nango.fukushima.jpnanjo.okinawa.jpnankoku.kochi.jpnanmoku.gunma.jp" +
+	"nannestad.nonanporo.hokkaido.jpnantan.kyoto.jpnanto.toyama.jpnanyo.yamagata.jp" +
+	"naoshima.kagawa.jpnaples.itnapoli.itnara.jpnara.nara.jpnarashino.chiba.jp" +
+	"narita.chiba.jpnaroy.nonarusawa.yamanashi.jpnaruto.tokushima.jpnarviika.no" +
+	"narvik.nonasu.tochigi.jpnasushiobara.tochigi.jpnat.tnnatal.brnational.museum" +
+	"nationalfirearms.museumnationalheritage.museumnativeamerican.museum" +
+	"natori.miyagi.jpnaturanatural.bonaturalhistory.museumnaturalhistorymuseum.museum" +
+	"naturalsciences.museumnaturbruksgymn.senature.museumnaturhistorisches.museum" +
+	"natuurwetenschappen.museumnaumburg.museumnaustdal.nonaval.museum" +
+	"navigation.aeronavoi.sunavuotna.nonavynayoro.hokkaido.jpnb.canba" +
+	"ncnc.trnc.usnd.usnene.jpne.kene.krne.pwne.tzne.ugne.usneat-url.com" +
+	"nebraska.museumnecnedre-eiker.noneko.amnemuro.hokkaido.jpnerdpol.ovh" +
+	"nerima.tokyo.jpnes.akershus.nones.buskerud.nonesna.nonesodden.no" +
+	"nesoddtangen.nonesseby.nonesset.nonetnet-freaks.comnet.acnet.aenet.af" +
+	"net.agnet.ainet.alnet.amnet.arnet.aunet.aznet.banet.bbnet.bhnet.bj" +
+	"net.bmnet.bnnet.bonet.brnet.bsnet.btnet.bznet.cinet.cmnet.cnnet.co" +
+	"net.cunet.cwnet.cynet.dmnet.donet.dznet.ecnet.egnet.etnet.eu.org" +
+	"net.fjnet.fmnet.genet.ggnet.glnet.gnnet.gpnet.grnet.gtnet.gunet.gy" +
+	"net.hknet.hnnet.htnet.idnet.ilnet.imnet.innet.iqnet.irnet.isnet.je" +
+	"net.jonet.kgnet.kinet.knnet.kwnet.kynet.kznet.lanet.lbnet.lcnet.lk" +
+	"net.lrnet.lsnet.lvnet.lynet.manet.menet.mknet.mlnet.monet.msnet.mt" +
+	"net.munet.mvnet.mwnet.mxnet.mynet.mznet.nfnet.ngnet.ninet.nrnet.nz" +
+	"net.omnet.panet.penet.phnet.pknet.plnet.pnnet.prnet.psnet.ptnet.py" +
+	"net.qanet.runet.rwnet.sanet.sbnet.scnet.sdnet.sgnet.shnet.slnet.so" +
+	"net.ssnet.stnet.synet.thnet.tjnet.tmnet.tnnet.tonet.trnet.ttnet.tw" +
+	"net.uanet.uknet.uynet.uznet.vcnet.venet.vinet.vnnet.vunet.wsnet.ye" +
+	"net.zanet.zmnetbanknetflixnetlify.appnetworkneues.museumneustarnew" +
+	"newhampshire.museumnewjersey.museumnewmexico.museumnewport.museum" +
+	"newsnews.hunewspaper.museumnewyork.museumnextnextdirectnexusneyagawa.osaka.jp" +
+	"nfnf.canflnflfan.orgnfshost.comngng.eu.orgngongo.lkngo.ngngo.phngo.za" +
+	"ngrok.ionh-serv.co.uknh.usnhknhlfan.netnhs.ukninic.innic.tjnic.za" +
+	"nichinan.miyazaki.jpnichinan.tottori.jpniconid.ioniepce.museumnieruchomosci.pl" +
+	"niigata.jpniigata.niigata.jpniihama.ehime.jpniikappu.hokkaido.jp" +
+	"niimi.okayama.jpniiza.saitama.jpnikaho.akita.jpnikeniki.hokkaido.jp" +
+	"nikita.jpnikko.tochigi.jpnikolaev.uanikonninjaninohe.iwate.jpninomiya.kanagawa.jp" +
+	"nirasaki.yamanashi.jpnis.zanishi.fukuoka.jpnishi.osaka.jpnishiaizu.fukushima.jp" +
+	"nishiarita.saga.jpnishiawakura.okayama.jpnishiazai.shiga.jpnishigo.fukushima.jp" +
+	"nishihara.kumamoto.jpnishihara.okinawa.jpnishiizu.shizuoka.jpnishikata.tochigi.jp" +
+	"nishikatsura.yamanashi.jpnishikawa.yamagata.jpnishimera.miyazaki.jp" +
+	"nishinomiya.hyogo.jpnishinoomote.kagoshima.jpnishinoshima.shimane.jp" +
+	"nishio.aichi.jpnishiokoppe.hokkaido.jpnishitosa.kochi.jpnishiwaki.hyogo.jp" +
+	"nissannissaynissedal.nonisshin.aichi.jpniteroi.brnittedal.noniyodogawa.kochi.jp" +
+	"nj.usnjs.jelastic.vps-host.netnlnl-ams-1.baremetal.scw.cloudnl.ca" +
+	"nl.cinl.eu.orgnl.nonm.cnnm.usnono-ip.bizno-ip.cano-ip.co.ukno-ip.info" +
+	"no-ip.netno-ip.orgno.comno.eu.orgno.itnobeoka.miyazaki.jpnoboribetsu.hokkaido.jp" +
+	"nobushi.jpnoda.chiba.jpnoda.iwate.jpnodebalancer.linode.comnodes.k8s.fr-par.scw.cloud" +
+	"nodes.k8s.nl-ams.scw.cloudnodes.k8s.pl-waw.scw.cloudnog.community" +
+	"nogata.fukuoka.jpnogi.tochigi.jpnoheji.aomori.jpnoho.stnohost.me" +
+	"noip.menoip.usnokianom.adnom.agnom.brnom.conom.esnom.frnom.kmnom.mg" +
+	"nom.ncnom.ninom.panom.penom.plnom.renom.ronom.tmnom.venom.zanombre.bo" +
+	"nome.cvnome.ptnomi.ishikawa.jpnonoichi.ishikawa.jpnoop.appnoor.jp" +
+	"nord-aurdal.nonord-fron.nonord-odal.nonorddal.nonordeste-idc.saveincloud.net" +
+	"nordkapp.nonordre-land.nonordreisa.nonore-og-uvdal.nonorfolk.museum" +
+	"north-kazakhstan.sunorth.museumnorthflank.appnorthwesternmutualnorton" +
+	"nose.osaka.jpnosegawa.nara.jpnoshiro.akita.jpnot.brnotaires.frnotaires.km" +
+	"noticeable.newsnoticias.bonoto.ishikawa.jpnotodden.nonotogawa.shiga.jp" +
+	"notteroy.nonov.runov.sunovara.itnovecore.sitenownow-dns.netnow-dns.org" +
+	"now-dns.topnow.shnowaruda.plnowruznowtvnozawaonsen.nagano.jpnpnr" +
+	"nranrwnrw.museumns.cansn.usnsupdate.infonsw.aunsw.edu.aunt.aunt.ca" +
+	"nt.edu.aunt.nont.rontdll.topntr.brnttnunu.canu.itnumata.gunma.jp" +
+	"numata.hokkaido.jpnumazu.shizuoka.jpnuoro.itnv.usnx.cnny-1.paas.massivegrid.net" +
+	"ny-2.paas.massivegrid.netny.usnyaa.amnyan.tonycnyc.mnnyc.museumnyny.museum" +
+	"nysa.plnyuzen.toyama.jpnznz.basketballnz.eu.orgnávuotna.nonååmesjevuemie.no" +
+	"nærøy.nonøtterøy.noo.bgo.seoamishirasato.chiba.jpoarai.ibaraki.jp" +
+	"obama.fukui.jpobama.nagasaki.jpobanazawa.yamagata.jpobiobihiro.hokkaido.jp" +
+	"obira.hokkaido.jpobninsk.suobserverobu.aichi.jpobuse.nagano.jpoceanographic.museum" +
+	"oceanographique.museumocelot.mythic-beasts.comochi.kochi.jpoci.customer-oci.com" +
+	"ocp.customer-oci.comocs.customer-oci.comod.uaodate.akita.jpodawara.kanagawa.jp" +
+	"odda.noodesa.uaodessa.uaodo.broe.yamagata.jpof.byof.jeof.nooff.ai" +
+	"officeoffice-on-the.netofficial.academyofficial.ecofunato.iwate.jp" +
+	"og.aoog.itoga.akita.jpogaki.gifu.jpogano.saitama.jpogasawara.tokyo.jp" +
+	"ogata.akita.jpogawa.ibaraki.jpogawa.nagano.jpogawa.saitama.jpogawara.miyagi.jp" +
+	"ogi.saga.jpogimi.okinawa.jpogliastra.itogori.fukuoka.jpogose.saitama.jp" +
+	"oguchi.aichi.jpoguni.kumamoto.jpoguni.yamagata.jpoh.usoharu.aichi.jp" +
+	"ohda.shimane.jpohi.fukui.jpohira.miyagi.jpohira.tochigi.jpohkura.yamagata.jp" +
+	"ohtawara.tochigi.jpoi.kanagawa.jpoirase.aomori.jpoirm.gov.ploishida.yamagata.jp" +
+	"oiso.kanagawa.jpoita.jpoita.oita.jpoizumi.gunma.jpoji.nara.jpojiya.niigata.jp" +
+	"ok.usokagaki.fukuoka.jpokawa.fukuoka.jpokawa.kochi.jpokaya.nagano.jp" +
+	"okayama.jpokayama.okayama.jpokazaki.aichi.jpokegawa.saitama.jpoketo.hokkaido.jp" +
+	"oki.fukuoka.jpokinawaokinawa.jpokinawa.okinawa.jpokinoshima.shimane.jp" +
+	"okoppe.hokkaido.jpoksnes.nookuizumo.shimane.jpokuma.fukushima.jp" +
+	"okutama.tokyo.jpol.noolawa.plolayanolayangroupolbia-tempio.itolbiatempio.it" +
+	"oldnavyolecko.plolkusz.plolloolsztyn.plomomachi.nagano.jpomachi.saga.jp" +
+	"omaezaki.shizuoka.jpomaha.museumomasvuotna.noome.tokyo.jpomegaomg.lol" +
+	"omi.nagano.jpomi.niigata.jpomigawa.chiba.jpomihachiman.shiga.jpomitama.ibaraki.jp" +
+	"omiya.saitama.jpomniwe.siteomotego.fukushima.jpomura.nagasaki.jp" +
+	"omuta.fukuoka.jpon-acorn.ioon-aptible.comon-k3s.ioon-rancher.cloud" +
+	"on-rio.ioon-the-web.tvon-web.fron.caonagawa.miyagi.jponavstack.net" +
+	"oncilla.mythic-beasts.comondigitalocean.apponeonfabrica.comonflashdrive.app" +
+	"ongong.bronga.fukuoka.jponiononjuku.chiba.jponlonlineonline.museum" +
+	"online.thonna.okinawa.jpono.fukui.jpono.fukushima.jpono.hyogo.jp" +
+	"onojo.fukuoka.jponomichi.hiroshima.jponporter.runonred.oneonrender.com" +
+	"ontario.museumonthewifi.comonza.mythic-beasts.comooguy.comookuwa.nagano.jp" +
+	"ooooops.jpooshika.nagano.jpopenopenair.museumopencraft.hostingopensocial.site" +
+	"operaunite.comopoczno.plopole.ploppdal.nooppegard.nooppegård.no" +
+	"or.ator.bior.cior.cror.idor.itor.jpor.keor.kror.muor.naor.pwor.th" +
+	"or.tzor.ugor.usora.gunma.jporacleorangeorangecloud.tnoregon.museum" +
+	"oregontrail.museumorgorg.acorg.aeorg.aforg.agorg.aiorg.alorg.amorg.ar" +
+	"org.auorg.azorg.baorg.bborg.bhorg.biorg.bjorg.bmorg.bnorg.boorg.br" +
+	"org.bsorg.btorg.bworg.bzorg.ciorg.cnorg.coorg.cuorg.cvorg.cworg.cy" +
+	"org.dmorg.doorg.dzorg.ecorg.eeorg.egorg.esorg.etorg.fjorg.fmorg.ge" +
+	"org.ggorg.ghorg.giorg.glorg.gnorg.gporg.grorg.gtorg.guorg.gyorg.hk" +
+	"org.hnorg.htorg.huorg.ilorg.imorg.inorg.iqorg.irorg.isorg.jeorg.jo" +
+	"org.kgorg.kiorg.kmorg.knorg.kporg.kworg.kyorg.kzorg.laorg.lborg.lc" +
+	"org.lkorg.lrorg.lsorg.lvorg.lyorg.maorg.meorg.mgorg.mkorg.mlorg.mn" +
+	"org.moorg.msorg.mtorg.muorg.mvorg.mworg.mxorg.myorg.mzorg.naorg.ng" +
+	"org.niorg.nrorg.nzorg.omorg.paorg.peorg.pforg.phorg.pkorg.plorg.pn" +
+	"org.prorg.psorg.ptorg.pyorg.qaorg.roorg.rsorg.ruorg.rworg.saorg.sb" +
+	"org.scorg.sdorg.seorg.sgorg.shorg.slorg.snorg.soorg.ssorg.storg.sv" +
+	"org.syorg.szorg.tjorg.tmorg.tnorg.toorg.trorg.ttorg.tworg.uaorg.ug" +
+	"org.ukorg.uyorg.uzorg.vcorg.veorg.viorg.vnorg.vuorg.wsorg.yeorg.yt" +
+	"org.zaorg.zmorg.zworganicoriginsoristano.itorkanger.noorkdal.noorland.no" +
+	"orsites.comorskog.noorsta.noorx.bizos.hedmark.noos.hordaland.noosaka" +
+	"osaka.jposakasayama.osaka.jposaki.miyagi.jposakikamijima.hiroshima.jp" +
+	"osasco.brosen.nooseto.nagasaki.jposhima.tokyo.jposhima.yamaguchi.jp" +
+	"oshino.yamanashi.jposhu.iwate.jposlo.noosoyro.noosteroy.noosterøy.no" +
+	"ostre-toten.noostroda.plostroleka.plostrowiec.plostrowwlkp.plosøyro.no" +
+	"ot.itota.gunma.jpota.tokyo.jpotago.museumotake.hiroshima.jpotaki.chiba.jp" +
+	"otaki.nagano.jpotaki.saitama.jpotama.fukushima.jpotap.cootari.nagano.jp" +
+	"otaru.hokkaido.jpote.bjother.nfoto.fukuoka.jpotobe.hokkaido.jpotofuke.hokkaido.jp" +
+	"otoineppu.hokkaido.jpotoyo.kochi.jpotsu.shiga.jpotsuchi.iwate.jp" +
+	"otsukaotsuki.kochi.jpotsuki.yamanashi.jpottouchi.saga.jpouda.nara.jp" +
+	"oum.gov.ploumu.hokkaido.jpoutsystemscloud.comoverhalla.noovhovre-eiker.no" +
+	"owani.aomori.jpowariasahi.aichi.jpown.pmownip.netownprovider.com" +
+	"owo.codesox.rsoxa.cloudoxford.museumoy.lcoya.tooyabe.toyama.jpoyama.tochigi.jp" +
+	"oyamazaki.kyoto.jpoyer.nooygarden.nooyodo.nara.jpoystre-slidre.no" +
+	"oz.auozora.hokkaido.jpozu.ehime.jpozu.kumamoto.jpp.bgp.sepapa.gov.br" +
+	"pa.gov.plpa.itpa.leg.brpa.uspaas.beebyte.iopaas.datacenter.fipaas.hosted-by-previder.com" +
+	"paas.massivegrid.compacific.museumpaderborn.museumpadova.itpadua.it" +
+	"pagepagefrontapp.compages.devpages.it.hs-heilbronn.depages.torproject.net" +
+	"pages.wiardweb.compagespeedmobilizer.compagexl.compalace.museumpaleo.museum" +
+	"palermo.itpalmas.brpalmsprings.museumpanama.museumpanasonicpanel.gg" +
+	"pantheonsite.ioparachuting.aeroparagliding.aeroparallel.jpparasite.jp" +
+	"parisparis.eu.orgparis.museumparliament.nzparma.itparoch.k12.ma.us" +
+	"parsparti.separtnerspartspartypasadena.museumpassagenspassenger-association.aero" +
+	"patria.bopavia.itpaypaywhirl.compb.aopb.gov.brpb.leg.brpc.itpc.pl" +
+	"pccwpcloud.hostpd.itpdns.pagepepe.cape.gov.brpe.itpe.krpe.leg.br" +
+	"pecori.jppeewee.jppenne.jppenza.supepper.jpper.laper.nfper.sgperma.jp" +
+	"perso.htperso.snperso.tnperspecta.cloudperugia.itpesaro-urbino.it" +
+	"pesarourbino.itpescara.itpetpfpfizerpgpg.inpg.itpgafan.netpgfog.com" +
+	"phpharmacien.frpharmaciens.kmpharmacypharmacy.museumphdphiladelphia.museum" +
+	"philadelphiaarea.museumphilately.museumphilipsphoenix.museumphone" +
+	"photophotographyphotography.museumphotosphx.enscaled.usphysiopi.gov.br" +
+	"pi.itpi.leg.brpiacenza.itpicspictetpicturespidpiedmont.itpiemonte.it" +
+	"pigboat.jppila.plpilot.aeropilots.museumpimienta.orgpinpinb.gov.pl" +
+	"pingpinkpinoko.jppioneerpippu.hokkaido.jppisa.itpistoia.itpisz.pl" +
+	"pittsburgh.museumpiw.gov.plpixolino.compizzapkplpl.eu.orgpl.uaplace" +
+	"planetarium.museumplantation.museumplants.museumplatform0.appplatformsh.site" +
+	"platter-app.complatter-app.devplatterp.usplayplaystationplaystation-cloud.com" +
+	"plaza.museumplc.co.implc.lyplc.ukplesk.pagepleskns.complo.psplumbing" +
+	"plurinacional.bopluspmpmn.itpnpn.itpncpo.gov.plpo.itpoa.brpodhale.pl" +
+	"podlasie.plpodzone.netpodzone.orgpohlpoint2this.compointto.uspoivron.org" +
+	"pokerpokrovsk.supol.dzpol.htpol.trpolice.ukpolitica.bopolitiepolkowice.pl" +
+	"poltava.uapomorskie.plpomorze.plponiatowa.plponpes.idpordenone.it" +
+	"pornporsanger.noporsangu.noporsgrunn.noporsáŋgu.noport.frportal.museum" +
+	"portland.museumportlligat.museumpostpost.inpostman-echo.composts-and-telecommunications.museum" +
+	"potager.orgpotenza.itpowiat.plpoznan.plpp.azpp.rupp.sepp.uappg.br" +
+	"prpr.gov.brpr.itpr.leg.brpr.uspramericaprato.itpraxiprd.frprd.km" +
+	"prd.mgprequalifyme.todaypreservation.museumpresidio.museumpresspress.aero" +
+	"press.cypress.mapress.museumpress.sepresse.cipresse.kmpresse.mlpri.ee" +
+	"primeprimetel.cloudprincipe.stpriv.atpriv.hupriv.instances.scw.cloud" +
+	"priv.mepriv.nopriv.plprivatelink.snowflake.appprivatizehealthinsurance.net" +
+	"propro.azpro.brpro.cypro.ecpro.fjpro.htpro.inpro.mvpro.napro.ompro.pr" +
+	"pro.ttpro.typeform.compro.vnprochowice.plprodproduction.aeroproductions" +
+	"profprof.prprofesional.boprogressiveproject.museumpromoproperties" +
+	"propertyprotectionprotonet.iopruprudentialpruszkow.plprvcy.pageprzeworsk.pl" +
+	"pspsc.brpsi.brpsp.gov.plpsse.gov.plpstmn.ioptpt.eu.orgpt.itpu.it" +
+	"pubpub.instances.scw.cloudpub.sapubl.ptpublic-inquiry.ukpublic.museum" +
+	"publishproxy.compubol.museumpubtls.orgpueblo.bopug.itpuglia.itpulawy.pl" +
+	"punyu.jppup.gov.plpupu.jppussycat.jppv.itpvh.brpvt.gepvt.k12.ma.us" +
+	"pwpwcpypya.jppyatigorsk.rupymnt.ukpythonanywhere.compz.itq-a.eu.org" +
+	"q.bgqaqa2.comqbuser.comqc.caqc.comqcx.ioqh.cnqld.auqld.edu.auqld.gov.au" +
+	"qoto.ioqponqsl.brqualifioapp.comquebecquebec.museumquestquicksytes.com" +
+	"quipelements.comr.appspot.comr.bgr.cdn77.netr.ser2.devra.itracing" +
+	"rackmaze.comrackmaze.netrade.noradioradio.amradio.brradio.fmradom.pl" +
+	"radoy.noradøy.noraffleentry.org.ukrag-cloud-ch.hosteur.comrag-cloud.hosteur.com" +
+	"ragusa.itrahkkeravju.noraholt.norailroad.museumrailway.museumraindrop.jp" +
+	"raisa.norakkestad.noralingen.norana.norandaberg.norankoshi.hokkaido.jp" +
+	"ranzan.saitama.jprar.veras.rurauma.noravendb.cloudravendb.community" +
+	"ravendb.meravendb.runravenna.itravpage.co.ilrawa-maz.plrc.itrdv.to" +
+	"rere.itre.krreadread-books.orgreadmyblog.orgreadthedocs.ioreadymade.jp" +
+	"realestaterealestate.plrealm.czrealtorrealtyrebun.hokkaido.jprec.br" +
+	"rec.corec.nfrec.rorec.verecht.prorecife.brrecipesrecreation.aero" +
+	"redred.svredirectme.netredstoneredumbrellareg.dkreggio-calabria.it" +
+	"reggio-emilia.itreggiocalabria.itreggioemilia.itrehabreisereisen" +
+	"reitreklam.hurel.htrel.plrelianceremotewd.comrenrendalen.norennebu.no" +
+	"rennesoy.norennesøy.norentrentalsrep.brrep.kprepairrepbody.aero" +
+	"repl.corepl.runreportrepublicanres.aerores.inresearch.aeroresearch.museum" +
+	"reservd.comreservd.dev.thingdust.ioreservd.disrec.thingdust.ioreservd.testing.thingdust.io" +
+	"reserve-online.comreserve-online.netresindevice.ioresistance.museum" +
+	"restrestaurantrestaurant.bjresto.bjreviewreviewsrevista.borexroth" +
+	"rg.itrhcloud.comri.itri.usribeirao.brric.jelastic.vps-host.netrich" +
+	"richardliricohrieti.itrifu.miyagi.jpriik.eerikubetsu.hokkaido.jp" +
+	"rikuzentakata.iwate.jprilrimini.itrindal.noringebu.noringerike.no" +
+	"ringsaker.noriorio.brriobranco.brriodejaneiro.museumriopreto.brrip" +
+	"rishiri.hokkaido.jprishirifuji.hokkaido.jprisor.norissa.norisør.no" +
+	"ritto.shiga.jprivne.uarj.gov.brrj.leg.brrl.norm.itrn.gov.brrn.it" +
+	"rn.leg.brroro.eu.orgro.gov.brro.imro.itro.leg.brroan.norocherrochester.museum" +
+	"rockart.museumrocksrocky.pagerodeorodoy.norogersrokunohe.aomori.jp" +
+	"rollag.noroma.itroma.museumrome.itromsa.noromskog.noroomroros.no" +
+	"rost.norotorcraft.aerorouter.managementrovigo.itrovno.uaroyal-commission.uk" +
+	"royken.noroyrvik.norr.gov.brrr.leg.brrsrs.bars.gov.brrs.leg.brrsc.cdn77.org" +
+	"rss.my.idrsvpruru.comru.eu.orgru.netrugbyruhrrunrun.appruovat.no" +
+	"russia.museumrv.uarwrwerybnik.plryd.wafaicloud.comrygge.noryokami.saitama.jp" +
+	"ryugasaki.ibaraki.jpryukyuryuoh.shiga.jprzeszow.plrzgw.gov.plráhkkerávju.no" +
+	"ráisa.noråde.noråholt.norælingen.norødøy.norømskog.norøros.no" +
+	"røst.norøyken.norøyrvik.nos.bgs.ses3-ap-northeast-1.amazonaws.com" +
+	"s3-ap-northeast-2.amazonaws.coms3-ap-south-1.amazonaws.coms3-ap-southeast-1.amazonaws.com" +
+	"s3-ap-southeast-2.amazonaws.coms3-ca-central-1.amazonaws.coms3-eu-central-1.amazonaws.com" +
+	"s3-eu-west-1.amazonaws.coms3-eu-west-2.amazonaws.coms3-eu-west-3.amazonaws.com" +
+	"s3-external-1.amazonaws.coms3-fips-us-gov-west-1.amazonaws.coms3-sa-east-1.amazonaws.com" +
+	"s3-us-east-2.amazonaws.coms3-us-gov-west-1.amazonaws.coms3-us-west-1.amazonaws.com" +
+	"s3-us-west-2.amazonaws.coms3-website-ap-northeast-1.amazonaws.com" +
+	"s3-website-ap-southeast-1.amazonaws.coms3-website-ap-southeast-2.amazonaws.com" +
+	"s3-website-eu-west-1.amazonaws.coms3-website-sa-east-1.amazonaws.com" +
+	"s3-website-us-east-1.amazonaws.coms3-website-us-west-1.amazonaws.com" +
+	"s3-website-us-west-2.amazonaws.coms3-website.ap-northeast-2.amazonaws.com" +
+	"s3-website.ap-south-1.amazonaws.coms3-website.ca-central-1.amazonaws.com" +
+	"s3-website.eu-central-1.amazonaws.coms3-website.eu-west-2.amazonaws.com" +
+	"s3-website.eu-west-3.amazonaws.coms3-website.fr-par.scw.clouds3-website.nl-ams.scw.cloud" +
+	"s3-website.pl-waw.scw.clouds3-website.us-east-2.amazonaws.coms3.amazonaws.com" +
+	"s3.ap-northeast-2.amazonaws.coms3.ap-south-1.amazonaws.coms3.ca-central-1.amazonaws.com" +
+	"s3.cn-north-1.amazonaws.com.cns3.dualstack.ap-northeast-1.amazonaws.com" +
+	"s3.dualstack.ap-northeast-2.amazonaws.coms3.dualstack.ap-south-1.amazonaws.com" +
+	"s3.dualstack.ap-southeast-1.amazonaws.coms3.dualstack.ap-southeast-2.amazonaws.com" +
+	"s3.dualstack.ca-central-1.amazonaws.coms3.dualstack.eu-central-1.amazonaws.com" +
+	"s3.dualstack.eu-west-1.amazonaws.coms3.dualstack.eu-west-2.amazonaws.com" +
+	"s3.dualstack.eu-west-3.amazonaws.coms3.dualstack.sa-east-1.amazonaws.com" +
+	"s3.dualstack.us-east-1.amazonaws.coms3.dualstack.us-east-2.amazonaws.com" +
+	"s3.eu-central-1.amazonaws.coms3.eu-west-2.amazonaws.coms3.eu-west-3.amazonaws.com" +
+	"s3.fr-par.scw.clouds3.nl-ams.scw.clouds3.pl-waw.scw.clouds3.teckids.org" +
+	"s3.us-east-2.amazonaws.coms5y.iosasa-east-1.elasticbeanstalk.com" +
+	"sa.ausa.comsa.crsa.edu.ausa.gov.ausa.gov.plsa.itsaarlandsabae.fukui.jp" +
+	"sadist.jpsado.niigata.jpsafesafetysafety.aerosaga.jpsaga.saga.jp" +
+	"sagae.yamagata.jpsagamihara.kanagawa.jpsaigawa.fukuoka.jpsaijo.ehime.jp" +
+	"saikai.nagasaki.jpsaiki.oita.jpsaintlouis.museumsaitama.jpsaitama.saitama.jp" +
+	"saito.miyazaki.jpsaka.hiroshima.jpsakado.saitama.jpsakae.chiba.jp" +
+	"sakae.nagano.jpsakahogi.gifu.jpsakai.fukui.jpsakai.ibaraki.jpsakai.osaka.jp" +
+	"sakaiminato.tottori.jpsakaki.nagano.jpsakata.yamagata.jpsakawa.kochi.jp" +
+	"sakegawa.yamagata.jpsaku.nagano.jpsakuho.nagano.jpsakurasakura.chiba.jp" +
+	"sakura.tochigi.jpsakuragawa.ibaraki.jpsakurai.nara.jpsakyo.kyoto.jp" +
+	"salangen.nosalat.nosalesalem.museumsalerno.itsalonsaltdal.nosalud.bo" +
+	"salvador.brsalvadordali.museumsalzburg.museumsamegawa.fukushima.jp" +
+	"samnanger.nosampa.brsamsclubsamsungsamukawa.kanagawa.jpsanagochi.tokushima.jp" +
+	"sanda.hyogo.jpsandcats.iosande.more-og-romsdal.nosande.møre-og-romsdal.no" +
+	"sande.vestfold.nosandefjord.nosandiego.museumsandnes.nosandnessjoen.no" +
+	"sandnessjøen.nosandoy.nosandviksandvikcoromantsandøy.nosanfrancisco.museum" +
+	"sango.nara.jpsanjo.niigata.jpsannan.hyogo.jpsannohe.aomori.jpsano.tochigi.jp" +
+	"sanofisanok.plsantabarbara.museumsantacruz.museumsantafe.museumsantamaria.br" +
+	"santoandre.brsanuki.kagawa.jpsaobernardo.brsaogonca.brsaotome.st" +
+	"sapsapporo.jpsar.itsardegna.itsardinia.itsarlsaroma.hokkaido.jpsarpsborg.no" +
+	"sarufutsu.hokkaido.jpsassasaguri.fukuoka.jpsasayama.hyogo.jpsasebo.nagasaki.jp" +
+	"saskatchewan.museumsassari.itsatosho.okayama.jpsatsumasendai.kagoshima.jp" +
+	"satte.saitama.jpsatx.museumsauda.nosauherad.nosavannahga.museumsave" +
+	"saves-the-whales.comsavona.itsaxosayama.osaka.jpsayama.saitama.jp" +
+	"sayo.hyogo.jpsbsb.uasbisbsscsc.cnsc.gov.brsc.kesc.krsc.leg.brsc.ls" +
+	"sc.tzsc.ugsc.usscascalebook.scw.cloudscbsch.aesch.idsch.irsch.jo" +
+	"sch.lksch.lysch.ngsch.qasch.sasch.sosch.sssch.tfsch.uksch.wfsch.zm" +
+	"schaefflerschlesisches.museumschmidtschoenbrunn.museumschokokeks.net" +
+	"schokoladen.museumscholarshipsschoolschool.museumschool.naschool.nz" +
+	"school.zaschoolbus.jpschools.nsw.edu.auschuleschulplattform.deschulserver.de" +
+	"schwarzschweiz.museumsci.egsciencescience-fiction.museumscience.museum" +
+	"scienceandhistory.museumscienceandindustry.museumsciencecenter.museum" +
+	"sciencecenters.museumsciencehistory.museumsciences.museumsciencesnaturelles.museum" +
+	"scientist.aeroscotscotland.museumscrapper-site.netscrapping.ccscrysec.com" +
+	"sdsd.cnsd.ussdn.gov.plsdscloud.plsese.eu.orgse.gov.brse.leg.brse.net" +
+	"seaport.museumsearchseatsebastopol.uasec.pssecaas.hksecret.jpsecure" +
+	"securitysecuritytactics.comseekseg.brseidat.netseihi.nagasaki.jp" +
+	"seika.kyoto.jpseiro.niigata.jpseirou.niigata.jpseiyo.ehime.jpsejny.pl" +
+	"sekd1.beebyteapp.ioseki.gifu.jpsekigahara.gifu.jpsekikawa.niigata.jp" +
+	"sel.noselbu.noselectselfip.bizselfip.comselfip.infoselfip.netselfip.org" +
+	"selje.noseljord.nosellfy.storesells-for-less.comsells-for-u.comsells-it.net" +
+	"sellsyourhome.orgsemboku.akita.jpsemine.miyagi.jpsenasa.arsendai.jp" +
+	"senersennan.osaka.jpsenseering.netsensiosite.cloudseoul.krsera.hiroshima.jp" +
+	"seranishi.hiroshima.jpservebbs.comservebbs.netservebbs.orgservebeer.com" +
+	"serveblog.netservecounterstrike.comserveexchange.comserveftp.com" +
+	"serveftp.netserveftp.orgservegame.comservegame.orgservehalflife.com" +
+	"servehttp.comservehumour.comserveirc.comserveminecraft.netservemp3.com" +
+	"servep2p.comservepics.comservequake.comservers.runservesarcasm.com" +
+	"service.gov.scotservice.gov.ukservice.oneservicesservices.aerosetagaya.tokyo.jp" +
+	"seto.aichi.jpsetouchi.okayama.jpsettlement.museumsettlers.museum" +
+	"settsu.osaka.jpsevastopol.uasevensewsexsex.husex.plsexysf.nosfrsg" +
+	"sg-1.paas.massivegrid.netshsh.cnshacknet.nushakotan.hokkaido.jpshangrila" +
+	"shari.hokkaido.jpsharpshawshellshell.museumsherbrooke.museumshia" +
+	"shibata.miyagi.jpshibata.niigata.jpshibecha.hokkaido.jpshibetsu.hokkaido.jp" +
+	"shibukawa.gunma.jpshibuya.tokyo.jpshichikashuku.miyagi.jpshichinohe.aomori.jp" +
+	"shiftcrypto.devshiftcrypto.ioshiftedit.ioshiga.jpshiiba.miyazaki.jp" +
+	"shijonawate.osaka.jpshika.ishikawa.jpshikabe.hokkaido.jpshikama.miyagi.jp" +
+	"shikaoi.hokkaido.jpshikatsu.aichi.jpshiki.saitama.jpshikokuchuo.ehime.jp" +
+	"shikshashima.mie.jpshimabara.nagasaki.jpshimada.shizuoka.jpshimamaki.hokkaido.jp" +
+	"shimamoto.osaka.jpshimane.jpshimane.shimane.jpshimizu.hokkaido.jp" +
+	"shimizu.shizuoka.jpshimoda.shizuoka.jpshimodate.ibaraki.jpshimofusa.chiba.jp" +
+	"shimogo.fukushima.jpshimoichi.nara.jpshimoji.okinawa.jpshimokawa.hokkaido.jp" +
+	"shimokitayama.nara.jpshimonita.gunma.jpshimonoseki.yamaguchi.jpshimosuwa.nagano.jp" +
+	"shimotsuke.tochigi.jpshimotsuma.ibaraki.jpshinagawa.tokyo.jpshinanomachi.nagano.jp" +
+	"shingo.aomori.jpshingu.fukuoka.jpshingu.hyogo.jpshingu.wakayama.jp" +
+	"shinichi.hiroshima.jpshinjo.nara.jpshinjo.okayama.jpshinjo.yamagata.jp" +
+	"shinjuku.tokyo.jpshinkamigoto.nagasaki.jpshinonsen.hyogo.jpshinshinotsu.hokkaido.jp" +
+	"shinshiro.aichi.jpshinto.gunma.jpshintoku.hokkaido.jpshintomi.miyazaki.jp" +
+	"shinyoshitomi.fukuoka.jpshiogama.miyagi.jpshiojiri.nagano.jpshioya.tochigi.jp" +
+	"shirahama.wakayama.jpshirakawa.fukushima.jpshirakawa.gifu.jpshirako.chiba.jp" +
+	"shiranuka.hokkaido.jpshiraoi.hokkaido.jpshiraoka.saitama.jpshirataka.yamagata.jp" +
+	"shiriuchi.hokkaido.jpshiroi.chiba.jpshiroishi.miyagi.jpshiroishi.saga.jp" +
+	"shirosato.ibaraki.jpshishikui.tokushima.jpshiso.hyogo.jpshisui.chiba.jp" +
+	"shitara.aichi.jpshiwa.iwate.jpshizukuishi.iwate.jpshizuoka.jpshizuoka.shizuoka.jp" +
+	"shobara.hiroshima.jpshoesshonai.fukuoka.jpshonai.yamagata.jpshoo.okayama.jp" +
+	"shopshop.brendly.rsshop.htshop.hushop.plshop.roshop.thshoparena.pl" +
+	"shopitsite.comshoppingshopselect.netshopware.storeshoujishowshow.aero" +
+	"showa.fukushima.jpshowa.gunma.jpshowa.yamanashi.jpshowtimeshunan.yamaguchi.jp" +
+	"shw.iosisi.eu.orgsi.itsibenik.museumsic.itsicilia.itsicily.itsiellak.no" +
+	"siena.itsigdal.nosiiites.comsiljan.nosilksilk.museumsimple-url.com" +
+	"simplesite.comsimplesite.com.brsimplesite.grsimplesite.plsinasinaapp.com" +
+	"singlessiracusa.itsirdal.nositesite.tb-hosting.comsite.transip.me" +
+	"siteleaf.netsites.static.landsjsjc.brsksk.cask.eu.orgskanit.noskanland.no" +
+	"skaun.noskedsmo.noskedsmokorset.noskiski.museumski.noskien.noskierva.no" +
+	"skiervá.noskinskiptvet.noskjak.noskjervoy.noskjervøy.noskjåk.no" +
+	"sklep.plsko.gov.plskoczow.plskodje.noskole.museumskyskydiving.aero" +
+	"skygearapp.comskypeskánit.noskånland.noslslask.plslattum.nosld.do" +
+	"sld.paslg.brslingslupsk.plslz.brsmsm.uasmall-web.orgsmartsmartlabeling.scw.cloud" +
+	"smilesmola.nosmushcdn.comsmøla.nosnsn.cnsnaase.nosnasa.nosncfsnillfjord.no" +
+	"snoasa.nosnowflake.appsnåase.nosnåsa.nososo.gov.plso.itsobetsu.hokkaido.jp" +
+	"soc.dzsoc.lksoc.srcf.netsoccersochi.susocialsociety.museumsodegaura.chiba.jp" +
+	"soeda.fukuoka.jpsoftbanksoftwaresoftware.aerosogndal.nosogne.nosohu" +
+	"soja.okayama.jpsoka.saitama.jpsokndal.nosola.nosolarsologne.museum" +
+	"solund.nosolutionssoma.fukushima.jpsomna.nosondre-land.nosondrio.it" +
+	"songsongdalen.nosoni.nara.jpsonysoo.kagoshima.jpsopot.plsor-aurdal.no" +
+	"sor-fron.nosor-odal.nosor-varanger.nosorfold.nosorocaba.brsorreisa.no" +
+	"sortland.nosorum.nosos.plsosa.chiba.jpsosnowiec.plsoundandvision.museum" +
+	"soundcast.mesouthcarolina.museumsouthwest.museumsowa.ibaraki.jpsoy" +
+	"sp.gov.brsp.itsp.leg.brspaspacespace-to-rent.comspace.museumspacekit.io" +
+	"spb.ruspb.suspdns.despdns.euspdns.orgspectrum.myjino.rusphinx.mythic-beasts.com" +
+	"spjelkavik.nosportsport.huspotspy.museumspydeberg.nosquare.museum" +
+	"square7.chsquare7.desquare7.netsrsr.gov.plsr.itsrht.sitesrlsrv.br" +
+	"ssss.itssl.origin.cdn77-secure.orgstst.nostaba.jpstackhero-network.com" +
+	"stadastadt.museumstage.nodeart.iostaging.onred.onestalbans.museum" +
+	"stalowa-wola.plstange.nostaplesstarstarachowice.plstargard.plstarnberg.museum" +
+	"starostwo.gov.plstat.nostate.museumstatebankstatefarmstateofdelaware.museum" +
+	"stathelle.nostatic-access.netstatic.landstatic.observableusercontent.com" +
+	"statics.cloudstation.museumstavanger.nostavern.nostcstcgroupsteam.museum" +
+	"steiermark.museumsteigen.nosteinkjer.nostg-builder.code.comstg.dev" +
+	"stgstage.devsth.ac.atstjohn.museumstjordal.nostjordalshalsen.nostjørdal.no" +
+	"stjørdalshalsen.nostockholmstockholm.museumstokke.nostolos.iostor-elvdal.no" +
+	"storagestorage.yandexcloud.netstord.nostordal.nostorestore.bbstore.dk" +
+	"store.nfstore.rostore.ststore.vestorebase.storestorfjord.nostorj.farm" +
+	"stpetersburg.museumstrand.nostranda.nostreamstreamlit.appstreamlitapp.com" +
+	"stripper.jpstryn.nostudent.aerostudiostudystuff-4-sale.orgstuff-4-sale.us" +
+	"stufftoread.comstuttgart.museumstylesusu.paba.sesub.jpsuckssue.fukuoka.jp" +
+	"suedtirol.itsuginami.tokyo.jpsugito.saitama.jpsuifu.ibaraki.jpsuisse.museum" +
+	"suita.osaka.jpsukagawa.fukushima.jpsukumo.kochi.jpsula.nosuldal.no" +
+	"suli.husumida.tokyo.jpsumita.iwate.jpsumoto.hyogo.jpsumoto.kumamoto.jp" +
+	"sumy.uasunagawa.hokkaido.jpsund.nosunndal.nosunnyday.jpsupabase.co" +
+	"supabase.insupabase.netsupersale.jpsuppliessupplysupportsurfsurgeonshall.museum" +
+	"surgerysurnadal.nosurrey.museumsusaki.kochi.jpsusono.shizuoka.jp" +
+	"suwa.nagano.jpsuwalki.plsuzaka.nagano.jpsuzu.ishikawa.jpsuzuka.mie.jp" +
+	"suzukisvsv.itsvalbard.nosvc.firenet.chsveio.nosvelvik.nosvizzera.museum" +
+	"svn-repos.deswatchsweden.museumsweetpepper.orgswidnica.plswidnik.pl" +
+	"swiebodzin.plswinoujscie.plswisssxsx.cnsysydneysydney.museumsykkylven.no" +
+	"syncloud.itsyno-ds.desynology-diskstation.desynology-ds.desynology.me" +
+	"sys.qcx.iosystemssytes.netszszczecin.plszczytno.plszex.huszkola.pl" +
+	"sálat.nosálát.nosøgne.nosømna.nosøndre-land.nosør-aurdal.no" +
+	"sør-fron.nosør-odal.nosør-varanger.nosørfold.nosørreisa.nosørum.no" +
+	"südtirol.itt.bgt.set3l3p0rt.netta.ittaa.ittabtabayama.yamanashi.jp" +
+	"tabitorder.co.iltabuse.yamaguchi.jptachiarai.fukuoka.jptachikawa.tokyo.jp" +
+	"tadaoka.osaka.jptado.mie.jptadotsu.kagawa.jptagajo.miyagi.jptagami.niigata.jp" +
+	"tagawa.fukuoka.jptahara.aichi.jptaifun-dns.detaiji.wakayama.jptaiki.hokkaido.jp" +
+	"taiki.mie.jptainai.niigata.jptaipeitaira.toyama.jptaishi.hyogo.jp" +
+	"taishi.osaka.jptaishin.fukushima.jptaito.tokyo.jptaiwa.miyagi.jp" +
+	"tajimi.gifu.jptajiri.osaka.jptaka.hyogo.jptakagi.nagano.jptakahagi.ibaraki.jp" +
+	"takahama.aichi.jptakahama.fukui.jptakaharu.miyazaki.jptakahashi.okayama.jp" +
+	"takahata.yamagata.jptakaishi.osaka.jptakamatsu.kagawa.jptakamori.kumamoto.jp" +
+	"takamori.nagano.jptakanabe.miyazaki.jptakanezawa.tochigi.jptakaoka.toyama.jp" +
+	"takarazuka.hyogo.jptakasago.hyogo.jptakasaki.gunma.jptakashima.shiga.jp" +
+	"takasu.hokkaido.jptakata.fukuoka.jptakatori.nara.jptakatsuki.osaka.jp" +
+	"takatsuki.shiga.jptakayama.gifu.jptakayama.gunma.jptakayama.nagano.jp" +
+	"takazaki.miyazaki.jptakehara.hiroshima.jptaketa.oita.jptaketomi.okinawa.jp" +
+	"taki.mie.jptakikawa.hokkaido.jptakino.hyogo.jptakinoue.hokkaido.jp" +
+	"takko.aomori.jptako.chiba.jptaku.saga.jptalktama.tokyo.jptamakawa.fukushima.jp" +
+	"tamaki.mie.jptamamura.gunma.jptamano.okayama.jptamatsukuri.ibaraki.jp" +
+	"tamayu.shimane.jptamba.hyogo.jptana.notanabe.kyoto.jptanabe.wakayama.jp" +
+	"tanagura.fukushima.jptananger.notank.museumtanohata.iwate.jptaobao" +
+	"tara.saga.jptarama.okinawa.jptaranto.ittargettargi.pltarnobrzeg.pl" +
+	"tarui.gifu.jptarumizu.kagoshima.jptas.autas.edu.autas.gov.autashkent.su" +
+	"tatamotorstatartatebayashi.gunma.jptateshina.nagano.jptateyama.chiba.jp" +
+	"tateyama.toyama.jptatsuno.hyogo.jptatsuno.nagano.jptattootawaramoto.nara.jp" +
+	"taxtaxitaxi.brtctc.brtcitcm.museumtcp4.metdtdkte.itte.uateaches-yoga.com" +
+	"teamtec.brtec.mi.ustec.vetechtech.orangetechnologytechnology.museum" +
+	"tecnologia.boteltel.trtele.amune.orgtelebit.apptelebit.iotelebit.xyz" +
+	"telekommunikation.museumtelevision.museumtemasektemp-dns.comtempio-olbia.it" +
+	"tempioolbia.ittempurl.hosttendo.yamagata.jptenei.fukushima.jptenkawa.nara.jp" +
+	"tennistenri.nara.jpteo.brteramo.ittermez.suterni.itternopil.uateshikaga.hokkaido.jp" +
+	"test-iserv.detest.rutest.tjtevatexas.museumtextile.museumtftgtgory.pl" +
+	"ththdthe.brtheatertheater.museumtheatretheshop.jptheworkpc.comthick.jp" +
+	"thingdustdata.comthruhere.nettiaaticketstickets.iotiendatiffanytime.museum" +
+	"time.notimekeeping.museumtingvoll.notinn.notipstirestiroltjtj.cn" +
+	"tjeldsund.notjmaxxtjome.notjxtjøme.notktkmaxxtksat.botltlon.network" +
+	"tmtm.cytm.dztm.frtm.hutm.kmtm.mctm.mgtm.notm.pltm.rotm.setm.zatmall" +
+	"tmp.brtntn.ittn.oxa.cloudtn.ustoto.gov.brto.gtto.itto.leg.brto.md" +
+	"toba.mie.jptobe.ehime.jptobetsu.hokkaido.jptobishima.aichi.jptochigi.jp" +
+	"tochigi.tochigi.jptochio.niigata.jptoda.saitama.jptodaytoei.aichi.jp" +
+	"toga.toyama.jptogakushi.nagano.jptogane.chiba.jptogitsu.nagasaki.jp" +
+	"togliatti.sutogo.aichi.jptogura.nagano.jptohma.hokkaido.jptohnosho.chiba.jp" +
+	"toho.fukuoka.jptokai.aichi.jptokai.ibaraki.jptokamachi.niigata.jp" +
+	"tokashiki.okinawa.jptoki.gifu.jptokigawa.saitama.jptokke.notokoname.aichi.jp" +
+	"tokorozawa.saitama.jptokushima.jptokushima.tokushima.jptokuyama.yamaguchi.jp" +
+	"tokyotokyo.jptolga.notomakomai.hokkaido.jptomari.hokkaido.jptome.miyagi.jp" +
+	"tomi.nagano.jptomigusuku.okinawa.jptomika.gifu.jptomioka.gunma.jp" +
+	"tomisato.chiba.jptomiya.miyagi.jptomobe.ibaraki.jptonaki.okinawa.jp" +
+	"tonami.toyama.jptondabayashi.osaka.jptone.ibaraki.jptonkotsu.jptono.iwate.jp" +
+	"tonosho.kagawa.jptonsberg.notoolforge.orgtoolstoon.ehime.jptoptopology.museum" +
+	"torahime.shiga.jptoraytoride.ibaraki.jptorino.ittorino.museumtorproject.net" +
+	"torsken.notos.ittosa.kochi.jptosashimizu.kochi.jptoscana.ittoshiba" +
+	"toshima.tokyo.jptosu.saga.jptotaltottori.jptottori.tottori.jptouch.museum" +
+	"tourism.bjtourism.pltourism.tntourstowada.aomori.jptowntown.museum" +
+	"townnews-staging.comtoya.hokkaido.jptoyako.hokkaido.jptoyama.jptoyama.toyama.jp" +
+	"toyo.kochi.jptoyoake.aichi.jptoyohashi.aichi.jptoyokawa.aichi.jp" +
+	"toyonaka.osaka.jptoyone.aichi.jptoyono.osaka.jptoyooka.hyogo.jptoyosato.shiga.jp" +
+	"toyotatoyota.aichi.jptoyota.yamaguchi.jptoyotomi.hokkaido.jptoyotsu.fukuoka.jp" +
+	"toyoura.hokkaido.jptoystozawa.yamagata.jptozsde.hutp.ittrtr.eu.org" +
+	"tr.ittr.notra.kptradetrader.aerotradingtrading.aerotraeumtgerade.de" +
+	"trafficplex.cloudtrainer.aerotrainingtrana.notranby.notrani-andria-barletta.it" +
+	"trani-barletta-andria.ittraniandriabarletta.ittranibarlettaandria.it" +
+	"tranoy.notranslate.googtranslated.pagetransport.museumtransporte.bo" +
+	"transurl.betransurl.eutransurl.nltranøy.notrapani.ittraveltravel.in" +
+	"travel.pltravel.tttravelchanneltravelerstravelersinsurancetrd.br" +
+	"tree.museumtrentin-sud-tirol.ittrentin-sudtirol.ittrentin-sued-tirol.it" +
+	"trentin-suedtirol.ittrentin-süd-tirol.ittrentin-südtirol.ittrentino-a-adige.it" +
+	"trentino-aadige.ittrentino-alto-adige.ittrentino-altoadige.ittrentino-s-tirol.it" +
+	"trentino-stirol.ittrentino-sud-tirol.ittrentino-sudtirol.ittrentino-sued-tirol.it" +
+	"trentino-suedtirol.ittrentino-süd-tirol.ittrentino-südtirol.it" +
+	"trentino.ittrentinoa-adige.ittrentinoaadige.ittrentinoalto-adige.it" +
+	"trentinoaltoadige.ittrentinos-tirol.ittrentinostirol.ittrentinosud-tirol.it" +
+	"trentinosudtirol.ittrentinosued-tirol.ittrentinosuedtirol.ittrentinosüd-tirol.it" +
+	"trentinosüdtirol.ittrentinsud-tirol.ittrentinsudtirol.ittrentinsued-tirol.it" +
+	"trentinsuedtirol.ittrentinsüd-tirol.ittrentinsüdtirol.ittrento.it" +
+	"treviso.ittrieste.ittriton.zonetroandin.notrogstad.notroitsk.sutrolley.museum" +
+	"tromsa.notromso.notromsø.notrondheim.notrusttrust.museumtrustee.museum" +
+	"trvtry-snowplow.comtrycloudflare.comtrysil.notræna.notrøgstad.no" +
+	"ts.itts.nettselinograd.sutsk.trtst.sitetsu.mie.jptsubame.niigata.jp" +
+	"tsubata.ishikawa.jptsubetsu.hokkaido.jptsuchiura.ibaraki.jptsuga.tochigi.jp" +
+	"tsugaru.aomori.jptsuiki.fukuoka.jptsukigata.hokkaido.jptsukiyono.gunma.jp" +
+	"tsukuba.ibaraki.jptsukui.kanagawa.jptsukumi.oita.jptsumagoi.gunma.jp" +
+	"tsunan.niigata.jptsuno.kochi.jptsuno.miyazaki.jptsuru.yamanashi.jp" +
+	"tsuruga.fukui.jptsurugashima.saitama.jptsurugi.ishikawa.jptsuruoka.yamagata.jp" +
+	"tsuruta.aomori.jptsushima.aichi.jptsushima.nagasaki.jptsuwano.shimane.jp" +
+	"tsuyama.okayama.jptttt.imtubetuitula.sutuleap-partners.comtunestunk.org" +
+	"tur.artur.brturek.plturin.itturystyka.pltuscany.ittushutuva.sutuxfamily.org" +
+	"tvtv.bbtv.botv.brtv.imtv.intv.ittv.kgtv.natv.sdtv.trtv.tztvedestrand.no" +
+	"tvstwtw.cntwmail.cctwmail.nettwmail.orgtx.ustychy.pltydal.notynset.no" +
+	"typedream.apptysfjord.notysnes.notysvar.notysvær.notztønsberg.no" +
+	"u.bgu.channelsdvr.netu.seu2-local.xnbay.comu2.xnbay.comuaua.rsubank" +
+	"ube.yamaguchi.jpuber.spaceuberspace.deubsuchihara.ibaraki.jpuchiko.ehime.jp" +
+	"uchinada.ishikawa.jpuchinomi.kagawa.jpud.ituda.nara.jpudi.brudine.it" +
+	"udono.mie.jpueda.nagano.jpueno.gunma.jpuenohara.yamanashi.jpufcfan.org" +
+	"ugug.gov.plugim.gov.pluhren.museumui.nabu.casauji.kyoto.jpujiie.tochigi.jp" +
+	"ujitawara.kyoto.jpukuk.comuk.eu.orguk.inuk.kguk.netuk.oxa.clouduk.primetel.cloud" +
+	"uk.reclaim.clouduk0.bigv.iouki.kumamoto.jpukiha.fukuoka.jpullensaker.no" +
+	"ullensvang.noulm.museumulsan.krulvik.noum.gov.plumaji.kochi.jpumb.it" +
+	"umbria.itumi.fukuoka.jpumig.gov.plunazuki.toyama.jpunder.jpundersea.museum" +
+	"uni5.netunicloud.plunicomunion.aerouniv.bjuniv.snuniversityuniversity.museum" +
+	"unjarga.nounjárga.nounnan.shimane.jpunounusualperson.comunzen.nagasaki.jp" +
+	"uoluonuma.niigata.jpuozu.toyama.jpup.inupaas.kazteleport.kzupli.io" +
+	"upow.gov.plupper.jpuppo.gov.plupsurakawa.hokkaido.jpurasoe.okinawa.jp" +
+	"urausu.hokkaido.jpurawa.saitama.jpurayasu.chiba.jpurbino-pesaro.it" +
+	"urbinopesaro.itureshino.mie.jpuri.arpaurl.twurn.arpaurown.clouduruma.okinawa.jp" +
+	"uryu.hokkaido.jpusus-1.evennode.comus-2.evennode.comus-3.evennode.com" +
+	"us-4.evennode.comus-east-1.amazonaws.comus-east-1.elasticbeanstalk.com" +
+	"us-east-2.elasticbeanstalk.comus-gov-west-1.elasticbeanstalk.com" +
+	"us-west-1.elasticbeanstalk.comus-west-2.elasticbeanstalk.comus.ax" +
+	"us.comus.eu.orgus.gov.plus.inus.kgus.naus.orgus.platform.shus.reclaim.cloud" +
+	"usa.museumusa.oita.jpusantiques.museumusarts.museumuscountryestate.museum" +
+	"usculture.museumusdecorativearts.museumuser.aseinet.ne.jpuser.fm" +
+	"user.localcert.devuser.party.eususer.srcf.netusercontent.googusercontent.jp" +
+	"users.scale.virtualcloud.com.brusgarden.museumushiku.ibaraki.jpushistory.museum" +
+	"ushuaia.museumuslivinghistory.museumusr.cloud.muni.czustka.plusui.fukuoka.jp" +
+	"usuki.oita.jput.usutah.museumutashinai.hokkaido.jputazas.huutazu.kagawa.jp" +
+	"uto.kumamoto.jputsira.noutsunomiya.tochigi.jputwente.iouvic.museum" +
+	"uw.gov.pluwajima.ehime.jpuwu.aiuyuy.comuzuz.uauzhgorod.uauzs.gov.pl" +
+	"v-info.infov.bgv.uavava.itva.nova.usvaapste.novacationsvadso.novadsø.no" +
+	"vaga.novagan.novagsoy.novaksdal.noval-d-aosta.itval-daosta.itvald-aosta.it" +
+	"valdaosta.itvaler.hedmark.novaler.ostfold.novalle-aosta.itvalle-d-aosta.it" +
+	"valle-daosta.itvalle.novalleaosta.itvalled-aosta.itvalledaosta.it" +
+	"vallee-aoste.itvallee-d-aoste.itvalleeaoste.itvalleedaoste.itvalley.museum" +
+	"vallée-aoste.itvallée-d-aoste.itvalléeaoste.itvalléedaoste.it" +
+	"vanavang.novanguardvantaa.museumvanylven.novao.itvapor.cloudvaporcloud.io" +
+	"vardo.novardø.novarese.itvarggat.novaroy.novb.itvcvc.itvda.itve" +
+	"ve.itvefsn.novega.novegarshei.novegasvegårshei.novelvet.jpven.it" +
+	"veneto.itvenezia.itvenice.itvennesla.noventuresverbania.itvercel.app" +
+	"vercel.devvercelli.itverdal.noverisignvermögensberatervermögensberatung" +
+	"verona.itverran.noversailles.museumverse.jpversicherungversus.jp" +
+	"vestby.novestnes.novestre-slidre.novestre-toten.novestvagoy.novestvågøy.no" +
+	"vetvet.brveterinaire.frveterinaire.kmvevelstad.novf.novfs.cloud9.af-south-1.amazonaws.com" +
+	"vfs.cloud9.ap-east-1.amazonaws.comvfs.cloud9.ap-northeast-1.amazonaws.com" +
+	"vfs.cloud9.ap-northeast-2.amazonaws.comvfs.cloud9.ap-northeast-3.amazonaws.com" +
+	"vfs.cloud9.ap-south-1.amazonaws.comvfs.cloud9.ap-southeast-1.amazonaws.com" +
+	"vfs.cloud9.ap-southeast-2.amazonaws.comvfs.cloud9.ca-central-1.amazonaws.com" +
+	"vfs.cloud9.eu-central-1.amazonaws.comvfs.cloud9.eu-north-1.amazonaws.com" +
+	"vfs.cloud9.eu-south-1.amazonaws.comvfs.cloud9.eu-west-1.amazonaws.com" +
+	"vfs.cloud9.eu-west-2.amazonaws.comvfs.cloud9.eu-west-3.amazonaws.com" +
+	"vfs.cloud9.me-south-1.amazonaws.comvfs.cloud9.sa-east-1.amazonaws.com" +
+	"vfs.cloud9.us-east-1.amazonaws.comvfs.cloud9.us-east-2.amazonaws.com" +
+	"vfs.cloud9.us-west-1.amazonaws.comvfs.cloud9.us-west-2.amazonaws.com" +
+	"vgvgs.novivi.itvi.usviajesvibo-valentia.itvibovalentia.itvic.auvic.edu.au" +
+	"vic.gov.auvicenza.itvideovideo.huvigvik.novikingviking.museumvikna.no" +
+	"village.museumvillasvinvindafjord.novinnica.uavinnytsia.uavipvip.jelastic.cloud" +
+	"vipsinaapp.comvirginvirginia.museumvirtual-user.devirtual.museum" +
+	"virtualserver.iovirtualuser.devirtuel.museumvisavisionviterbo.it" +
+	"vivavivian.jpvivovix.brvlaanderenvlaanderen.museumvladikavkaz.ru" +
+	"vladikavkaz.suvladimir.ruvladimir.suvlog.brvm.bytemark.co.ukvnvn.ua" +
+	"voagat.novodkavolda.novolkenkunde.museumvolkswagenvologda.suvolvo" +
+	"volyn.uavoorloper.cloudvoss.novossevangen.novotevotingvotovoyage" +
+	"vp4.mevpndns.netvpnplus.tovps-host.netvps.mcdir.ruvps.myjino.ruvr.it" +
+	"vs.itvs.mythic-beasts.comvt.itvt.usvuvuelosvultrobjects.comvv.it" +
+	"vxl.shvárggát.novågan.novågsøy.novågå.novåler.hedmark.no" +
+	"våler.østfold.noværøy.now.bgw.sewa.auwa.edu.auwa.gov.auwa.us" +
+	"wada.nagano.jpwafflecell.comwajiki.tokushima.jpwajima.ishikawa.jp" +
+	"wakasa.fukui.jpwakasa.tottori.jpwakayama.jpwakayama.wakayama.jpwake.okayama.jp" +
+	"wakkanai.hokkaido.jpwakuya.miyagi.jpwalbrzych.plwaleswales.museum" +
+	"wallonie.museumwalmartwalterwangwanggouwanouchi.gifu.jpwar.museum" +
+	"warabi.saitama.jpwarmia.plwarszawa.plwashingtondc.museumwashtenaw.mi.us" +
+	"wassamu.hokkaido.jpwatarai.mie.jpwatari.miyagi.jpwatchwatch-and-clock.museum" +
+	"watchandclock.museumwatcheswatson.jpwaw.plwazuka.kyoto.jpwe.bswe.tc" +
+	"weatherweatherchannelweb.appweb.boweb.coweb.doweb.guweb.idweb.in" +
+	"web.lkweb.nfweb.niweb.pkweb.tjweb.trweb.veweb.zawebcamweberwebhare.dev" +
+	"webhop.bizwebhop.infowebhop.mewebhop.netwebhop.orgwebhosting.beweblike.jp" +
+	"webpaas.ovh.netwebredirect.orgwebsitewebsite.yandexcloud.netwebspace.rocks" +
+	"webthings.iowebview-assets.cloud9.af-south-1.amazonaws.comwebview-assets.cloud9.ap-east-1.amazonaws.com" +
+	"webview-assets.cloud9.ap-northeast-1.amazonaws.comwebview-assets.cloud9.ap-northeast-2.amazonaws.com" +
+	"webview-assets.cloud9.ap-northeast-3.amazonaws.comwebview-assets.cloud9.ap-south-1.amazonaws.com" +
+	"webview-assets.cloud9.ap-southeast-1.amazonaws.comwebview-assets.cloud9.ap-southeast-2.amazonaws.com" +
+	"webview-assets.cloud9.ca-central-1.amazonaws.comwebview-assets.cloud9.eu-central-1.amazonaws.com" +
+	"webview-assets.cloud9.eu-north-1.amazonaws.comwebview-assets.cloud9.eu-south-1.amazonaws.com" +
+	"webview-assets.cloud9.eu-west-1.amazonaws.comwebview-assets.cloud9.eu-west-2.amazonaws.com" +
+	"webview-assets.cloud9.eu-west-3.amazonaws.comwebview-assets.cloud9.me-south-1.amazonaws.com" +
+	"webview-assets.cloud9.sa-east-1.amazonaws.comwebview-assets.cloud9.us-east-1.amazonaws.com" +
+	"webview-assets.cloud9.us-east-2.amazonaws.comwebview-assets.cloud9.us-west-1.amazonaws.com" +
+	"webview-assets.cloud9.us-west-2.amazonaws.comweddingwedeploy.iowedeploy.me" +
+	"wedeploy.shweeklylottery.org.ukwegrow.plweiboweirwellbeingzone.co.uk" +
+	"wellbeingzone.euwest1-us.cloudjiffy.netwestern.museumwesteurope.azurestaticapps.net" +
+	"westfalen.museumwestus2.azurestaticapps.netwfwhaling.museumwhitesnow.jp" +
+	"whm.fr-par.scw.cloudwhm.nl-ams.scw.cloudwhoswhowi.uswielun.plwien" +
+	"wien.funkfeuer.atwif.gov.plwiih.gov.plwikiwiki.bowiki.brwildlife.museum" +
+	"williamhillwilliamsburg.museumwinwinb.gov.plwindmill.museumwindows" +
+	"winewinnerswios.gov.plwitd.gov.plwithgoogle.comwithyoutube.comwiw.gov.pl" +
+	"wixsite.comwlocl.plwloclawek.plwmcloud.orgwmewmflabs.orgwnext.app" +
+	"wodzislaw.plwolomin.plwolterskluwerwoltlab-demo.comwoodsideworkworkers.dev" +
+	"workinggroup.aeroworkisboring.comworksworks.aeroworkshop.museumworld" +
+	"worse-than.tvwowwpdevcloud.comwpenginepowered.comwphostedmail.com" +
+	"wpmucdn.comwpmudev.hostwritesthisblog.comwroc.plwroclaw.plwsws.na" +
+	"wsa.gov.plwskr.gov.plwtcwtfwuoz.gov.plwv.uswww.ckwww.rowy.uswzmiuw.gov.pl" +
+	"x.bgx.mythic-beasts.comx.sex443.pwxboxxen.prgmr.comxeroxxfinityxihuan" +
+	"xinxj.cnxnbay.comxs4all.spacexx.glxxxxy.axxyzxz.cny.bgy.seyabu.hyogo.jp" +
+	"yabuki.fukushima.jpyachimata.chiba.jpyachiyo.chiba.jpyachiyo.ibaraki.jp" +
+	"yachtsyaese.okinawa.jpyahaba.iwate.jpyahiko.niigata.jpyahooyaita.tochigi.jp" +
+	"yaizu.shizuoka.jpyakage.okayama.jpyakumo.hokkaido.jpyakumo.shimane.jp" +
+	"yali.mythic-beasts.comyalta.uayamada.fukuoka.jpyamada.iwate.jpyamada.toyama.jp" +
+	"yamaga.kumamoto.jpyamagata.gifu.jpyamagata.ibaraki.jpyamagata.jp" +
+	"yamagata.nagano.jpyamagata.yamagata.jpyamaguchi.jpyamakita.kanagawa.jp" +
+	"yamamoto.miyagi.jpyamanakako.yamanashi.jpyamanashi.jpyamanashi.yamanashi.jp" +
+	"yamanobe.yamagata.jpyamanouchi.nagano.jpyamashina.kyoto.jpyamato.fukushima.jp" +
+	"yamato.kanagawa.jpyamato.kumamoto.jpyamatokoriyama.nara.jpyamatotakada.nara.jp" +
+	"yamatsuri.fukushima.jpyamaxunyamazoe.nara.jpyame.fukuoka.jpyanagawa.fukuoka.jp" +
+	"yanaizu.fukushima.jpyandexyandexcloud.netyao.osaka.jpyaotsu.gifu.jp" +
+	"yasaka.nagano.jpyashio.saitama.jpyashiro.hyogo.jpyasu.shiga.jpyasuda.kochi.jp" +
+	"yasugi.shimane.jpyasuoka.nagano.jpyatomi.aichi.jpyatsuka.shimane.jp" +
+	"yatsushiro.kumamoto.jpyawara.ibaraki.jpyawata.kyoto.jpyawatahama.ehime.jp" +
+	"yazu.tottori.jpybo.faithybo.partyybo.reviewybo.scienceybo.tradeye" +
+	"yk.cayn.cnynh.fryodobashiyogayoichi.hokkaido.jpyoita.niigata.jpyoka.hyogo.jp" +
+	"yokaichiba.chiba.jpyokawa.hyogo.jpyokkaichi.mie.jpyokohamayokohama.jp" +
+	"yokoshibahikari.chiba.jpyokosuka.kanagawa.jpyokote.akita.jpyokoze.saitama.jp" +
+	"yolasite.comyombo.meyomitan.okinawa.jpyonabaru.okinawa.jpyonago.tottori.jp" +
+	"yonaguni.okinawa.jpyonezawa.yamagata.jpyono.saitama.jpyorii.saitama.jp" +
+	"york.museumyorkshire.museumyoro.gifu.jpyosemite.museumyoshida.saitama.jp" +
+	"yoshida.shizuoka.jpyoshikawa.saitama.jpyoshimi.saitama.jpyoshino.nara.jp" +
+	"yoshinogari.saga.jpyoshioka.gunma.jpyotsukaido.chiba.jpyouyouth.museum" +
+	"youtubeytyuasa.wakayama.jpyufu.oita.jpyugawa.fukushima.jpyugawara.kanagawa.jp" +
+	"yuki.ibaraki.jpyukuhashi.fukuoka.jpyunyura.wakayama.jpyurihonjo.akita.jp" +
+	"yusuhara.kochi.jpyusui.kagoshima.jpyuu.yamaguchi.jpyuza.yamagata.jp" +
+	"yuzawa.niigata.jpz.bgz.seza.bzza.comza.netza.orgzachpomor.plzagan.pl" +
+	"zakopane.plzama.kanagawa.jpzamami.okinawa.jpzao.miyagi.jpzaporizhzhe.ua" +
+	"zaporizhzhia.uazapposzapto.orgzapto.xyzzarazarow.plzentsuji.kagawa.jp" +
+	"zerozgora.plzgorzelec.plzhitomir.uazhytomyr.uazipzj.cnzlg.brzmzombie.jp" +
+	"zonezoological.museumzoology.museumzp.gov.plzp.uazt.uazuerichzushi.kanagawa.jp" +
+	"zwákŋoluokta.noálaheadju.noáltá.noåfjord.noåkrehamn.noål.no" +
+	"ålesund.noålgård.noåmli.noåmot.noårdal.noås.noåseral.noåsnes.no" +
+	"øksnes.noørland.noørskog.noørsta.noøstre-toten.noøvre-eiker.no" +
+	"øyer.noøygarden.noøystre-slidre.nočáhcesuolo.noελευак.срб" +
+	"бгбелбиз.русдетиеюиком.museumкатолик" +
+	"комком.рускрым.русмир.русмкдмонмосква" +
+	"мск.русобр.србод.србонлайноргорг.рус" +
+	"орг.србпр.србрусрфсайтсамара.руссочи.рус" +
+	"спб.руссрбукрупр.србя.русқазհայאקדמיה.ישראל" +
+	"ירושלים.museumישוב.ישראלישראלממשל.ישראל" +
+	"צהל.ישראלקוםابوظبياتصالاتارامكوالاردن" +
+	"البحرينالجزائرالسعوديةالسعوديهالسعودیة" +
+	"السعودیۃالعليانالمغرباليمنامارات" +
+	"ايرانايران.irایرانایران.irبارتبازار" +
+	"بيتكبھارتتونسسودانسورياسوريةشبكة" +
+	"عراقعربعمانفلسطينقطركاثوليككوممصر" +
+	"مليسياموريتانياموقعهمراهپاكستانپاکستان" +
+	"ڀارتकॉमनेटभारतभारतम्भारोत" +
+	"संगठनবাংলাভারতভাৰতਭਾਰਤ" +
+	"ભારતଭାରତஇந்தியாஇலங்கைசிங்கப்பூர்" +
+	"భారత్ಭಾರತഭാരതംලංකාคอมทหาร.ไทย" +
+	"ธุรกิจ.ไทยรัฐบาล.ไทยศึกษา.ไทย" +
+	"องค์กร.ไทยเน็ต.ไทยไทยລາວ" +
+	"გეみんなアマゾンクラウドグーグルコムストア" +
+	"セールファッションポイント三重.jp世界个人.hk中信" +
+	"中国中國中文网亚马逊京都.jp企业佐賀.jp佛山信息" +
+	"個人.hk個人.香港健康八卦公司公司.cn公司.hk公司.香港" +
+	"公益兵庫.jp北海道.jp千葉.jp台湾台灣和歌山.jp商城" +
+	"商店商标商業.tw嘉里嘉里大酒店在线埼玉.jp大分.jp" +
+	"大拿大阪.jp天主教奈良.jp娱乐宮城.jp宮崎.jp家電富山.jp" +
+	"山口.jp山形.jp山梨.jp岐阜.jp岡山.jp岩手.jp島根.jp广东" +
+	"広島.jp微博徳島.jp愛媛.jp愛知.jp慈善我爱你手机招聘" +
+	"政务政府政府.hk政府.香港敎育.hk教育.hk教育.香港" +
+	"新加坡新潟.jp新闻时尚書籍机构東京.jp栃木.jp沖縄.jp" +
+	"淡马锡游戏滋賀.jp澳門澳门点看熊本.jp石川.jp神奈川.jp" +
+	"福井.jp福岡.jp福島.jp秋田.jp移动箇人.hk組織.hk組織.tw" +
+	"組織.香港組织.hk網絡.cn網絡.hk網絡.香港網络.hk網路.tw" +
+	"组織.hk组织.hk组织机构网址网店网站网絡.hk网络网络.cn" +
+	"网络.hk群馬.jp联通臺灣茨城.jp谷歌购物通販長崎.jp" +
+	"長野.jp集团電訊盈科青森.jp静岡.jp飞利浦食品餐厅" +
+	"香川.jp香格里拉香港高知.jp鳥取.jp鹿児島.jp닷넷닷컴" +
+	"삼성한국"
+
+var builtinOffsets = []uint32{
+	0, 4, 14, 19, 27, 48, 52, 68, 84, 98, 112, 125, 136, 151, 161, 174,
+	188, 202, 215, 228, 241, 254, 261, 268, 275, 288, 295, 304, 325, 329, 336, 343,
+	349, 355, 361, 365, 372, 386, 390, 398, 406, 414, 420, 424, 429, 433, 439, 446,
+	451, 455, 459, 463, 472, 476, 493, 502, 506, 522, 527, 530, 537, 548, 552, 557,
+	563, 583, 586, 592, 598, 601, 607, 621, 635, 652, 663, 667, 673, 680, 686, 696,
+	712, 720, 722, 727, 732, 737, 742, 747, 752, 757, 762, 767, 776, 781, 786, 791,
+	796, 801, 806, 811, 816, 825, 830, 835, 840, 845, 850, 855, 860, 865, 870, 875,
+	880, 885, 890, 895, 900, 905, 910, 915, 920, 925, 930, 935, 940, 945, 950, 957,
+	968, 975, 989, 998, 1011, 1038, 1062, 1072, 1083, 1091, 1105, 1108, 1114, 1124, 1139, 1144,
+	1146, 1151, 1166, 1177, 1183, 1200, 1217, 1235, 1253, 1256, 1261, 1269, 1275, 1281, 1291, 1301,
+	1311, 1313, 1319, 1322, 1331, 1335, 1342, 1349, 1363, 1376, 1390, 1401, 1406, 1408, 1430, 1439,
+	1442, 1448, 1457, 1467, 1469, 1474, 1488, 1495, 1511, 1521, 1539, 1545, 1556, 1562, 1570, 1578,
+	1596, 1608, 1615, 1622, 1629, 1645, 1650, 1655, 1657, 1662, 1681, 1689, 1695, 1698, 1716, 1730,
+	1743, 1749, 1770, 1794, 1804, 1810, 1823, 1831, 1846, 1863, 1877, 1890, 1902, 1914, 1920, 1935,
+	1949, 1963, 1977, 1999, 2018, 2041, 2067, 2073, 2078, 2097, 2107, 2123, 2140, 2158, 2168, 2190,
+	2204, 2224, 2236, 2260, 2276, 2297, 2310, 2325, 2329, 2341, 2357, 2374, 2388, 2396, 2415, 2428,
+	2440, 2451, 2464, 2482, 2484, 2493, 2502, 2507, 2516, 2521, 2526, 2540, 2552, 2560, 2573, 2586,
+	2600, 2610, 2619, 2628, 2635, 2641, 2650, 2658, 2662, 2677, 2698, 2719, 2725, 2737, 2743, 2749,
+	2756, 2770, 2783, 2795, 2804, 2818, 2820, 2825, 2834, 2839, 2848, 2860, 2874, 2892, 2911, 2929,
+	2935, 2947, 2961, 2977, 2992, 3008, 3031, 3049, 3064, 3078, 3082, 3087, 3101, 3106, 3113, 3120,
+	3134, 3143, 3159, 3173, 3178, 3187, 3206, 3220, 3237, 3245, 3254, 3264, 3276, 3285, 3297, 3305,
+	3329, 3353, 3375, 3397, 3404, 3413, 3421, 3434, 3449, 3464, 3480, 3495, 3501, 3514, 3533, 3548,
+	3551, 3553, 3558, 3573, 3591, 3605, 3608, 3624, 3633, 3648, 3656, 3670, 3678, 3713, 3748, 3783,
+	3814, 3849, 3884, 3893, 3902, 3907, 3916, 3928, 3938, 3948, 3962, 3971, 3974, 3992, 3998, 4004,
+	4016, 4040, 4068, 4082, 4094, 4111, 4116, 4128, 4142, 4154, 4172, 4195, 4206, 4216, 4218, 4223,
+	4232, 4247, 4256, 4258, 4264, 4269, 4274, 4278, 4294, 4312, 4328, 4334, 4350, 4366, 4387, 4405,
+	4410, 4424, 4443, 4451, 4461, 4471, 4480, 4494, 4511, 4532, 4545, 4559, 4569, 4573, 4580, 4584,
+	4590, 4593, 4599, 4605, 4611, 4617, 4627, 4633, 4639, 4658, 4674, 4688, 4692, 4699, 4718, 4735,
+	4742, 4753, 4760, 4767, 4774, 4794, 4806, 4808, 4813, 4827, 4841, 4857, 4869, 4884, 4899, 4916,
+	4937, 4953, 4973, 4992, 5014, 5030, 5045, 5049, 5058, 5069, 5090, 5109, 5126, 5141, 5159, 5163,
+	5171, 5179, 5187, 5197, 5206, 5221, 5227, 5233, 5241, 5256, 5262, 5280, 5300, 5313, 5320, 5327,
+	5334, 5345, 5352, 5359, 5366, 5373, 5380, 5387, 5394, 5404, 5420, 5438, 5446, 5453, 5469, 5483,
+	5485, 5501, 5510, 5515, 5520, 5525, 5542, 5548, 5555, 5580, 5594, 5600, 5606, 5624, 5642, 5650,
+	5652, 5661, 5668, 5672, 5679, 5684, 5696, 5707, 5715, 5722, 5732, 5749, 5767, 5781, 5788, 5800,
+	5813, 5829, 5841, 5861, 5877, 5883, 5894, 5898, 5905, 5917, 5934, 5939, 5944, 5949, 5960, 5969,
+	5979, 5986, 6001, 6010, 6020, 6030, 6039, 6041, 6055, 6063, 6066, 6090, 6101, 6103, 6106, 6117,
+	6132, 6146, 6163, 6180, 6182, 6187, 6200, 6215, 6232, 6237, 6253, 6270, 6289, 6306, 6318, 6327,
+	6331, 6335, 6339, 6355, 6357, 6366, 6371, 6380, 6393, 6397, 6408, 6419, 6430, 6445, 6469, 6480,
+	6494, 6508, 6522, 6537, 6548, 6557, 6562, 6572, 6583, 6591, 6602, 6620, 6633, 6645, 6660, 6678,
+	6697, 6716, 6725, 6737, 6753, 6763, 6772, 6779, 6793, 6797, 6816, 6832, 6836, 6848, 6851, 6858,
+	6866, 6874, 6882, 6891, 6907, 6918, 6926, 6934, 6942, 6950, 6957, 6981, 7003, 7027, 7052, 7060,
+	7068, 7078, 7089, 7097, 7105, 7113, 7123, 7131, 7139, 7149, 7159, 7168, 7180, 7189, 7198, 7207,
+	7215, 7225, 7235, 7248, 7256, 7271, 7288, 7303, 7313, 7321, 7327, 7334, 7343, 7351, 7366, 7378,
+	7390, 7402, 7416, 7429, 7439, 7451, 7466, 7478, 7491, 7498, 7504, 7506, 7509, 7515, 7518, 7522,
+	7527, 7541, 7544, 7560, 7563, 7565, 7570, 7572, 7577, 7586, 7591, 7605, 7619, 7634, 7643, 7648,
+	7654, 7670, 7679, 7699, 7706, 7710, 7719, 7728, 7734, 7742, 7750, 7765, 7775, 7787, 7794, 7807,
+	7814, 7824, 7838, 7847, 7862, 7873, 7885, 7891, 7904, 7915, 7925, 7929, 7936, 7939, 7945, 7965,
+	7983, 7997, 8011, 8013, 8015, 8024, 8029, 8031, 8037, 8043, 8045, 8050, 8063, 8075, 8081, 8087,
+	8104, 8109, 8121, 8124, 8140, 8150, 8159, 8172, 8181, 8191, 8209, 8217, 8235, 8239, 8252, 8263,
+	8272, 8276, 8281, 8284, 8290, 8296, 8302, 8322, 8336, 8347, 8364, 8377, 8389, 8398, 8401, 8407,
+	8413, 8419, 8425, 8431, 8437, 8443, 8449, 8455, 8461, 8467, 8473, 8479, 8485, 8491, 8497, 8503,
+	8509, 8515, 8521, 8527, 8533, 8539, 8545, 8551, 8557, 8563, 8569, 8585, 8587, 8592, 8602, 8613,
+	8625, 8633, 8638, 8643, 8659, 8670, 8681, 8685, 8692, 8699, 8706, 8713, 8720, 8731, 8742, 8753,
+	8765, 8777, 8788, 8799, 8810, 8821, 8832, 8843, 8854, 8865, 8876, 8887, 8898, 8912, 8926, 8940,
+	8954, 8968, 8982, 8996, 9008, 9023, 9038, 9053, 9068, 9083, 9098, 9113, 9128, 9143, 9158, 9173,
+	9188, 9203, 9214, 9225, 9236, 9247, 9258, 9269, 9280, 9291, 9302, 9313, 9324, 9335, 9346, 9357,
+	9368, 9379, 9390, 9401, 9412, 9423, 9434, 9445, 9456, 9467, 9478, 9489, 9500, 9511, 9522, 9533,
+	9544, 9555, 9566, 9577, 9588, 9599, 9610, 9621, 9632, 9643, 9654, 9665, 9677, 9686, 9697, 9701,
+	9712, 9720, 9722, 9728, 9746, 9749, 9752, 9754, 9759, 9769, 9775, 9777, 9782, 9796, 9810, 9815,
+	9826, 9833, 9841, 9851, 9855, 9862, 9884, 9898, 9908, 9918, 9925, 9945, 9955, 9958, 9966, 9970,
+	9981, 9984, 9990, 9994, 10001, 10015, 10025, 10030, 10036, 10042, 10055, 10058, 10074, 10096, 10116, 10129,
+	10141, 10156, 10164, 10174, 10177, 10187, 10193, 10205, 10222, 10240, 10258, 10266, 10277, 10287, 10298, 10300,
+	10306, 10311, 10319, 10327, 10350, 10363, 10374, 10386, 10396, 10407, 10418, 10432, 10446, 10468, 10484, 10492,
+	10504, 10510, 10521, 10531, 10545, 10552, 10572, 10585, 10598, 10612, 10620, 10635, 10651, 10661, 10669, 10681,
+	10697, 10699, 10704, 10710, 10720, 10722, 10727, 10732, 10742, 10747, 10756, 10772, 10780, 10795, 10812, 10822,
+	10840, 10859, 10878, 10887, 10903, 10922, 10937, 10951, 10961, 10969, 10982, 10990, 11001, 11007, 11010, 11020,
+	11034, 11050, 11054, 11056, 11058, 11060, 11072, 11081, 11091, 11099, 11107, 11109, 11114, 11117, 11132, 11147,
+	11163, 11174, 11185, 11195, 11209, 11222, 11231, 11246, 11261, 11270, 11274, 11285, 11289, 11293, 11299, 11301,
+	11334, 11343, 11348, 11353, 11358, 11374, 11379, 11387, 11393, 11396, 11411, 11426, 11430, 11439, 11450, 11463,
+	11466, 11472, 11483, 11500, 11504, 11520, 11531, 11534, 11540, 11556, 11566, 11572, 11576, 11591, 11602, 11620,
+	11637, 11653, 11664, 11677, 11687, 11700, 11711, 11716, 11729, 11743, 11760, 11768, 11775, 11785, 11793, 11796,
+	11821, 11828, 11848, 11867, 11872, 11876, 11882, 11889, 11899, 11915, 11930, 11938, 11952, 11956, 11973, 11977,
+	11988, 12009, 12013, 12023, 12027, 12033, 12042, 12055, 12069, 12072, 12078, 12088, 12100, 12108, 12121, 12131,
+	12139, 12154, 12163, 12168, 12171, 12177, 12180, 12184, 12187, 12189, 12197, 12205, 12213, 12221, 12229, 12237,
+	12245, 12253, 12261, 12269, 12277, 12285, 12293, 12301, 12306, 12314, 12322, 12330, 12338, 12346, 12354, 12362,
+	12370, 12378, 12386, 12394, 12402, 12410, 12418, 12426, 12431, 12439, 12447, 12455, 12463, 12471, 12479, 12487,
+	12495, 12503, 12511, 12519, 12527, 12535, 12543, 12551, 12559, 12567, 12575, 12580, 12588, 12596, 12604, 12612,
+	12620, 12628, 12636, 12644, 12650, 12652, 12661, 12674, 12700, 12713, 12722, 12727, 12736, 12747, 12760, 12766,
+	12779, 12808, 12811, 12815, 12833, 12844, 12859, 12875, 12889, 12904, 12906, 12917, 12920, 12923, 12925, 12927,
+	12936, 12941, 12946, 12967, 12979, 12996, 13002, 13009, 13024, 13031, 13043, 13048, 13052, 13070, 13075, 13083,
+	13100, 13112, 13123, 13135, 13147, 13160, 13173, 13193, 13201, 13215, 13226, 13245, 13254, 13275, 13298, 13317,
+	13335, 13353, 13372, 13390, 13407, 13426, 13447, 13466, 13481, 13497, 13519, 13530, 13541, 13556, 13563, 13586,
+	13594, 13613, 13647, 13671, 13686, 13700, 13719, 13735, 13751, 13767, 13783, 13797, 13812, 13827, 13842, 13852,
+	13869, 13890, 13899, 13905, 13919, 13925, 13936, 13947, 13960, 13975, 13988, 14001, 14018, 14024, 14026, 14031,
+	14038, 14048, 14058, 14064, 14081, 14094, 14102, 14108, 14121, 14126, 14140, 14150, 14157, 14161, 14166, 14170,
+	14177, 14193, 14211, 14223, 14237, 14252, 14266, 14282, 14290, 14308, 14327, 14346, 14361, 14363, 14368, 14370,
+	14375, 14381, 14389, 14397, 14406, 14420, 14433, 14438, 14454, 14469, 14475, 14483, 14497, 14509, 14517, 14522,
+	14543, 14566, 14576, 14603, 14629, 14649, 14670, 14680, 14692, 14708, 14723, 14735, 14752, 14771, 14790, 14803,
+	14822, 14836, 14854, 14868, 14880, 14891, 14901, 14913, 14923, 14933, 14945, 14956, 14967, 14977, 14987, 15005,
+	15022, 15026, 15035, 15042, 15049, 15051, 15053, 15083, 15117, 15123, 15132, 15137, 15142, 15147, 15152, 15158,
+	15166, 15180, 15186, 15188, 15193, 15198, 15203, 15208, 15213, 15218, 15223, 15228, 15233, 15244, 15249, 15254,
+	15259, 15264, 15269, 15275, 15280, 15285, 15290, 15302, 15311, 15323, 15328, 15333, 15338, 15343, 15348, 15353,
+	15358, 15363, 15368, 15373, 15378, 15383, 15388, 15393, 15399, 15404, 15409, 15414, 15419, 15424, 15429, 15434,
+	15439, 15444, 15454, 15459, 15464, 15469, 15474, 15479, 15484, 15492, 15497, 15502, 15507, 15512, 15517, 15522,
+	15527, 15540, 15545, 15550, 15555, 15560, 15565, 15570, 15575, 15580, 15585, 15590, 15595, 15600, 15605, 15610,
+	15615, 15620, 15631, 15652, 15662, 15670, 15683, 15688, 15700, 15711, 15717, 15726, 15732, 15746, 15763, 15770,
+	15784, 15791, 15818, 15840, 15855, 15870, 15873, 15879, 15885, 15891, 15897, 15903, 15909, 15915, 15921, 15927,
+	15933, 15939, 15945, 15951, 15957, 15963, 15969, 15975, 15981, 15987, 15993, 15999, 16005, 16011, 16017, 16023,
+	16029, 16035, 16041, 16047, 16053, 16059, 16065, 16071, 16077, 16083, 16089, 16095, 16101, 16107, 16113, 16119,
+	16125, 16131, 16137, 16143, 16149, 16155, 16161, 16167, 16173, 16179, 16185, 16191, 16197, 16203, 16209, 16215,
+	16221, 16227, 16233, 16239, 16245, 16251, 16257, 16263, 16269, 16275, 16281, 16287, 16293, 16299, 16305, 16311,
+	16317, 16323, 16329, 16335, 16341, 16347, 16353, 16359, 16365, 16371, 16377, 16383, 16389, 16395, 16401, 16407,
+	16413, 16419, 16425, 16431, 16437, 16443, 16449, 16455, 16461, 16467, 16473, 16479, 16485, 16491, 16497, 16503,
+	16509, 16515, 16521, 16527, 16533, 16539, 16545, 16551, 16557, 16563, 16569, 16575, 16581, 16587, 16593, 16599,
+	16605, 16611, 16617, 16623, 16629, 16635, 16641, 16647, 16653, 16659, 16665, 16671, 16677, 16683, 16689, 16695,
+	16701, 16707, 16713, 16719, 16726, 16734, 16744, 16764, 16785, 16794, 16810, 16827, 16843, 16850, 16857, 16864,
+	16887, 16908, 16932, 16946, 16954, 16969, 16991, 16997, 17018, 17024, 17031, 17038, 17045, 17060, 17067, 17079,
+	17091, 17106, 17116, 17131, 17138, 17149, 17168, 17190, 17201, 17213, 17227, 17234, 17248, 17252, 17263, 17267,
+	17274, 17281, 17288, 17295, 17302, 17309, 17316, 17323, 17330, 17337, 17351, 17368, 17376, 17394, 17430, 17437,
+	17452, 17462, 17476, 17496, 17508, 17515, 17535, 17548, 17554, 17561, 17568, 17574, 17577, 17584, 17589, 17591,
+	17596, 17601, 17613, 17626, 17642, 17651, 17657, 17672, 17678, 17688, 17699, 17709, 17718, 17724, 17730, 17737,
+	17746, 17756, 17761, 17764, 17770, 17777, 17792, 17797, 17802, 17818, 17824, 17829, 17834, 17836, 17845, 17855,
+	17870, 17891, 17905, 17913, 17923, 17934, 17942, 17963, 17987, 18009, 18029, 18054, 18075, 18091, 18117, 18141,
+	18152, 18154, 18159, 18161, 18163, 18168, 18170, 18179, 18185, 18197, 18202, 18214, 18223, 18232, 18236, 18238,
+	18247, 18252, 18262, 18270, 18274, 18281, 18285, 18291, 18296, 18299, 18307, 18317, 18332, 18343, 18354, 18370,
+	18385, 18399, 18417, 18428, 18441, 18455, 18460, 18469, 18480, 18484, 18499, 18511, 18515, 18532, 18548, 18554,
+	18560, 18574, 18588, 18602, 18614, 18628, 18643, 18656, 18659, 18677, 18682, 18686, 18695, 18702, 18710, 18719,
+	18731, 18743, 18755, 18767, 18775, 18784, 18794, 18797, 18799, 18805, 18812, 18821, 18826, 18831, 18836, 18857,
+	18862, 18866, 18872, 18877, 18886, 18896, 18903, 18910, 18931, 18941, 18949, 18955, 18966, 18978, 18984, 18999,
+	19007, 19015, 19019, 19036, 19052, 19070, 19078, 19083, 19101, 19120, 19137, 19150, 19158, 19166, 19180, 19196,
+	19204, 19210, 19217, 19223, 19233, 19245, 19251, 19258, 19262, 19268, 19279, 19292, 19298, 19306, 19314, 19328,
+	19331, 19351, 19370, 19391, 19397, 19412, 19418, 19435, 19448, 19463, 19486, 19495, 19504, 19513, 19530, 19533,
+	19545, 19553, 19569, 19573, 19582, 19589, 19611, 19626, 19641, 19647, 19669, 19691, 19700, 19715, 19729, 19737,
+	19752, 19766, 19774, 19790, 19794, 19808, 19822, 19837, 19858, 19873, 19888, 19904, 19907, 19909, 19911, 19920,
+	19932, 19934, 19939, 19956, 19962, 19979, 19982, 19994, 20006, 20018, 20029, 20040, 20051, 20060, 20070, 20084,
+	20094, 20103, 20117, 20130, 20132, 20136, 20142, 20153, 20168, 20183, 20186, 20198, 20205, 20215, 20223, 20238,
+	20251, 20264, 20277, 20288, 20299, 20309, 20327, 20330, 20338, 20346, 20351, 20356, 20361, 20366, 20376, 20388,
+	20399, 20411, 20421, 20437, 20442, 20451, 20457, 20464, 20471, 20481, 20492, 20502, 20514, 20525, 20536, 20547,
+	20556, 20559, 20564, 20575, 20581, 20587, 20593, 20606, 20610, 20613, 20624, 20634, 20643, 20648, 20661, 20672,
+	20686, 20696, 20710, 20722, 20743, 20751, 20763, 20775, 20787, 20803, 20821, 20834, 20852, 20870, 20885, 20900,
+	20915, 20928, 20943, 20960, 20975, 20992, 21009, 21023, 21038, 21053, 21063, 21081, 21096, 21107, 21117, 21126,
+	21135, 21145, 21154, 21165, 21173, 21182, 21191, 21199, 21208, 21210, 21219, 21223, 21227, 21233, 21242, 21247,
+	21252, 21270, 21287, 21315, 21331, 21358, 21371, 21385, 21388, 21406, 21417, 21435, 21452, 21469, 21476, 21478,
+	21494, 21500, 21503, 21509, 21515, 21526, 21543, 21551, 21562, 21567, 21572, 21577, 21582, 21587, 21592, 21603,
+	21618, 21637, 21648, 21660, 21681, 21694, 21704, 21720, 21723, 21729, 21735, 21741, 21747, 21753, 21759, 21765,
+	21771, 21777, 21783, 21789, 21795, 21801, 21807, 21813, 21819, 21825, 21831, 21837, 21843, 21849, 21855, 21861,
+	21867, 21873, 21879, 21885, 21891, 21897, 21903, 21909, 21915, 21925, 21931, 21937, 21943, 21949, 21955, 21961,
+	21967, 21973, 21979, 21985, 21991, 21997, 22003, 22009, 22015, 22021, 22027, 22033, 22039, 22045, 22051, 22057,
+	22063, 22069, 22075, 22082, 22088, 22094, 22100, 22106, 22112, 22118, 22124, 22130, 22136, 22142, 22148, 22154,
+	22160, 22166, 22172, 22178, 22184, 22190, 22196, 22202, 22208, 22214, 22220, 22226, 22232, 22238, 22244, 22250,
+	22256, 22262, 22268, 22274, 22280, 22286, 22292, 22298, 22304, 22310, 22316, 22322, 22328, 22334, 22340, 22346,
+	22352, 22360, 22366, 22372, 22378, 22384, 22390, 22396, 22402, 22408, 22414, 22420, 22426, 22432, 22438, 22444,
+	22450, 22456, 22462, 22468, 22474, 22480, 22486, 22492, 22498, 22504, 22510, 22519, 22535, 22553, 22566, 22575,
+	22577, 22586, 22603, 22614, 22616, 22627, 22636, 22651, 22659, 22665, 22676, 22687, 22697, 22708, 22720, 22736,
+	22752, 22762, 22782, 22799, 22819, 22828, 22841, 22856, 22870, 22876, 22893, 22903, 22908, 22914, 22926, 22945,
+	22962, 22968, 22982, 22999, 23015, 23021, 23031, 23037, 23047, 23052, 23063, 23076, 23084, 23103, 23120, 23137,
+	23157, 23167, 23173, 23179, 23185, 23192, 23203, 23214, 23222, 23235, 23246, 23260, 23277, 23284, 23290, 23301,
+	23316, 23334, 23345, 23363, 23380, 23398, 23430, 23445, 23450, 23459, 23473, 23475, 23480, 23488, 23505, 23509,
+	23519, 23529, 23531, 23549, 23554, 23563, 23572, 23577, 23586, 23602, 23620, 23626, 23629, 23641, 23660, 23679,
+	23696, 23716, 23722, 23728, 23741, 23743, 23763, 23769, 23785, 23791, 23799, 23806, 23816, 23818, 23835, 23852,
+	23869, 23886, 23919, 23949, 23979, 24009, 24014, 24020, 24036, 24042, 24058, 24064, 24078, 24099, 24105, 24115,
+	24125, 24128, 24139, 24148, 24154, 24168, 24186, 24201, 24215, 24223, 24236, 24249, 24266, 24274, 24280, 24301,
+	24308, 24315, 24327, 24337, 24356, 24360, 24364, 24368, 24372, 24381, 24386, 24396, 24402, 24408, 24421, 24433,
+	24445, 24457, 24460, 24464, 24480, 24486, 24490, 24501, 24521, 24528, 24542, 24558, 24568, 24575, 24590, 24594,
+	24609, 24629, 24641, 24655, 24673, 24685, 24697, 24706, 24715, 24723, 24728, 24733, 24739, 24754, 24759, 24767,
+	24787, 24803, 24811, 24819, 24825, 24851, 24859, 24869, 24876, 24883, 24895, 24901, 24911, 24916, 24930, 24935,
+	24941, 24948, 24954, 24956, 24975, 24980, 24989, 24994, 24998, 25006, 25010, 25016, 25028, 25043, 25059, 25073,
+	25087, 25101, 25115, 25129, 25143, 25154, 25158, 25165, 25176, 25182, 25188, 25194, 25199, 25206, 25215, 25229,
+	25244, 25258, 25267, 25277, 25281, 25296, 25306, 25316, 25325, 25345, 25364, 25384, 25403, 25414, 25421, 25428,
+	25435, 25442, 25449, 25456, 25463, 25470, 25478, 25482, 25489, 25492, 25501, 25508, 25510, 25515, 25524, 25532,
+	25534, 25539, 25545, 25556, 25571, 25578, 25590, 25599, 25613, 25624, 25630, 25638, 25649, 25656, 25660, 25667,
+	25676, 25684, 25695, 25709, 25719, 25726, 25734, 25743, 25750, 25767, 25770, 25777, 25793, 25800, 25802, 25807,
+	25812, 25817, 25837, 25843, 25852, 25854, 25863, 25880, 25892, 25902, 25905, 25909, 25920, 25927, 25935, 25949,
+	25961, 25973, 25985, 25996, 26008, 26012, 26020, 26025, 26040, 26055, 26070, 26085, 26099, 26115, 26130, 26137,
+	26147, 26156, 26164, 26183, 26199, 26204, 26212, 26223, 26232, 26238, 26248, 26265, 26268, 26274, 26276, 26301,
+	26329, 26357, 26366, 26371, 26393, 26403, 26415, 26428, 26442, 26453, 26469, 26477, 26493, 26509, 26528, 26542,
+	26546, 26553, 26567, 26580, 26593, 26605, 26617, 26628, 26643, 26661, 26673, 26686, 26704, 26711, 26726, 26739,
+	26748, 26763, 26781, 26800, 26818, 26842, 26865, 26882, 26899, 26917, 26934, 26957, 26979, 26995, 26998, 27009,
+	27016, 27024, 27034, 27045, 27056, 27067, 27078, 27089, 27100, 27111, 27122, 27133, 27144, 27155, 27166, 27177,
+	27188, 27199, 27210, 27221, 27232, 27243, 27254, 27265, 27276, 27287, 27298, 27309, 27320, 27331, 27342, 27353,
+	27364, 27375, 27386, 27397, 27408, 27419, 27430, 27441, 27452, 27463, 27474, 27485, 27496, 27507, 27518, 27529,
+	27540, 27551, 27562, 27573, 27584, 27595, 27606, 27613, 27622, 27630, 27642, 27651, 27659, 27668, 27677, 27686,
+	27692, 27704, 27707, 27725, 27739, 27754, 27768, 27788, 27797, 27815, 27831, 27850, 27868, 27888, 27909, 27937,
+	27953, 27970, 27989, 28011, 28027, 28044, 28061, 28081, 28101, 28108, 28132, 28152, 28169, 28187, 28207, 28223,
+	28237, 28245, 28264, 28274, 28293, 28310, 28332, 28353, 28365, 28386, 28389, 28407, 28427, 28446, 28476, 28480,
+	28495, 28505, 28515, 28533, 28542, 28558, 28578, 28599, 28617, 28624, 28637, 28651, 28670, 28676, 28693, 28708,
+	28720, 28736, 28752, 28758, 28761, 28774, 28785, 28794, 28798, 28802, 28815, 28821, 28823, 28828, 28840, 28843,
+	28850, 28864, 28869, 28875, 28883, 28900, 28904, 28917, 28931, 28938, 28943, 28951, 28964, 28973, 28986, 28996,
+	28999, 29005, 29018, 29029, 29043, 29052, 29062, 29065, 29067, 29073, 29077, 29082, 29084, 29089, 29095, 29104,
+	29107, 29116, 29118, 29123, 29126, 29133, 29147, 29163, 29175, 29190, 29208, 29214, 29223, 29229, 29235, 29241,
+	29255, 29263, 29272, 29276, 29288, 29295, 29311, 29317, 29331, 29349, 29355, 29369, 29379, 29390, 29401, 29403,
+	29405, 29410, 29414, 29420, 29422, 29430, 29432, 29448, 29462, 29466, 29471, 29483, 29490, 29499, 29511, 29524,
+	29537, 29555, 29572, 29585, 29593, 29601, 29618, 29630, 29639, 29648, 29665, 29686, 29695, 29705, 29710, 29716,
+	29726, 29737, 29748, 29758, 29767, 29777, 29779, 29790, 29795, 29807, 29810, 29820, 29832, 29841, 29851, 29857,
+	29879, 29900, 29905, 29914, 29923, 29933, 29944, 29946, 29951, 29955, 29963, 29966, 29969, 29971, 29981, 29986,
+	29991, 30004, 30013, 30018, 30023, 30028, 30033, 30038, 30047, 30052, 30057, 30062, 30067, 30072, 30078, 30084,
+	30090, 30096, 30102, 30108, 30114, 30120, 30126, 30132, 30138, 30144, 30150, 30156, 30162, 30178, 30185, 30197,
+	30207, 30214, 30229, 30235, 30253, 30259, 30263, 30272, 30276, 30286, 30292, 30300, 30316, 30319, 30327, 30331,
+	30337, 30351, 30365, 30368, 30374, 30386, 30396, 30406, 30412, 30424, 30440, 30456, 30459, 30468, 30478, 30488,
+	30507, 30523, 30538, 30554, 30564, 30571, 30578, 30585, 30592, 30599, 30606, 30609, 30615, 30621, 30627, 30633,
+	30639, 30645, 30651, 30657, 30663, 30669, 30675, 30681, 30687, 30693, 30699, 30705, 30711, 30717, 30723, 30729,
+	30735, 30741, 30747, 30753, 30759, 30765, 30771, 30777, 30783, 30789, 30795, 30801, 30807, 30813, 30819, 30825,
+	30831, 30837, 30843, 30849, 30855, 30861, 30867, 30873, 30879, 30885, 30891, 30897, 30903, 30909, 30915, 30921,
+	30927, 30933, 30939, 30945, 30951, 30957, 30963, 30969, 30975, 30981, 30987, 30993, 30999, 31005, 31011, 31017,
+	31023, 31029, 31035, 31041, 31047, 31053, 31059, 31065, 31071, 31077, 31083, 31089, 31095, 31101, 31110, 31116,
+	31122, 31128, 31134, 31140, 31146, 31152, 31158, 31164, 31170, 31176, 31182, 31188, 31194, 31200, 31206, 31212,
+	31218, 31224, 31232, 31238, 31244, 31250, 31256, 31262, 31268, 31274, 31280, 31286, 31292, 31298, 31304, 31310,
+	31316, 31322, 31328, 31334, 31340, 31346, 31352, 31358, 31364, 31370, 31376, 31382, 31388, 31403, 31410, 31412,
+	31414, 31416, 31422, 31431, 31436, 31441, 31449, 31459, 31466, 31484, 31492, 31502, 31510, 31520, 31532, 31538,
+	31549, 31559, 31564, 31572, 31583, 31588, 31599, 31606, 31620, 31635, 31645, 31653, 31664, 31683, 31688, 31698,
+	31707, 31716, 31722, 31728, 31735, 31737, 31745, 31753, 31761, 31766, 31774, 31782, 31790, 31805, 31813, 31821,
+	31829, 31837, 31845, 31855, 31863, 31871, 31879, 31893, 31901, 31909, 31917, 31925, 31931, 31937, 31939, 31941,
+	31946, 31953, 31961, 31967, 31972, 31987, 31991, 31996, 32003, 32013, 32025, 32033, 32041, 32057, 32061, 32081,
+	32086, 32091, 32096, 32098, 32108, 32113, 32115, 32127, 32138, 32150, 32169, 32174, 32187, 32197, 32212, 32232,
+	32250, 32254, 32258, 32263, 32268, 32285, 32294, 32312, 32320, 32336, 32355, 32372, 32392, 32410, 32419, 32437,
+	32452, 32465, 32482, 32501, 32505, 32522, 32542, 32560, 32576, 32593, 32612, 32621, 32630, 32647, 32655, 32671,
+	32688, 32709, 32717, 32727, 32743, 32767, 32774, 32788, 32803, 32816, 32831, 32848, 32867, 32886, 32900, 32914,
+	32928, 32944, 32951, 32966, 32982, 32998, 33007, 33022, 33036, 33044, 33053, 33068, 33078, 33103, 33117, 33135,
+	33146, 33165, 33180, 33201, 33218, 33231, 33241, 33250, 33269, 33288, 33312, 33327, 33339, 33343, 33356, 33377,
+	33397, 33410, 33423, 33428, 33431, 33435, 33443, 33448, 33454, 33475, 33488, 33497, 33506, 33516, 33524, 33538,
+	33559, 33575, 33588, 33592, 33600, 33615, 33637, 33645, 33654, 33665, 33677, 33683, 33691, 33695, 33713, 33728,
+	33734, 33747, 33760, 33784, 33801, 33827, 33845, 33855, 33859, 33864, 33869, 33878, 33893, 33905, 33923, 33938,
+	33955, 33973, 33983, 34001, 34021, 34039, 34063, 34089, 34118, 34140, 34163, 34186, 34211, 34234, 34256, 34283,
+	34310, 34334, 34356, 34377, 34396, 34417, 34441, 34466, 34487, 34506, 34526, 34548, 34572, 34594, 34601, 34613,
+	34632, 34649, 34666, 34681, 34696, 34713, 34727, 34740, 34755, 34770, 34787, 34805, 34811, 34819, 34837, 34855,
+	34872, 34889, 34906, 34925, 34946, 34962, 34982, 35001, 35020, 35035, 35052, 35070, 35082, 35091, 35110, 35125,
+	35142, 35166, 35187, 35204, 35223, 35237, 35260, 35272, 35279, 35297, 35319, 35342, 35363, 35371, 35374, 35387,
+	35398, 35411, 35413, 35418, 35424, 35430, 35433, 35438, 35443, 35451, 35459, 35468, 35470, 35475, 35477, 35482,
+	35496, 35510, 35518, 35527, 35533, 35539, 35556, 35567, 35578, 35597, 35615, 35634, 35640, 35648, 35655, 35662,
+	35676, 35687, 35699, 35706, 35723, 35738, 35752, 35761, 35772, 35783, 35794, 35803, 35813, 35825, 35838, 35851,
+	35864, 35881, 35886, 35905, 35923, 35932, 35944, 35956, 35968, 35980, 35994, 36014, 36019, 36030, 36048, 36062,
+	36078, 36093, 36104, 36112, 36121, 36133, 36154, 36169, 36189, 36194, 36203, 36210, 36218, 36222, 36234, 36241,
+	36259, 36276, 36291, 36306, 36309, 36317, 36325, 36333, 36340, 36346, 36365, 36372, 36377, 36389, 36392, 36403,
+	36415, 36417, 36426, 36436, 36441, 36447, 36454, 36458, 36460, 36471, 36473, 36479, 36488, 36494, 36500, 36519,
+	36536, 36545, 36554, 36562, 36571, 36576, 36588, 36596, 36613, 36620, 36626, 36636, 36653, 36663, 36675, 36681,
+	36695, 36707, 36719, 36732, 36736, 36740, 36744, 36748, 36755, 36760, 36773, 36789, 36807, 36817, 36833, 36843,
+	36858, 36861, 36869, 36878, 36882, 36885, 36904, 36921, 36939, 36956, 36973, 37000, 37017, 37036, 37055, 37074,
+	37077, 37086, 37088, 37093, 37115, 37130, 37135, 37140, 37145, 37155, 37160, 37172, 37178, 37187, 37193, 37199,
+	37201, 37210, 37214, 37219, 37222, 37242, 37261, 37277, 37291, 37307, 37323, 37342, 37358, 37375, 37391, 37396,
+	37411, 37425, 37439, 37453, 37466, 37483, 37498, 37512, 37518, 37533, 37546, 37563, 37565, 37574, 37579, 37587,
+	37600, 37619, 37636, 37638, 37643, 37659, 37679, 37698, 37704, 37717, 37723, 37727, 37742, 37746, 37756, 37766,
+	37779, 37795, 37797, 37809, 37821, 37830, 37842, 37851, 37861, 37871, 37886, 37895, 37905, 37915, 37924, 37939,
+	37944, 37950, 37955, 37960, 37965, 37970, 37975, 37989, 38002, 38016, 38028, 38044, 38058, 38073, 38090, 38109,
+	38128, 38151, 38167, 38170, 38176, 38186, 38192, 38198, 38204, 38210, 38216, 38241, 38263, 38285, 38305, 38326,
+	38336, 38347, 38360, 38374, 38393, 38412, 38422, 38433, 38445, 38455, 38467, 38473, 38479, 38485, 38491, 38499,
+	38503, 38510, 38517, 38524, 38531, 38538, 38545, 38552, 38559, 38566, 38573, 38580, 38587, 38594, 38601, 38608,
+	38615, 38622, 38629, 38636, 38643, 38650, 38657, 38664, 38671, 38678, 38685, 38692, 38699, 38706, 38713, 38720,
+	38727, 38734, 38741, 38748, 38755, 38758, 38764, 38775, 38778, 38790, 38813, 38831, 38846, 38855, 38864, 38878,
+	38884, 38887, 38893, 38899, 38905, 38911, 38917, 38923, 38933, 38939, 38945, 38951, 38957, 38963, 38969, 38975,
+	38981, 38987, 38993, 38999, 39005, 39011, 39030, 39048, 39061, 39076, 39087, 39094, 39100, 39116, 39127, 39141,
+	39143, 39148, 39153, 39161, 39170, 39194, 39202, 39213, 39221, 39223, 39225, 39236, 39245, 39250, 39261, 39277,
+	39279, 39297, 39313, 39332, 39350, 39368, 39386, 39402, 39421, 39434, 39447, 39460, 39481, 39493, 39515, 39532,
+	39549, 39564, 39589, 39602, 39615, 39628, 39642, 39655, 39675, 39690, 39705, 39724, 39739, 39755, 39775, 39794,
+	39808, 39825, 39843, 39857, 39873, 39889, 39913, 39934, 39949, 39968, 39985, 40003, 40018, 40034, 40050, 40065,
+	40083, 40103, 40118, 40135, 40154, 40170, 40188, 40209, 40217, 40233, 40245, 40256, 40273, 40289, 40309, 40326,
+	40337, 40348, 40368, 40380, 40392, 40407, 40422, 40438, 40454, 40470, 40487, 40506, 40515, 40524, 40529, 40541,
+	40553, 40565, 40582, 40598, 40604, 40623, 40633, 40652, 40669, 40679, 40688, 40702, 40718, 40737, 40757, 40778,
+	40789, 40808, 40828, 40835, 40851, 40858, 40874, 40894, 40897, 40905, 40918, 40928, 40942, 40944, 40949, 40955,
+	40964, 40994, 41014, 41031, 41047, 41063, 41077, 41095, 41099, 41118, 41133, 41141, 41156, 41175, 41192, 41198,
+	41201, 41219, 41229, 41239, 41246, 41263, 41281, 41298, 41316, 41336, 41352, 41368, 41389, 41407, 41424, 41441,
+	41455, 41463, 41482, 41488, 41500, 41505, 41521, 41536, 41554, 41568, 41586, 41604, 41626, 41642, 41662, 41683,
+	41687, 41705, 41724, 41740, 41746, 41752, 41761, 41775, 41783, 41795, 41799, 41810, 41820, 41823, 41851, 41871,
+	41880, 41893, 41899, 41901, 41919, 41923, 41939, 41946, 41964, 41988, 42012, 42025, 42046, 42056, 42065, 42074,
+	42081, 42090, 42105, 42117, 42127, 42137, 42153, 42164, 42169, 42180, 42187, 42201, 42214, 42230, 42240, 42248,
+	42273, 42276, 42281, 42284, 42303, 42322, 42341, 42343, 42346, 42349, 42351, 42366, 42370, 42377, 42383, 42400,
+	42409, 42425, 42437, 42447, 42456, 42462, 42474, 42489, 42492, 42506, 42518, 42535, 42550, 42553, 42566, 42574,
+	42576, 42585, 42590, 42595, 42601, 42609, 42616, 42620, 42625, 42631, 42653, 42658, 42672, 42690, 42707, 42713,
+	42724, 42731, 42738, 42744, 42749, 42760, 42773, 42777, 42781, 42790, 42799, 42808, 42817, 42826, 42835, 42844,
+	42853, 42862, 42871, 42877, 42886, 42895, 42904, 42913, 42922, 42928, 42937, 42946, 42955, 42964, 42973, 42982,
+	42991, 43000, 43009, 43018, 43027, 43036, 43045, 43054, 43063, 43072, 43081, 43090, 43099, 43108, 43117, 43126,
+	43135, 43144, 43153, 43162, 43171, 43177, 43186, 43195, 43204, 43210, 43219, 43228, 43237, 43246, 43255, 43275,
+	43295, 43315, 43328, 43335, 43352, 43372, 43387, 43397, 43413, 43428, 43451, 43470, 43479, 43491, 43513, 43528,
+	43546, 43564, 43580, 43599, 43617, 43635, 43653, 43669, 43689, 43709, 43728, 43745, 43761, 43770, 43781, 43792,
+	43801, 43818, 43835, 43855, 43871, 43886, 43899, 43913, 43936, 43958, 43975, 43993, 44013, 44030, 44050, 44067,
+	44086, 44106, 44124, 44140, 44161, 44183, 44199, 44218, 44241, 44258, 44282, 44303, 44319, 44332, 44347, 44367,
+	44384, 44395, 44409, 44429, 44448, 44469, 44489, 44501, 44515, 44530, 44544, 44563, 44580, 44599, 44613, 44630,
+	44645, 44653, 44663, 44675, 44688, 44699, 44720, 44733, 44748, 44758, 44774, 44791, 44806, 44816, 44825, 44835,
+	44845, 44855, 44874, 44890, 44906, 44920, 44937, 44954, 44972, 44987, 45004, 45022, 45037, 45053, 45071, 45093,
+	45106, 45123, 45138, 45154, 45173, 45195, 45212, 45222, 45239, 45254, 45272, 45287, 45298, 45315, 45336, 45355,
+	45372, 45390, 45396, 45409, 45424, 45446, 45460, 45478, 45498, 45517, 45531, 45544, 45557, 45576, 45594, 45610,
+	45630, 45651, 45673, 45694, 45715, 45733, 45750, 45771, 45788, 45799, 45817, 45837, 45851, 45869, 45887, 45905,
+	45920, 45935, 45939, 45941, 45958, 45971, 45991, 45997, 46005, 46016, 46030, 46045, 46055, 46068, 46071, 46073,
+	46078, 46080, 46085, 46097, 46107, 46117, 46127, 46142, 46157, 46166, 46168, 46171, 46190, 46203, 46216, 46220,
+	46231, 46238, 46245, 46256, 46271, 46287, 46298, 46317, 46336, 46356, 46369, 46376, 46383, 46386, 46404, 46420,
+	46441, 46455, 46461, 46467, 46479, 46497, 46517, 46530, 46541, 46554, 46568, 46585, 46603, 46617, 46640, 46655,
+	46668, 46681, 46694, 46712, 46730, 46750, 46766, 46782, 46799, 46819, 46835, 46860, 46877, 46898, 46918, 46931,
+	46949, 46968, 46993, 47018, 47037, 47057, 47064, 47075, 47079, 47086, 47100, 47120, 47140, 47155, 47170, 47183,
+	47191, 47199, 47209, 47218, 47220, 47225, 47237, 47239, 47258, 47273, 47287, 47308, 47315, 47328, 47336, 47350,
+	47366, 47383, 47388, 47400, 47417, 47432, 47447, 47463, 47478, 47491, 47506, 47524, 47536, 47550, 47568, 47583,
+	47590, 47609, 47634, 47644, 47662, 47672, 47685, 47701, 47710, 47724, 47738, 47750, 47764, 47772, 47785, 47800,
+	47811, 47824, 47842, 47853, 47874, 47887, 47904, 47919, 47933, 47939, 47959, 47980, 47998, 48017, 48030, 48043,
+	48056, 48074, 48092, 48107, 48125, 48145, 48164, 48180, 48196, 48216, 48231, 48240, 48242, 48246, 48249, 48261,
+	48263, 48269, 48278, 48283, 48288, 48300, 48310, 48321, 48330, 48340, 48352, 48355, 48359, 48371, 48386, 48401,
+	48414, 48429, 48436, 48449, 48463, 48468, 48473, 48485, 48507, 48529, 48549, 48565, 48578, 48590, 48607, 48622,
+	48636, 48655, 48673, 48684, 48704, 48723, 48736, 48753, 48772, 48790, 48807, 48825, 48844, 48863, 48879, 48897,
+	48917, 48937, 48952, 48964, 48984, 49005, 49014, 49034, 49051, 49068, 49077, 49097, 49113, 49130, 49148, 49166,
+	49190, 49198, 49214, 49231, 49247, 49263, 49282, 49303, 49322, 49333, 49344, 49356, 49375, 49383, 49396, 49413,
+	49418, 49429, 49440, 49447, 49459, 49471, 49484, 49496, 49506, 49517, 49529, 49542, 49544, 49554, 49566, 49568,
+	49573, 49580, 49595, 49612, 49630, 49647, 49652, 49660, 49674, 49691, 49706, 49708, 49724, 49735, 49747, 49751,
+	49755, 49757, 49769, 49774, 49789, 49795, 49807, 49820, 49827, 49836, 49850, 49858, 49869, 49874, 49883, 49900,
+	49909, 49915, 49919, 49933, 49946, 49963, 49972, 49983, 49995, 50006, 50013, 50020, 50030, 50039, 50053, 50062,
+	50069, 50080, 50083, 50092, 50098, 50105, 50115, 50126, 50129, 50136, 50142, 50148, 50154, 50162, 50164, 50166,
+	50171, 50178, 50190, 50205, 50208, 50213, 50225, 50239, 50244, 50256, 50271, 50281, 50290, 50303, 50311, 50319,
+	50326, 50335, 50341, 50347, 50352, 50362, 50366, 50378, 50390, 50400, 50413, 50420, 50430, 50436, 50446, 50454,
+	50463, 50472, 50480, 50491, 50509, 50514, 50524, 50529, 50534, 50538, 50540, 50545, 50554, 50563, 50572, 50581,
+	50590, 50599, 50608, 50617, 50626, 50635, 50641, 50650, 50659, 50668, 50677, 50686, 50695, 50704, 50713, 50722,
+	50731, 50740, 50749, 50758, 50767, 50776, 50785, 50794, 50803, 50812, 50821, 50830, 50839, 50848, 50857, 50866,
+	50875, 50884, 50893, 50902, 50911, 50920, 50929, 50938, 50947, 50956, 50965, 50974, 50983, 50992, 51001, 51010,
+	51019, 51028, 51037, 51041, 51048, 51057, 51061, 51074, 51083, 51089, 51097, 51107, 51111, 51124, 51138, 51152,
+	51164, 51169, 51181, 51193, 51205, 51220, 51229, 51240, 51247, 51251, 51258, 51272, 51281, 51286, 51298, 51308,
+	51312, 51329, 51343, 51360, 51371, 51376, 51389, 51393, 51399, 51412, 51432, 51442, 51444, 51450, 51453, 51456,
+	51461, 51466, 51475, 51485, 51489, 51494, 51513, 51532, 51545, 51551, 51556, 51563, 51574, 51580, 51593, 51606,
+	51618, 51636, 51650, 51660, 51674, 51684, 51693, 51702, 51712, 51715, 51725, 51737, 51750, 51756, 51762, 51774,
+	51785, 51792, 51800, 51826, 51852, 51870, 51876, 51900, 51913, 51924, 51932, 51944, 51961, 51975, 51983, 51988,
+	51993, 52006, 52010, 52020, 52031, 52040, 52055, 52064, 52067, 52079, 52096, 52098, 52100, 52102, 52111, 52116,
+	52121, 52124, 52133, 52139, 52145, 52151, 52157, 52163, 52169, 52175, 52179, 52181, 52190, 52195, 52206, 52214,
+	52223, 52233, 52241, 52255, 52265, 52275, 52286, 52294, 52301, 52309, 52318, 52326, 52335, 52344, 52352, 52356,
+	52373, 52379, 52392, 52394, 52403, 52408, 52415, 52417, 52427, 52436, 52458, 52468, 52480, 52490, 52502, 52515,
+	52524, 52528, 52532, 52534, 52543, 52552, 52557, 52566, 52575, 52586, 52602, 52607, 52617, 52623, 52636, 52653,
+	52666, 52683, 52694, 52710, 52714, 52721, 52728, 52744, 52750, 52766, 52772, 52794, 52817, 52831, 52841, 52856,
+	52869, 52879, 52888, 52910, 52913, 52923, 52932, 52949, 52958, 52963, 52976, 52993, 53008, 53022, 53037, 53047,
+	53058, 53066, 53069, 53083, 53099, 53105, 53119, 53128, 53137, 53147, 53162, 53177, 53186, 53192, 53201, 53208,
+	53220, 53228, 53237, 53255, 53273, 53288, 53305, 53320, 53328, 53341, 53360, 53379, 53397, 53405, 53421, 53436,
+	53453, 53459, 53468, 53486, 53507, 53526, 53542, 53559, 53578, 53598, 53620, 53639, 53655, 53671, 53694, 53714,
+	53734, 53752, 53773, 53789, 53795, 53808, 53820, 53832, 53843, 53852, 53857, 53862, 53865, 53867, 53872, 53881,
+	53886, 53894, 53902, 53910, 53917, 53925, 53927, 53932, 53937, 53939, 53948, 53953, 53958, 53963, 53968, 53973,
+	53978, 53983, 53988, 53993, 53998, 54001, 54007, 54013, 54019, 54025, 54031, 54037, 54043, 54049, 54056, 54062,
+	54068, 54078, 54088, 54093, 54103, 54111, 54123, 54131, 54143, 54156, 54170, 54181, 54199, 54216, 54242, 54255,
+	54259, 54274, 54287, 54300, 54313, 54327, 54339, 54359, 54368, 54377, 54386, 54395, 54403, 54412, 54430, 54434,
+	54442, 54457, 54467, 54470, 54474, 54484, 54492, 54503, 54514, 54530, 54542, 54552, 54567, 54580, 54587, 54589,
+	54598, 54607, 54609, 54614, 54619, 54624, 54629, 54644, 54653, 54668, 54683, 54698, 54707, 54725, 54740, 54755,
+	54765, 54782, 54788, 54797, 54806, 54824, 54837, 54852, 54867, 54882, 54895, 54913, 54932, 54947, 54966, 54981,
+	54999, 55017, 55030, 55033, 55039, 55045, 55051, 55057, 55063, 55069, 55075, 55081, 55087, 55093, 55099, 55105,
+	55111, 55117, 55123, 55129, 55135, 55141, 55147, 55153, 55159, 55165, 55171, 55177, 55183, 55189, 55195, 55201,
+	55207, 55213, 55219, 55225, 55231, 55237, 55243, 55249, 55255, 55261, 55267, 55273, 55279, 55285, 55291, 55297,
+	55303, 55309, 55315, 55321, 55327, 55333, 55339, 55345, 55351, 55357, 55363, 55369, 55375, 55381, 55387, 55393,
+	55399, 55407, 55416, 55431, 55442, 55459, 55477, 55494, 55514, 55538, 55555, 55570, 55589, 55609, 55635, 55655,
+	55674, 55696, 55718, 55742, 55758, 55779, 55799, 55821, 55844, 55867, 55890, 55913, 55937, 55954, 55969, 55984,
+	55993, 56000, 56013, 56017, 56030, 56044, 56055, 56071, 56083, 56102, 56116, 56132, 56148, 56152, 56164, 56177,
+	56188, 56199, 56216, 56231, 56248, 56263, 56279, 56296, 56313, 56331, 56347, 56362, 56382, 56401, 56415, 56430,
+	56443, 56446, 56461, 56475, 56490, 56505, 56523, 56539, 56549, 56563, 56581, 56598, 56614, 56623, 56637, 56654,
+	56669, 56691, 56708, 56721, 56741, 56760, 56771, 56791, 56806, 56822, 56842, 56860, 56880, 56896, 56911, 56930,
+	56946, 56963, 56975, 56988, 56990, 56999, 57004, 57006, 57009, 57019, 57022, 57024, 57027, 57037, 57039, 57044,
+	57049, 57051, 57063, 57076, 57081, 57086, 57091, 57101, 57116, 57120, 57127, 57134, 57141, 57148, 57155, 57162,
+	57168, 57187, 57200, 57206, 57210, 57220, 57234, 57243, 57256, 57263, 57271, 57274, 57277, 57292, 57298, 57306,
+	57315, 57318, 57329, 57349, 57355, 57362, 57367, 57375, 57387, 57399, 57414, 57421, 57438, 57453, 57469, 57493,
+	57501, 57516, 57532, 57553, 57559, 57571, 57584, 57595, 57606, 57615, 57633, 57654, 57670, 57687, 57704, 57722,
+	57728, 57749, 57768, 57776, 57782, 57795, 57815, 57825, 57836, 57847, 57854, 57863, 57880, 57884, 57901, 57918,
+	57929, 57943, 57960, 57963, 57968, 57981, 57996, 58007, 58009, 58014, 58016, 58018, 58023, 58033, 58035, 58044,
+	58049, 58054, 58063, 58068, 58071, 58077, 58083, 58085, 58094, 58103, 58108, 58117, 58122, 58125, 58128, 58130,
+	58145, 58160, 58177, 58193, 58211, 58224, 58239, 58258, 58271, 58278, 58287, 58297, 58307, 58326, 58342, 58362,
+	58373, 58392, 58407, 58413, 58422, 58446, 58464, 58477, 58483, 58492, 58501, 58510, 58519, 58528, 58547, 58570,
+	58575, 58587, 58596, 58605, 58616, 58631, 58649, 58655, 58664, 58666, 58668, 58677, 58679, 58684, 58686, 58701,
+	58714, 58726, 58737, 58746, 58755, 58760, 58781, 58792, 58805, 58812, 58822, 58833, 58844, 58856, 58869, 58881,
+	58892, 58899, 58911, 58922, 58934, 58948, 58965, 58976, 58985, 58994, 59010, 59022, 59031, 59042, 59057, 59070,
+	59086, 59096, 59105, 59116, 59123, 59132, 59147, 59167, 59187, 59207, 59223, 59236, 59251, 59266, 59281, 59296,
+	59314, 59330, 59349, 59364, 59379, 59394, 59409, 59424, 59439, 59454, 59470, 59485, 59500, 59515, 59530, 59543,
+	59554, 59562, 59574, 59583, 59593, 59595, 59610, 59628, 59639, 59649, 59658, 59662, 59666, 59672, 59674, 59679,
+	59686, 59703, 59706, 59719, 59744, 59761, 59778, 59787, 59803, 59822, 59840, 59859, 59874, 59893, 59904, 59924,
+	59942, 59961, 59980, 59996, 60011, 60027, 60042, 60048, 60057, 60072, 60087, 60103, 60120, 60135, 60155, 60174,
+	60194, 60212, 60233, 60254, 60270, 60287, 60304, 60326, 60343, 60364, 60380, 60395, 60412, 60432, 60456, 60477,
+	60501, 60520, 60540, 60558, 60566, 60576, 60586, 60596, 60609, 60613, 60620, 60627, 60634, 60641, 60648, 60655,
+	60662, 60669, 60676, 60683, 60690, 60697, 60704, 60711, 60718, 60725, 60732, 60739, 60758, 60777, 60797, 60815,
+	60832, 60841, 60854, 60871, 60888, 60904, 60922, 60940, 60956, 60972, 60988, 61000, 61019, 61034, 61049, 61066,
+	61084, 61093, 61102, 61109, 61121, 61139, 61154, 61162, 61183, 61202, 61213, 61222, 61237, 61260, 61266, 61274,
+	61289, 61312, 61335, 61356, 61372, 61378, 61388, 61409, 61436, 61458, 61475, 61488, 61512, 61538, 61553, 61564,
+	61576, 61591, 61599, 61610, 61614, 61632, 61637, 61640, 61642, 61647, 61652, 61657, 61659, 61664, 61669, 61674,
+	61679, 61684, 61689, 61694, 61706, 61721, 61724, 61738, 61745, 61763, 61774, 61789, 61804, 61819, 61827, 61838,
+	61853, 61863, 61872, 61875, 61889, 61895, 61901, 61907, 61913, 61919, 61925, 61931, 61937, 61943, 61949, 61955,
+	61961, 61967, 61973, 61979, 61985, 61991, 61997, 62003, 62009, 62015, 62021, 62027, 62033, 62039, 62045, 62051,
+	62057, 62063, 62069, 62075, 62081, 62087, 62093, 62103, 62109, 62115, 62121, 62127, 62133, 62139, 62145, 62151,
+	62157, 62163, 62169, 62175, 62181, 62187, 62193, 62199, 62205, 62211, 62217, 62223, 62229, 62235, 62241, 62247,
+	62253, 62259, 62265, 62271, 62277, 62283, 62289, 62295, 62301, 62307, 62313, 62319, 62325, 62331, 62337, 62343,
+	62349, 62355, 62361, 62367, 62373, 62379, 62385, 62391, 62397, 62403, 62409, 62415, 62421, 62427, 62433, 62439,
+	62445, 62451, 62457, 62463, 62469, 62475, 62481, 62487, 62493, 62499, 62505, 62511, 62517, 62523, 62529, 62535,
+	62541, 62547, 62553, 62559, 62565, 62571, 62577, 62583, 62589, 62595, 62601, 62607, 62613, 62619, 62625, 62631,
+	62637, 62643, 62649, 62655, 62661, 62667, 62673, 62679, 62685, 62691, 62697, 62703, 62709, 62716, 62723, 62734,
+	62741, 62753, 62760, 62763, 62782, 62798, 62814, 62828, 62832, 62839, 62855, 62869, 62873, 62883, 62888, 62905,
+	62907, 62912, 62915, 62925, 62936, 62938, 62947, 62950, 62956, 62962, 62968, 62974, 62982, 62995, 63000, 63003,
+	63013, 63019, 63021, 63027, 63033, 63039, 63059, 63078, 63082, 63088, 63101, 63117, 63127, 63145, 63161, 63181,
+	63197, 63213, 63228, 63232, 63248, 63257, 63273, 63284, 63289, 63294, 63309, 63329, 63350, 63356, 63372, 63386,
+	63408, 63426, 63449, 63467, 63487, 63508, 63528, 63548, 63568, 63593, 63614, 63635, 63655, 63680, 63703, 63718,
+	63741, 63759, 63777, 63783, 63789, 63800, 63816, 63826, 63837, 63856, 63861, 63886, 63888, 63916, 63921, 63926,
+	63935, 63940, 63945, 63950, 63952, 63961, 63969, 63980, 63990, 63999, 64008, 64014, 64023, 64028, 64047, 64070,
+	64080, 64093, 64106, 64129, 64155, 64181, 64207, 64220, 64237, 64252, 64268, 64275, 64284, 64291, 64298, 64303,
+	64309, 64315, 64321, 64327, 64333, 64339, 64345, 64351, 64357, 64363, 64369, 64375, 64381, 64387, 64393, 64399,
+	64405, 64411, 64420, 64427, 64434, 64450, 64470, 64478, 64485, 64499, 64511, 64523, 64533, 64561, 64572, 64586,
+	64598, 64614, 64628, 64647, 64659, 64673, 64691, 64697, 64710, 64726, 64742, 64748, 64759, 64770, 64785, 64796,
+	64812, 64823, 64840, 64851, 64857, 64863, 64872, 64885, 64888, 64899, 64910, 64921, 64927, 64938, 64944, 64949,
+	64970, 64972, 64974, 64977, 64980, 64990, 64995, 65001, 65014, 65020, 65030, 65035, 65040, 65049, 65054, 65059,
+	65068, 65074, 65077, 65079, 65084, 65089, 65104, 65122, 65140, 65148, 65153, 65158, 65183, 65208, 65213, 65220,
+	65227, 65230, 65236, 65246, 65257, 65264, 65280, 65282, 65295, 65304, 65316, 65335, 65345, 65358, 65362, 65366,
+	65388, 65404, 65418, 65435, 65456, 65459, 65478, 65495, 65505, 65513, 65525, 65540, 65560, 65582, 65606, 65619,
+	65639, 65659, 65679, 65684, 65698, 65717, 65724, 65732, 65741, 65747, 65761, 65766, 65771, 65776, 65782, 65788,
+	65805, 65821, 65832, 65848, 65853, 65858, 65870, 65883, 65899, 65917, 65931, 65947, 65962, 65978, 65995, 66006,
+	66022, 66034, 66050, 66066, 66081, 66098, 66115, 66120, 66134, 66149, 66161, 66176, 66192, 66210, 66229, 66243,
+	66259, 66270, 66289, 66305, 66312, 66324, 66339, 66350, 66366, 66371, 66389, 66405, 66419, 66434, 66444, 66462,
+	66478, 66496, 66513, 66527, 66534, 66544, 66562, 66583, 66601, 66610, 66629, 66647, 66663, 66668, 66676, 66682,
+	66693, 66708, 66722, 66729, 66738, 66747, 66751, 66761, 66763, 66779, 66793, 66813, 66825, 66838, 66850, 66855,
+	66862, 66875, 66889, 66905, 66925, 66943, 66959, 66970, 66990, 67007, 67023, 67034, 67048, 67057, 67073, 67082,
+	67095, 67104, 67109, 67126, 67139, 67164, 67182, 67185, 67198, 67214, 67217, 67223, 67238, 67243, 67258, 67261,
+	67267, 67280, 67289, 67304, 67316, 67332, 67344, 67360, 67381, 67393, 67402, 67414, 67428, 67441, 67463, 67472,
+	67488, 67491, 67498, 67515, 67519, 67533, 67550, 67565, 67579, 67589, 67597, 67606, 67617, 67629, 67634, 67639,
+	67644, 67649, 67654, 67659, 67664, 67669, 67674, 67679, 67684, 67689, 67694, 67699, 67704, 67709, 67721, 67727,
+	67733, 67747, 67760, 67778, 67781, 67787, 67793, 67799, 67805, 67811, 67817, 67823, 67829, 67835, 67841, 67847,
+	67853, 67859, 67865, 67871, 67877, 67883, 67889, 67895, 67901, 67907, 67913, 67919, 67925, 67931, 67937, 67943,
+	67949, 67955, 67961, 67967, 67973, 67979, 67985, 67991, 67997, 68003, 68009, 68015, 68021, 68027, 68033, 68039,
+	68045, 68051, 68057, 68063, 68069, 68075, 68081, 68087, 68093, 68099, 68105, 68111, 68117, 68123, 68129, 68135,
+	68141, 68147, 68153, 68159, 68165, 68171, 68177, 68183, 68189, 68195, 68201, 68207, 68213, 68219, 68225, 68231,
+	68237, 68243, 68249, 68255, 68261, 68267, 68273, 68279, 68285, 68291, 68297, 68303, 68309, 68315, 68321, 68327,
+	68333, 68339, 68345, 68351, 68357, 68363, 68369, 68375, 68381, 68387, 68393, 68399, 68405, 68411, 68417, 68423,
+	68429, 68435, 68441, 68447, 68453, 68459, 68465, 68471, 68477, 68483, 68489, 68495, 68501, 68507, 68513, 68519,
+	68525, 68531, 68537, 68543, 68549, 68555, 68561, 68567, 68573, 68579, 68585, 68591, 68597, 68603, 68609, 68615,
+	68621, 68627, 68633, 68639, 68645, 68651, 68657, 68663, 68669, 68675, 68681, 68687, 68693, 68699, 68705, 68712,
+	68719, 68730, 68741, 68750, 68759, 68770, 68779, 68787, 68794, 68807, 68822, 68827, 68835, 68855, 68870, 68896,
+	68905, 68912, 68929, 68944, 68963, 68982, 68995, 69002, 69011, 69021, 69032, 69046, 69056, 69068, 69080, 69093,
+	69103, 69108, 69120, 69132, 69144, 69162, 69176, 69191, 69207, 69225, 69232, 69247, 69264, 69270, 69278, 69292,
+	69309, 69328, 69349, 69363, 69376, 69392, 69398, 69413, 69432, 69435, 69448, 69460, 69470, 69486, 69505, 69517,
+	69520, 69533, 69548, 69567, 69573, 69582, 69597, 69606, 69611, 69620, 69633, 69638, 69644, 69659, 69675, 69693,
+	69700, 69711, 69724, 69740, 69745, 69762, 69774, 69789, 69793, 69797, 69799, 69808, 69817, 69822, 69831, 69836,
+	69851, 69869, 69896, 69916, 69930, 69946, 69955, 69963, 69967, 69983, 69992, 70016, 70036, 70054, 70076, 70086,
+	70099, 70111, 70121, 70130, 70148, 70161, 70170, 70178, 70193, 70209, 70225, 70236, 70247, 70252, 70264, 70276,
+	70289, 70297, 70313, 70317, 70325, 70333, 70338, 70343, 70358, 70367, 70393, 70402, 70410, 70413, 70425, 70430,
+	70439, 70448, 70453, 70458, 70462, 70473, 70478, 70487, 70489, 70494, 70503, 70508, 70513, 70522, 70531, 70540,
+	70548, 70556, 70565, 70571, 70577, 70583, 70591, 70599, 70607, 70615, 70630, 70640, 70656, 70671, 70681, 70684,
+	70686, 70692, 70694, 70699, 70704, 70714, 70723, 70725, 70738, 70752, 70760, 70775, 70778, 70797, 70820, 70836,
+	70843, 70857, 70862, 70867, 70878, 70896, 70902, 70917, 70923, 70932, 70937, 70946, 70957, 70961, 70967, 70975,
+	70978, 70989, 71000, 71010, 71017, 71027, 71040, 71052, 71055, 71066, 71070, 71074, 71083, 71090, 71107, 71114,
+	71124, 71131, 71148, 71158, 71170, 71175, 71177, 71179, 71188, 71193, 71198, 71216, 71233, 71246, 71259, 71274,
+	71289, 71304, 71315, 71319, 71330, 71351, 71363, 71372, 71378, 71384, 71394, 71405, 71411, 71419, 71435, 71439,
+	71441, 71447, 71449, 71454, 71457, 71466, 71471, 71477, 71487, 71498, 71509, 71520, 71524, 71538, 71548, 71559,
+	71564, 71575, 71581, 71587, 71593, 71602, 71613, 71620, 71632, 71642, 71654, 71664, 71676, 71685, 71697, 71701,
+	71713, 71724, 71736, 71749, 71756, 71769, 71784, 71801, 71805, 71812, 71828, 71863, 71874, 71884, 71893, 71902,
+	71907, 71912, 71917, 71922, 71928, 71930, 71939, 71944, 71953, 71958, 71967, 71975, 71980, 71986, 71992, 71998,
+	72016, 72035, 72050, 72055, 72065, 72073, 72081, 72093, 72101, 72110, 72119, 72128, 72134, 72139, 72153, 72164,
+	72171, 72178, 72202, 72209, 72216, 72223, 72248, 72276, 72279, 72285, 72291, 72297, 72303, 72309, 72315, 72321,
+	72327, 72333, 72339, 72345, 72351, 72367, 72373, 72386, 72390, 72405, 72416, 72420, 72427, 72441, 72452, 72466,
+	72471, 72481, 72489, 72499, 72510, 72513, 72523, 72534, 72544, 72556, 72558, 72564, 72570, 72580, 72591, 72599,
+	72601, 72610, 72615, 72620, 72623, 72646, 72652, 72659, 72676, 72689, 72705, 72717, 72727, 72736, 72742, 72751,
+	72760, 72768, 72778, 72785, 72796, 72801, 72807, 72813, 72826, 72828, 72831, 72833, 72839, 72852, 72860, 72878,
+	72883, 72893, 72897, 72899, 72906, 72916, 72921, 72927, 72933, 72938, 72944, 72954, 72964, 72971, 72975, 72981,
+	72996, 73002, 73015, 73020, 73034, 73050, 73063, 73067, 73078, 73082, 73088, 73093, 73099, 73111, 73123, 73130,
+	73135, 73143, 73151, 73159, 73167, 73175, 73184, 73202, 73226, 73247, 73256, 73270, 73279, 73294, 73308, 73319,
+	73327, 73339, 73350, 73357, 73369, 73389, 73406, 73412, 73418, 73426, 73439, 73456, 73466, 73477, 73487, 73500,
+	73511, 73516, 73522, 73524, 73529, 73534, 73538, 73552, 73566, 73580, 73592, 73602, 73615, 73623, 73630, 73636,
+	73653, 73659, 73665, 73671, 73677, 73683, 73692, 73701, 73708, 73723, 73726, 73732, 73746, 73754, 73765, 73771,
+	73789, 73805, 73822, 73837, 73842, 73847, 73853, 73857, 73866, 73872, 73878, 73886, 73898, 73901, 73912, 73922,
+	73933, 73945, 73949, 73956, 73962, 73968, 73974, 73986, 73993, 74001, 74007, 74017, 74025, 74031, 74044, 74059,
+	74070, 74094, 74121, 74149, 74167, 74185, 74199, 74216, 74220, 74230, 74243, 74251, 74257, 74264, 74274, 74281,
+	74286, 74297, 74302, 74307, 74318, 74343, 74347, 74356, 74361, 74369, 74383, 74390, 74411, 74433, 74436, 74445,
+	74454, 74464, 74476, 74488, 74491, 74497, 74509, 74528, 74539, 74542, 74561, 74584, 74592, 74600, 74609, 74623,
+	74631, 74640, 74649, 74654, 74659, 74668, 74673, 74682, 74684, 74693, 74702, 74707, 74712, 74721, 74728, 74734,
+	74750, 74764, 74769, 74779, 74784, 74792, 74798, 74816, 74825, 74832, 74843, 74850, 74858, 74868, 74872, 74880,
+	74887, 74902, 74919, 74928, 74936, 74955, 74964, 74974, 74983, 74992, 74994, 74999, 75008, 75017, 75030, 75039,
+	75043, 75045, 75051, 75060, 75066, 75071, 75075, 75078, 75085, 75094, 75107, 75112, 75114, 75117, 75126, 75144,
+	75152, 75170, 75190, 75196, 75210, 75220, 75231, 75247, 75256, 75264, 75274, 75286, 75296, 75307, 75316, 75324,
+	75334, 75345, 75349, 75353, 75384, 75415, 75442, 75473, 75504, 75533, 75562, 75588, 75614, 75640, 75667, 75702,
+	75728, 75754, 75784, 75810, 75836, 75875, 75914, 75953, 75987, 76021, 76055, 76089, 76123, 76162, 76197, 76234,
+	76271, 76305, 76339, 76366, 76393, 76420, 76454, 76470, 76501, 76528, 76557, 76587, 76628, 76669, 76706, 76747,
+	76788, 76827, 76866, 76902, 76938, 76974, 77010, 77046, 77082, 77111, 77137, 77163, 77182, 77201, 77220, 77234,
+	77260, 77266, 77268, 77298, 77303, 77309, 77314, 77323, 77332, 77341, 77346, 77354, 77368, 77377, 77392, 77396,
+	77402, 77413, 77420, 77432, 77449, 77471, 77489, 77503, 77521, 77534, 77551, 77561, 77579, 77596, 77613, 77630,
+	77644, 77659, 77675, 77689, 77705, 77719, 77741, 77757, 77775, 77790, 77810, 77824, 77840, 77846, 77861, 77878,
+	77899, 77914, 77928, 77939, 77947, 77951, 77963, 77973, 77978, 77988, 77996, 78007, 78026, 78041, 78062, 78074,
+	78082, 78090, 78097, 78117, 78139, 78153, 78164, 78188, 78213, 78230, 78243, 78258, 78268, 78283, 78299, 78308,
+	78315, 78330, 78340, 78359, 78372, 78388, 78403, 78420, 78435, 78441, 78449, 78468, 78484, 78498, 78511, 78524,
+	78540, 78554, 78565, 78575, 78578, 78588, 78594, 78605, 78616, 78620, 78638, 78650, 78671, 78674, 78693, 78710,
+	78728, 78747, 78757, 78775, 78801, 78817, 78828, 78836, 78847, 78864, 78868, 78888, 78897, 78901, 78916, 78933,
+	78946, 78948, 78953, 78956, 78959, 78961, 78966, 78975, 78980, 78985, 78994, 78999, 79004, 79009, 79014, 79017,
+	79036, 79039, 79045, 79051, 79057, 79063, 79069, 79075, 79081, 79087, 79093, 79099, 79105, 79111, 79117, 79123,
+	79129, 79139, 79158, 79165, 79183, 79197, 79215, 79227, 79233, 79246, 79255, 79264, 79273, 79285, 79303, 79309,
+	79326, 79340, 79347, 79361, 79367, 79374, 79396, 79410, 79434, 79459, 79479, 79500, 79521, 79536, 79561, 79575,
+	79579, 79594, 79611, 79623, 79634, 79636, 79641, 79646, 79656, 79667, 79669, 79678, 79687, 79696, 79702, 79716,
+	79722, 79726, 79739, 79745, 79754, 79763, 79769, 79777, 79796, 79800, 79806, 79816, 79833, 79847, 79863, 79880,
+	79894, 79902, 79921, 79933, 79951, 79970, 79976, 79984, 79990, 80000, 80010, 80021, 80031, 80041, 80049, 80059,
+	80071, 80089, 80104, 80116, 80133, 80149, 80165, 80174, 80183, 80188, 80203, 80217, 80233, 80241, 80258, 80280,
+	80292, 80304, 80316, 80329, 80342, 80364, 80381, 80393, 80405, 80417, 80430, 80443, 80460, 80473, 80488, 80500,
+	80518, 80530, 80542, 80555, 80569, 80580, 80596, 80612, 80626, 80637, 80645, 80658, 80675, 80688, 80707, 80724,
+	80739, 80754, 80767, 80772, 80775, 80778, 80784, 80790, 80794, 80799, 80802, 80804, 80829, 80831, 80836, 80847,
+	80867, 80876, 80893, 80898, 80902, 80907, 80919, 80936, 80940, 80957, 80975, 80995, 81015, 81033, 81049, 81072,
+	81092, 81107, 81121, 81133, 81141, 81159, 81179, 81196, 81215, 81232, 81251, 81268, 81284, 81304, 81311, 81323,
+	81344, 81363, 81384, 81402, 81412, 81430, 81449, 81468, 81487, 81507, 81525, 81545, 81562, 81580, 81601, 81622,
+	81640, 81664, 81683, 81704, 81725, 81743, 81765, 81781, 81798, 81813, 81831, 81852, 81866, 81883, 81901, 81918,
+	81942, 81960, 81984, 82002, 82017, 82037, 82057, 82081, 82099, 82117, 82134, 82155, 82177, 82194, 82210, 82231,
+	82250, 82269, 82290, 82311, 82326, 82345, 82362, 82382, 82404, 82418, 82433, 82449, 82463, 82483, 82494, 82514,
+	82534, 82539, 82556, 82574, 82589, 82593, 82608, 82615, 82622, 82629, 82636, 82643, 82655, 82669, 82677, 82691,
+	82705, 82711, 82715, 82724, 82742, 82756, 82774, 82782, 82801, 82807, 82809, 82818, 82823, 82837, 82843, 82853,
+	82862, 82872, 82880, 82889, 82900, 82909, 82913, 82924, 82938, 82952, 82969, 82982, 82995, 82999, 83010, 83017,
+	83028, 83037, 83041, 83060, 83075, 83087, 83104, 83106, 83112, 83114, 83119, 83128, 83137, 83148, 83156, 83166,
+	83182, 83185, 83195, 83201, 83209, 83219, 83230, 83234, 83245, 83253, 83264, 83276, 83285, 83293, 83303, 83313,
+	83322, 83334, 83337, 83351, 83365, 83370, 83380, 83392, 83394, 83402, 83412, 83418, 83424, 83430, 83435, 83444,
+	83450, 83452, 83457, 83470, 83475, 83498, 83503, 83511, 83523, 83532, 83534, 83539, 83548, 83556, 83560, 83573,
+	83582, 83595, 83605, 83614, 83616, 83625, 83630, 83649, 83655, 83661, 83673, 83679, 83687, 83693, 83707, 83725,
+	83741, 83749, 83757, 83770, 83780, 83788, 83792, 83807, 83822, 83832, 83839, 83844, 83858, 83867, 83876, 83893,
+	83901, 83915, 83925, 83929, 83941, 83953, 83957, 83973, 83981, 83994, 84005, 84016, 84031, 84041, 84052, 84063,
+	84074, 84082, 84088, 84101, 84113, 84134, 84146, 84166, 84182, 84197, 84200, 84209, 84214, 84223, 84226, 84231,
+	84248, 84260, 84271, 84277, 84283, 84291, 84299, 84308, 84326, 84350, 84363, 84368, 84376, 84380, 84390, 84402,
+	84415, 84425, 84435, 84446, 84448, 84457, 84462, 84471, 84474, 84480, 84482, 84487, 84514, 84516, 84521, 84529,
+	84550, 84555, 84567, 84583, 84600, 84615, 84630, 84639, 84646, 84650, 84665, 84676, 84692, 84708, 84715, 84727,
+	84736, 84745, 84767, 84779, 84796, 84807, 84839, 84852, 84866, 84878, 84888, 84891, 84899, 84911, 84928, 84938,
+	84950, 84970, 84977, 84989, 84998, 85011, 85022, 85040, 85052, 85071, 85080, 85096, 85105, 85114, 85128, 85135,
+	85158, 85166, 85176, 85181, 85189, 85197, 85205, 85213, 85221, 85229, 85244, 85256, 85266, 85285, 85294, 85304,
+	85310, 85323, 85339, 85350, 85358, 85370, 85376, 85381, 85397, 85412, 85427, 85443, 85448, 85450, 85460, 85466,
+	85471, 85485, 85497, 85514, 85531, 85547, 85560, 85574, 85595, 85610, 85617, 85626, 85633, 85648, 85663, 85678,
+	85696, 85703, 85723, 85730, 85740, 85751, 85762, 85773, 85785, 85797, 85805, 85811, 85818, 85822, 85841, 85848,
+	85859, 85872, 85887, 85905, 85919, 85929, 85945, 85961, 85974, 85980, 85982, 85987, 85998, 86012, 86020, 86030,
+	86045, 86057, 86063, 86076, 86091, 86102, 86112, 86125, 86139, 86144, 86146, 86151, 86153, 86159, 86172, 86184,
+	86195, 86205, 86228, 86242, 86253, 86263, 86270, 86279, 86281, 86292, 86303, 86310, 86319, 86328, 86338, 86347,
+	86356, 86371, 86385, 86397, 86409, 86425, 86436, 86448, 86457, 86469, 86473, 86477, 86489, 86494, 86500, 86503,
+	86524, 86540, 86559, 86579, 86597, 86613, 86624, 86641, 86657, 86674, 86691, 86706, 86719, 86736, 86753, 86765,
+	86782, 86788, 86803, 86818, 86833, 86853, 86867, 86882, 86896, 86911, 86924, 86940, 86959, 86976, 86993, 87013,
+	87033, 87053, 87070, 87089, 87109, 87127, 87147, 87168, 87185, 87204, 87221, 87238, 87256, 87274, 87291, 87307,
+	87325, 87343, 87359, 87376, 87394, 87414, 87435, 87449, 87468, 87479, 87499, 87514, 87534, 87549, 87562, 87574,
+	87578, 87591, 87612, 87625, 87642, 87659, 87681, 87698, 87712, 87719, 87734, 87752, 87773, 87784, 87795, 87812,
+	87818, 87830, 87847, 87857, 87863, 87871, 87884, 87897, 87918, 87924, 87934, 87944, 87955, 87965, 87970, 87990,
+	88009, 88026, 88044, 88060, 88077, 88083, 88101, 88104, 88108, 88115, 88117, 88122, 88125, 88135, 88142, 88144,
+	88147, 88152, 88157, 88173, 88177, 88183, 88192, 88198, 88202, 88213, 88223, 88240, 88253, 88256, 88262, 88276,
+	88287, 88297, 88308, 88332, 88349, 88356, 88368, 88383, 88397, 88409, 88426, 88444, 88459, 88465, 88478, 88484,
+	88493, 88502, 88510, 88521, 88542, 88555, 88562, 88569, 88573, 88585, 88599, 88601, 88603, 88611, 88613, 88616,
+	88622, 88629, 88643, 88650, 88660, 88673, 88681, 88698, 88710, 88714, 88721, 88731, 88737, 88744, 88755, 88762,
+	88780, 88791, 88798, 88802, 88807, 88812, 88814, 88819, 88831, 88837, 88845, 88848, 88857, 88859, 88865, 88873,
+	88875, 88887, 88889, 88894, 88899, 88904, 88909, 88914, 88919, 88924, 88929, 88934, 88939, 88944, 88949, 88954,
+	88960, 88962, 88967, 88979, 88984, 88986, 88995, 89000, 89005, 89014, 89019, 89030, 89043, 89062, 89080, 89090,
+	89108, 89125, 89140, 89145, 89158, 89172, 89191, 89206, 89225, 89237, 89250, 89266, 89283, 89300, 89315, 89329,
+	89345, 89365, 89385, 89397, 89416, 89424, 89441, 89462, 89474, 89496, 89517, 89522, 89530, 89538, 89559, 89577,
+	89591, 89605, 89626, 89640, 89656, 89673, 89689, 89706, 89723, 89739, 89760, 89775, 89786, 89799, 89816, 89827,
+	89840, 89845, 89858, 89861, 89876, 89893, 89898, 89915, 89924, 89937, 89951, 89961, 89967, 89980, 90000, 90010,
+	90017, 90033, 90045, 90050, 90060, 90078, 90090, 90100, 90110, 90120, 90125, 90141, 90145, 90156, 90176, 90192,
+	90210, 90219, 90235, 90248, 90264, 90282, 90299, 90316, 90331, 90346, 90362, 90379, 90385, 90400, 90419, 90439,
+	90457, 90476, 90480, 90498, 90507, 90512, 90514, 90523, 90528, 90533, 90539, 90544, 90555, 90562, 90574, 90590,
+	90607, 90619, 90627, 90635, 90644, 90668, 90692, 90714, 90736, 90745, 90759, 90774, 90790, 90803, 90814, 90825,
+	90836, 90846, 90856, 90862, 90871, 90880, 90889, 90902, 90911, 90929, 90935, 90946, 90966, 90985, 91006, 91026,
+	91047, 91067, 91086, 91104, 91126, 91147, 91166, 91184, 91205, 91225, 91247, 91268, 91290, 91311, 91322, 91340,
+	91357, 91378, 91398, 91416, 91433, 91453, 91472, 91493, 91513, 91534, 91554, 91573, 91591, 91611, 91630, 91650,
+	91669, 91678, 91688, 91698, 91709, 91720, 91731, 91741, 91755, 91764, 91773, 91783, 91795, 91800, 91812, 91826,
+	91829, 91845, 91862, 91871, 91880, 91892, 91897, 91903, 91917, 91923, 91931, 91941, 91959, 91978, 91998, 92018,
+	92034, 92051, 92068, 92089, 92107, 92125, 92143, 92158, 92175, 92192, 92206, 92223, 92241, 92257, 92280, 92299,
+	92319, 92336, 92353, 92373, 92391, 92409, 92411, 92416, 92420, 92423, 92430, 92449, 92454, 92462, 92468, 92474,
+	92482, 92490, 92502, 92512, 92517, 92524, 92537, 92539, 92544, 92549, 92554, 92559, 92564, 92569, 92574, 92579,
+	92584, 92589, 92594, 92608, 92611, 92613, 92618, 92627, 92637, 92647, 92652, 92660, 92668, 92677, 92690, 92701,
+	92710, 92719, 92729, 92731, 92743, 92747, 92764, 92768, 92786, 92798, 92800, 92805, 92810, 92826, 92836, 92848,
+	92851, 92870, 92885, 92905, 92923, 92928, 92939, 92945, 92953, 92965, 92979, 92992, 93013, 93023, 93025, 93034,
+	93045, 93057, 93069, 93081, 93097, 93115, 93117, 93123, 93132, 93137, 93142, 93148, 93160, 93177, 93193, 93204,
+	93219, 93235, 93248, 93261, 93271, 93279, 93287, 93296, 93310, 93316, 93325, 93339, 93350, 93367, 93375, 93390,
+	93398, 93409, 93415, 93425, 93432, 93439, 93449, 93466, 93476, 93487, 93503, 93506, 93523, 93540, 93543, 93560,
+	93574, 93579, 93599, 93606, 93617, 93625, 93636, 93639, 93658, 93675, 93693, 93709, 93725, 93741, 93756, 93771,
+	93779, 93785, 93793, 93804, 93820, 93836, 93838, 93855, 93872, 93889, 93906, 93929, 93959, 93989, 94023, 94053,
+	94083, 94088, 94094, 94103, 94112, 94117, 94122, 94127, 94133, 94147, 94163, 94173, 94184, 94201, 94214, 94236,
+	94252, 94275, 94293, 94300, 94318, 94332, 94345, 94361, 94375, 94406, 94421, 94438, 94454, 94468, 94490, 94507,
+	94515, 94530, 94543, 94548, 94559, 94580, 94589, 94604, 94619, 94628, 94649, 94659, 94670, 94679, 94695, 94701,
+	94703, 94709, 94711, 94716, 94727, 94737, 94748, 94752, 94756, 94758, 94763, 94768, 94773, 94783, 94792, 94800,
+	94809, 94816, 94824, 94833, 94843, 94857, 94870, 94883, 94895, 94911, 94927, 94941, 94957, 94972, 94980, 94993,
+	95008, 95022, 95037, 95054, 95068, 95083, 95096, 95112, 95130, 95145, 95161, 95165, 95172, 95180, 95193, 95204,
+	95210, 95221, 95234, 95242, 95251, 95260, 95270, 95278, 95283, 95285, 95290, 95296, 95298, 95303, 95311, 95318,
+	95330, 95335, 95348, 95357, 95363, 95372, 95382, 95391, 95402, 95410, 95421, 95431, 95441, 95452, 95461, 95469,
+	95486, 95504, 95513, 95522, 95539, 95547, 95559, 95568, 95577, 95587, 95603, 95618, 95630, 95644, 95647, 95653,
+	95667, 95681, 95693, 95698, 95733, 95767, 95806, 95845, 95884, 95919, 95958, 95997, 96034, 96071, 96106, 96141,
+	96175, 96209, 96243, 96278, 96312, 96346, 96380, 96414, 96448, 96450, 96456, 96458, 96463, 96468, 96474, 96490,
+	96505, 96511, 96521, 96531, 96541, 96546, 96554, 96557, 96563, 96569, 96582, 96590, 96604, 96610, 96613, 96626,
+	96636, 96648, 96651, 96669, 96683, 96689, 96704, 96719, 96733, 96749, 96763, 96777, 96781, 96787, 96797, 96801,
+	96810, 96814, 96820, 96830, 96847, 96861, 96875, 96886, 96897, 96904, 96921, 96923, 96928, 96937, 96942, 96950,
+	96968, 96978, 96988, 96993, 97001, 97016, 97023, 97037, 97041, 97047, 97051, 97057, 97063, 97073, 97083, 97095,
+	97107, 97120, 97125, 97130, 97150, 97155, 97160, 97162, 97168, 97184, 97189, 97195, 97207, 97216, 97227, 97236,
+	97253, 97271, 97281, 97285, 97289, 97294, 97303, 97312, 97317, 97331, 97345, 97364, 97382, 97397, 97414, 97425,
+	97445, 97460, 97480, 97496, 97508, 97513, 97525, 97540, 97547, 97553, 97557, 97564, 97580, 97590, 97607, 97616,
+	97627, 97646, 97661, 97680, 97694, 97710, 97715, 97737, 97757, 97764, 97773, 97779, 97794, 97799, 97804, 97811,
+	97825, 97832, 97838, 97844, 97850, 97856, 97862, 97868, 97874, 97880, 97886, 97892, 97898, 97904, 97910, 97916,
+	97922, 97927, 97938, 97948, 97959, 97968, 97978, 97988, 98001, 98011, 98026, 98041, 98048, 98071, 98085, 98097,
+	98143, 98188, 98238, 98288, 98338, 98384, 98434, 98484, 98532, 98580, 98626, 98672, 98717, 98762, 98807, 98853,
+	98898, 98943, 98988, 99033, 99078, 99085, 99096, 99107, 99118, 99138, 99147, 99152, 99156, 99175, 99191, 99214,
+	99228, 99258, 99274, 99301, 99303, 99317, 99329, 99349, 99369, 99376, 99381, 99390, 99394, 99411, 99421, 99432,
+	99436, 99443, 99450, 99465, 99476, 99495, 99498, 99509, 99524, 99531, 99535, 99542, 99553, 99564, 99578, 99593,
+	99603, 99614, 99622, 99634, 99645, 99648, 99659, 99668, 99680, 99690, 99703, 99719, 99727, 99731, 99742, 99759,
+	99775, 99780, 99790, 99805, 99810, 99823, 99826, 99840, 99859, 99875, 99886, 99898, 99916, 99923, 99933, 99935,
+	99940, 99950, 99961, 99964, 99967, 99978, 99983, 99989, 99995, 100000, 100013, 100017, 100036, 100040, 100047, 100051,
+	100064, 100069, 100076, 100082, 100085, 100090, 100099, 100111, 100116, 100119, 100124, 100127, 100132, 100136, 100140, 100153,
+	100172, 100190, 100206, 100224, 100230, 100246, 100261, 100278, 100283, 100299, 100316, 100333, 100351, 100368, 100390, 100398,
+	100415, 100430, 100446, 100464, 100480, 100499, 100510, 100528, 100548, 100560, 100580, 100598, 100621, 100633, 100655, 100675,
+	100695, 100713, 100732, 100750, 100768, 100790, 100810, 100832, 100839, 100854, 100869, 100888, 100908, 100914, 100929, 100941,
+	100955, 100971, 100988, 101004, 101017, 101032, 101049, 101066, 101081, 101099, 101121, 101138, 101153, 101172, 101187, 101196,
+	101205, 101215, 101226, 101235, 101237, 101242, 101247, 101253, 101262, 101266, 101284, 101300, 101313, 101332, 101347, 101363,
+	101371, 101382, 101406, 101426, 101441, 101458, 101470, 101478, 101496, 101515, 101532, 101551, 101571, 101586, 101602, 101613,
+	101629, 101641, 101656, 101674, 101693, 101713, 101731, 101746, 101765, 101782, 101801, 101804, 101816, 101823, 101825, 101842,
+	101854, 101873, 101893, 101908, 101928, 101931, 101947, 101965, 101982, 102000, 102016, 102032, 102049, 102053, 102057, 102062,
+	102068, 102074, 102080, 102092, 102100, 102111, 102127, 102144, 102157, 102171, 102186, 102192, 102201, 102210, 102214, 102222,
+	102240, 102244, 102252, 102264, 102275, 102286, 102289, 102294, 102300, 102302, 102311, 102315, 102332, 102346, 102355, 102360,
+	102365, 102372, 102389, 102391, 102406, 102419, 102428, 102438, 102450, 102456, 102467, 102478, 102486, 102494, 102503, 102509,
+	102519, 102528, 102538, 102548, 102558, 102567, 102582, 102596, 102604, 102616, 102633, 102648, 102652, 102656, 102667, 102671,
+	102677, 102690, 102698, 102702, 102717, 102731, 102737, 102750, 102765, 102778, 102784, 102790, 102802, 102815, 102828, 102839,
+	102851, 102857, 102870, 102883, 102894, 102900, 102904, 102912, 102931, 102946, 102959, 102965, 102971, 102984, 102993, 102999,
+	103005, 103028, 103049, 103068, 103078, 103097, 103114, 103120, 103132, 103146, 103158, 103170, 103184, 103198, 103214, 103230,
+	103246, 103262, 103276, 103288, 103298, 103310, 103320, 103333, 103343, 103356, 103364, 103374, 103382, 103392, 103400, 103410,
+	103420, 103430, 103438, 103446, 103452, 103460, 103472, 103478, 103492, 103498, 103504, 103516, 103534, 103542, 103552, 103566,
+	103580, 103588, 103597, 103606, 103618, 103636, 103651, 103666, 103681, 103693, 103705, 103717, 103729, 103741, 103762, 103780,
+	103813, 103828, 103840, 103855, 103867, 103876, 103898, 103926, 103954, 103979, 104007, 104029, 104038, 104047, 104053, 104062,
+	104074, 104086, 104098, 104104, 104113, 104122, 104140, 104152, 104161, 104167, 104176, 104182, 104188, 104194, 104203, 104212,
+	104221, 104227, 104236, 104242, 104248, 104257, 104270, 104276, 104282, 104288, 104297, 104306, 104319, 104325, 104334, 104346,
+	104355, 104361, 104367, 104379, 104385, 104391, 104397, 104406, 104412, 104427, 104433, 104442, 104451, 104457, 104466, 104475,
+	104484, 104490, 104499, 104508, 104514, 104523, 104532, 104541, 104550, 104559, 104568, 104577, 104586, 104592, 104601, 104607,
+	104616, 104625, 104634, 104640, 104649, 104655, 104661, 104667, 104673, 104682, 104695, 104704, 104713, 104726, 104735, 104744,
+	104750, 104756, 104762, 104768, 104777, 104786, 104795, 104804, 104810, 104819, 104825, 104831, 104837, 104846, 104855, 104867,
+	104876, 104885, 104894, 104903, 104909, 104918, 104927, 104936, 104949, 104958, 104967, 104976, 104989, 104998, 105007, 105016,
+	105025, 105037, 105043, 105049, 105055, 105064, 105070, 105079, 105088, 105097, 105103, 105109, 105118, 105124, 105130, 105136,
+	105145, 105154, 105160, 105172, 105181, 105190, 105199, 105205, 105211, 105220, 105232, 105238, 105247, 105256, 105268, 105274,
+	105280, 105286, 105292,
+}
+
+var builtinFlags = []uint8{
+	1, 9, 9, 10, 9, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 9, 9,
+	9, 9, 1, 9, 9, 1, 9, 9, 9, 9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 9, 9, 9, 9, 1, 1, 1, 1, 1, 10, 9, 9,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9, 1, 1, 9, 9, 9, 1, 9, 1, 9, 9,
+	9, 9, 9, 9, 9, 1, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 9, 1, 9, 9, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 9, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 10, 9, 9, 1, 9, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 9, 9, 9, 9, 10, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 9,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 9, 1, 2, 1, 1, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 9, 9, 9, 1, 9, 1,
+	1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 1, 9,
+	9, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 9, 9, 9, 1, 1, 1, 1, 9, 9, 9, 1, 9,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 10, 10, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9,
+	1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 9,
+	9, 1, 1, 1, 9, 1, 1, 9, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 9, 9, 1, 1, 1,
+	9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 9,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 4, 4, 4, 4, 4, 4, 4, 1, 1, 1, 1, 1, 2, 1, 1, 1,
+	1, 9, 1, 9, 9, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 10, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 1, 9, 1, 9,
+	10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 1, 1, 9, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 9, 9, 1, 1, 9, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 10, 9, 1, 9, 1, 1, 1, 9, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 10,
+	10, 10, 10, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 9, 9, 9, 9, 9, 9, 9, 10, 9, 9, 9, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 9, 9, 1, 1, 9,
+	1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9, 1, 9, 10, 1, 1, 10, 1, 1, 1, 1, 1,
+	9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 1,
+	1, 1, 1, 1, 9, 9, 9, 1, 9, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 9, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 10, 9, 10, 1, 9, 9, 10, 10, 9, 9, 1, 9, 1, 9, 1, 9,
+	1, 1, 1, 9, 1, 10, 10, 1, 1, 9, 9, 1, 9, 9, 1, 9, 9, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 9, 1,
+	1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9,
+	9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 9, 9, 9, 1, 9, 9, 9, 9, 9, 9, 1,
+	1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 10, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1,
+	9, 1, 9, 1, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9,
+	9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 10, 10, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 9, 9, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 10, 10, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9,
+	9, 9, 9, 9, 9, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 10, 1, 1, 9, 9, 9, 9, 9, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1,
+	1, 9, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1,
+	9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 10, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 9, 1, 9, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 9, 9, 1, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 9,
+	9, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 1, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 9,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 9, 9, 1, 9, 9, 9, 9, 9,
+	9, 1, 9, 9, 1, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 9,
+	10, 10, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 9, 1, 1, 9, 1, 9, 9, 9, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 9,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 10, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 1,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 9, 9,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 1, 1, 9, 9,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 9, 9, 9,
+	9, 1, 1, 9, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 9, 9, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1,
+	9, 9, 9, 9, 1, 9, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 9, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	2, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1,
+	9, 9, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	10, 10, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 9, 10, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 9, 9, 9,
+	9, 9, 9, 1, 9, 9, 9, 1, 1, 9, 9, 9, 1, 1, 1, 1, 9, 1, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 1, 9, 9, 1, 1, 9, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 9, 1, 9, 1, 9,
+	1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 9, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 9, 1, 9, 9, 9, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 2, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 10, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 9, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 9, 1, 9, 1, 1, 9, 1, 1, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 9, 9, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 9,
+	1, 1, 10, 9, 9, 9, 9, 1, 1, 1, 9, 9, 9, 9, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 9, 9, 1, 9, 1, 9, 9, 9, 9, 1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 9, 1, 9, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 10, 10, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9,
+	9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 10, 9, 10, 10, 10, 9,
+	9, 1, 1, 9, 9, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 9, 9, 9, 1,
+	1, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 9, 9, 9, 10, 9, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9,
+	9, 9, 9, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 9, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 9, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 9,
+	9, 9, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 10, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 9, 1, 9, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1,
+	9, 1, 1, 1, 9, 1, 1, 9, 1, 9, 1, 9, 1, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 1,
+	9, 1, 1, 9, 9, 1, 9, 9, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 9, 10, 10, 1, 9, 1, 9, 1, 1, 9, 9, 1, 1,
+	9, 1, 9, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 9, 9, 9, 1, 9, 1,
+	1, 9, 1, 1, 1, 1, 9, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 9,
+	9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 9, 1, 9, 1, 9, 1, 1, 1,
+	1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 9, 1, 9, 1, 9, 9, 10, 1,
+	1, 9, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9,
+	10, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 2, 9, 1,
+	1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 9, 9, 9, 1, 1, 1, 1, 9, 1, 9, 1, 9, 9, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 9, 9, 9, 9, 9, 1, 1, 1, 2, 1, 1, 9, 10, 1, 1, 1, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 9, 9, 1, 9, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 9, 9, 9, 9, 1, 9, 1, 1,
+	1, 1, 9, 9, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9,
+	1, 9, 9, 9, 9, 9, 9, 10, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 9, 9,
+	1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	10, 10, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1,
+	9, 9, 9, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 1, 1, 1,
+	9, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 10, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 10, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9, 1, 1, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 1, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 10, 10, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 10, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 9, 9, 1, 10, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 1, 1,
+	1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 9, 1, 9, 9, 1, 9, 1, 1, 9, 10, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1,
+	1, 9, 1, 1, 1, 1, 9, 9, 1, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9,
+	9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 9, 1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 9, 1, 9, 9, 9, 1, 1, 1, 1, 1, 1,
+	1, 9, 10, 10, 9, 9, 10, 9, 9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 9, 1,
+	9, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 9, 1, 9, 9, 1, 1, 1, 1, 1, 9,
+	1, 1, 1, 1, 9, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 9, 9, 9,
+	10, 1, 1, 9, 1, 1, 1, 1, 10, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 9, 9, 1, 1,
+	9, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 10, 9, 9, 9, 9, 9, 9, 9, 10, 9, 1, 9, 9, 9, 9,
+	9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 1, 9, 9, 9, 9, 1, 1, 1, 9, 9, 9, 1,
+	9, 1, 9, 1, 1, 9, 9, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9, 1,
+	9, 1, 1, 9, 1, 9, 9, 1, 1, 1, 9, 1, 1, 9, 1, 9, 1, 1, 1, 1, 9, 1, 9, 9, 9, 9, 9, 9, 9, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 4, 1, 1, 1, 1, 9, 1, 9, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9,
+	9, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 9,
+	9, 9, 1, 1, 9, 1, 1, 1, 1, 1, 1, 9, 9, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	9, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 9, 1, 1, 1, 1, 9, 1, 1, 1, 1, 1, 9, 9, 9, 1, 1, 1, 9, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1,
+}
@@ -0,0 +1,187 @@
+// file: table_test.go
+// description: tests for the suffix table and the built-in list
+
+package gotld
+
+import (
+	"testing"
+)
+
+// TestBuiltinTable tests lookups against the generated built-in table
+func TestBuiltinTable(t *testing.T) {
+	if builtinTable.Len() == 0 {
+		t.Fatal("built-in table is empty")
+	}
+
+	if builtinListSHA256 == "" {
+		t.Error("built-in table has no source digest")
+	}
+
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("Failed to create FQDN manager: %v", err)
+	}
+
+	private, err := newFQDN(&Options{AllowPrivateTLDs: true})
+	if err != nil {
+		t.Fatalf("Failed to create FQDN manager: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		manager  *FQDN
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "Normal rule",
+			manager:  fqdn,
+			input:    "https://www.example.co.uk/path",
+			expected: "example.co.uk",
+		},
+		{
+			name:     "Wildcard rule",
+			manager:  fqdn,
+			input:    "shop.foo.bar.ck",
+			expected: "foo.bar.ck",
+		},
+		{
+			name:     "Exception rule",
+			manager:  fqdn,
+			input:    "www.ck",
+			expected: "www.ck",
+		},
+		{
+			name:     "Exception rule below a wildcard",
+			manager:  fqdn,
+			input:    "a.city.kawasaki.jp",
+			expected: "city.kawasaki.jp",
+		},
+		{
+			name:    "Wildcard suffix only",
+			manager: fqdn,
+			input:   "bar.ck",
+			wantErr: true,
+		},
+		{
+			name:     "Private rule ignored",
+			manager:  fqdn,
+			input:    "user.github.io",
+			expected: "github.io",
+		},
+		{
+			name:     "Private rule allowed",
+			manager:  private,
+			input:    "user.github.io",
+			expected: "user.github.io",
+		},
+		{
+			name:     "Upper case host",
+			manager:  fqdn,
+			input:    "WWW.Example.COM",
+			expected: "example.com",
+		},
+		{
+			name:     "Unicode IDN suffix",
+			manager:  fqdn,
+			input:    "a.b.公司.cn",
+			expected: "b.公司.cn",
+		},
+		{
+			name:     "Punycode IDN suffix",
+			manager:  fqdn,
+			input:    "a.b.xn--55qx5d.cn",
+			expected: "b.xn--55qx5d.cn",
+		},
+		{
+			name:     "Punycode IDN TLD",
+			manager:  fqdn,
+			input:    "https://www.example.xn--p1ai/",
+			expected: "example.xn--p1ai",
+		},
+		{
+			name:    "Punycode IDN suffix only",
+			manager: fqdn,
+			input:   "xn--55qx5d.cn",
+			wantErr: true,
+		},
+		{
+			name:     "Invalid punycode label",
+			manager:  fqdn,
+			input:    "a.xn--zz.com",
+			expected: "xn--zz.com",
+		},
+		{
+			name:     "Punycode IDN suffix beside a non-IDN label",
+			manager:  fqdn,
+			input:    "ab--cd.xn--zz.b.xn--55qx5d.cn",
+			expected: "b.xn--55qx5d.cn",
+		},
+		{
+			name:    "Unknown TLD",
+			manager: fqdn,
+			input:   "example.invalidtld",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.manager.GetFQDN(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetFQDN() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && got != tt.expected {
+				t.Errorf("GetFQDN() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestTableBuilder tests building a table from list lines
+func TestTableBuilder(t *testing.T) {
+	builder := newTableBuilder()
+
+	lines := []string{"// comment", "", "com", "*.ck", "!www.ck", "CO.UK ignored", "*"}
+	added := 0
+	for _, line := range lines {
+		if builder.Add(line, false) {
+			added++
+		}
+	}
+	builder.Add("github.io", true)
+
+	if added != 4 {
+		t.Errorf("Add() accepted %d rules, want 4", added)
+	}
+
+	table := builder.Build()
+	if table.Len() != 5 {
+		t.Fatalf("Build() produced %d suffixes, want 5", table.Len())
+	}
+
+	tests := []struct {
+		suffix string
+		flags  uint8
+	}{
+		{"com", ruleNormal},
+		{"ck", ruleWildcard},
+		{"www.ck", ruleException},
+		{"co.uk", ruleNormal},
+		{"github.io", ruleNormal | rulePrivate},
+	}
+
+	for _, tt := range tests {
+		flags, ok := table.find(tt.suffix)
+		if !ok || flags != tt.flags {
+			t.Errorf("find(%q) = %d, %v, want %d", tt.suffix, flags, ok, tt.flags)
+		}
+	}
+
+	if flags := table.match("github.io", false); flags != 0 {
+		t.Errorf("match() returned private rule %d when not allowed", flags)
+	}
+}
@@ -0,0 +1,295 @@
+// file: tlddb.go
+// description: metadata about top-level domains from the IANA root zone
+
+package gotld
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/idna"
+)
+
+// tldData is the built-in TLD database generated by gentld.go
+//
+//go:embed tlds.txt
+var tldData string
+
+// TLDType is the IANA classification of a top-level domain
+type TLDType string
+
+// TLD types used by the IANA root zone database, plus TLDBrand. IANA
+// classifies brand TLDs as generic; gentld.go marks them brand when given
+// the list of brand TLDs.
+const (
+	TLDGeneric           TLDType = "generic"
+	TLDBrand             TLDType = "brand"
+	TLDCountryCode       TLDType = "country-code"
+	TLDSponsored         TLDType = "sponsored"
+	TLDGenericRestricted TLDType = "generic-restricted"
+	TLDInfrastructure    TLDType = "infrastructure"
+	TLDTest              TLDType = "test"
+)
+
+// TLDInfo describes a top-level domain
+type TLDInfo struct {
+	// TLD is the top-level domain in ASCII form, e.g. "xn--p1ai"
+	TLD string `json:"tld"`
+
+	// Unicode is the top-level domain in Unicode form, e.g. "рф"
+	Unicode string `json:"unicode"`
+
+	// Type is the IANA classification
+	Type TLDType `json:"type"`
+
+	// Sponsor is the sponsoring organization or registry operator; it may
+	// be empty when the database does not record it
+	Sponsor string `json:"sponsor,omitempty"`
+
+	// Delegated is true when the TLD is present in the root zone
+	Delegated bool `json:"delegated"`
+}
+
+// TLDDatabase holds metadata of top-level domains
+type TLDDatabase struct {
+	version string
+	entries map[string]TLDInfo
+}
+
+// builtinTLDs parses the embedded database once
+var builtinTLDs = sync.OnceValue(func() *TLDDatabase {
+	db, err := ParseTLDDatabase(strings.NewReader(tldData))
+	if err != nil {
+		panic("gotld: invalid built-in TLD database: " + err.Error())
+	}
+	return db
+})
+
+// BuiltinTLDDatabase returns the TLD database compiled into the package
+func BuiltinTLDDatabase() *TLDDatabase {
+	return builtinTLDs()
+}
+
+// ParseTLDDatabase reads a database in the format written by gentld.go:
+// tab separated lines of TLD, type, status ("delegated" or "undelegated")
+// and an optional sponsor, with "#" comments and a "# VERSION:" header.
+func ParseTLDDatabase(r io.Reader) (*TLDDatabase, error) {
+	db := &TLDDatabase{entries: make(map[string]TLDInfo)}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if v, ok := strings.CutPrefix(line, "# VERSION:"); ok {
+			db.version = strings.TrimSpace(v)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			return nil, wrapError(ErrTLDDatabaseFormat, "malformed line "+line)
+		}
+
+		info, err := newTLDInfo(fields[0], TLDType(fields[1]))
+		if err != nil {
+			return nil, err
+		}
+		info.Delegated = fields[2] == "delegated"
+		if len(fields) > 3 {
+			info.Sponsor = fields[3]
+		}
+
+		db.entries[info.TLD] = info
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, wrapError(ErrTLDDatabaseFormat, err.Error())
+	}
+
+	if len(db.entries) == 0 {
+		return nil, wrapError(ErrTLDDatabaseFormat, "no TLDs found")
+	}
+
+	return db, nil
+}
+
+// LoadTLDDatabaseFile reads a database file written by gentld.go
+func LoadTLDDatabaseFile(path string) (*TLDDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, wrapError(ErrTLDDatabaseFormat, err.Error())
+	}
+	defer file.Close()
+
+	return ParseTLDDatabase(file)
+}
+
+// newTLDInfo normalizes a TLD into its ASCII and Unicode forms
+func newTLDInfo(tld string, kind TLDType) (TLDInfo, error) {
+	ascii, err := idna.Lookup.ToASCII(strings.TrimPrefix(tld, "."))
+	if err != nil || ascii == "" || strings.Contains(ascii, ".") {
+		return TLDInfo{}, wrapError(ErrTLDDatabaseFormat, "invalid TLD "+tld)
+	}
+
+	unicode, err := idna.Lookup.ToUnicode(ascii)
+	if err != nil {
+		return TLDInfo{}, wrapError(ErrTLDDatabaseFormat, "invalid TLD "+tld)
+	}
+
+	return TLDInfo{TLD: ascii, Unicode: unicode, Type: kind}, nil
+}
+
+// Version returns the version of the database, usually its date
+func (db *TLDDatabase) Version() string {
+	return db.version
+}
+
+// Len returns the number of TLDs in the database
+func (db *TLDDatabase) Len() int {
+	return len(db.entries)
+}
+
+// Lookup returns the metadata of a TLD given in ASCII or Unicode form,
+// with or without a leading dot
+func (db *TLDDatabase) Lookup(tld string) (TLDInfo, bool) {
+	ascii, err := idna.Lookup.ToASCII(strings.TrimPrefix(tld, "."))
+	if err != nil {
+		return TLDInfo{}, false
+	}
+
+	info, ok := db.entries[ascii]
+	return info, ok
+}
+
+// TLDs returns every TLD in the database sorted by ASCII form
+func (db *TLDDatabase) TLDs() []TLDInfo {
+	infos := make([]TLDInfo, 0, len(db.entries))
+	for _, info := range db.entries {
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].TLD < infos[j].TLD })
+	return infos
+}
+
+// WithDelegations returns a copy of the database whose delegation status
+// is taken from an IANA tlds-alpha-by-domain.txt file. TLDs listed in the
+// file but missing from the database are added with an empty type.
+func (db *TLDDatabase) WithDelegations(r io.Reader) (*TLDDatabase, error) {
+	zone := make(map[string]bool)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		zone[strings.ToLower(line)] = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, wrapError(ErrTLDDatabaseFormat, err.Error())
+	}
+
+	if len(zone) == 0 {
+		return nil, wrapError(ErrTLDDatabaseFormat, "no TLDs found")
+	}
+
+	out := &TLDDatabase{version: db.version, entries: make(map[string]TLDInfo, len(db.entries))}
+	for tld, info := range db.entries {
+		info.Delegated = zone[tld]
+		out.entries[tld] = info
+	}
+
+	for tld := range zone {
+		if _, ok := out.entries[tld]; ok {
+			continue
+		}
+		info, err := newTLDInfo(tld, "")
+		if err != nil {
+			return nil, err
+		}
+		info.Delegated = true
+		out.entries[info.TLD] = info
+	}
+
+	return out, nil
+}
+
+// TLDDatabase returns the TLD database used by the manager
+func (f *FQDN) TLDDatabase() *TLDDatabase {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.tlds != nil {
+		return f.tlds
+	}
+	return BuiltinTLDDatabase()
+}
+
+// ReloadTLDDatabase reads Options.TLDDatabaseFile again; the built-in
+// database is kept when no file is configured
+func (f *FQDN) ReloadTLDDatabase() error {
+	if f.Options.TLDDatabaseFile == "" {
+		return nil
+	}
+
+	db, err := LoadTLDDatabaseFile(f.Options.TLDDatabaseFile)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.tlds = db
+	f.mu.Unlock()
+
+	return nil
+}
+
+// TLDInfo returns the metadata of the top-level domain of a URL or host,
+// which is its last label rather than its public suffix
+func (f *FQDN) TLDInfo(srcURL string) (TLDInfo, error) {
+	host, err := f.hostname(srcURL)
+	if err != nil {
+		return TLDInfo{}, err
+	}
+
+	tld := host[strings.LastIndexByte(strings.TrimSuffix(host, "."), '.')+1:]
+	info, ok := f.TLDDatabase().Lookup(strings.TrimSuffix(tld, "."))
+	if !ok {
+		return TLDInfo{}, wrapError(ErrInvalidTLD, "not in the root zone database")
+	}
+
+	return info, nil
+}
+
+// MissingTLDs returns the delegated TLDs of the TLD database that have no
+// rule in the loaded public suffix list, a sign that the list is stale. The
+// built-in database was derived from the built-in list, so use an IANA
+// database or WithDelegations for a meaningful result.
+func (f *FQDN) MissingTLDs() []TLDInfo {
+	var missing []TLDInfo
+
+	for _, info := range f.TLDDatabase().TLDs() {
+		if !info.Delegated {
+			continue
+		}
+
+		f.mu.RLock()
+		flags := f.rules.match(info.Unicode, true) | f.rules.match(info.TLD, true)
+		f.mu.RUnlock()
+
+		if flags == 0 {
+			missing = append(missing, info)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,174 @@
+// file: tlddb_test.go
+// description: tests for the TLD metadata database
+
+package gotld
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testTLDDatabase is a small database in the gentld.go format
+const testTLDDatabase = `# gotld TLD database
+# VERSION: 2025-01-01
+# tld	type	status	sponsor
+com	generic	delegated	VeriSign Global Registry Services
+google	brand	delegated	Charleston Road Registry Inc.
+uk	country-code	delegated	Nominet UK
+xn--p1ai	country-code	delegated	Coordination Center for TLD RU
+arpa	infrastructure	delegated	Internet Architecture Board (IAB)
+example	test	undelegated
+`
+
+// TestBuiltinTLDDatabase tests lookups in the embedded database
+func TestBuiltinTLDDatabase(t *testing.T) {
+	db := BuiltinTLDDatabase()
+	if db.Len() < 1000 || db.Version() == "" {
+		t.Fatalf("Len() = %d, Version() = %q", db.Len(), db.Version())
+	}
+
+	tests := []struct {
+		tld   string
+		ascii string
+		kind  TLDType
+	}{
+		{"com", "com", TLDGeneric},
+		{".COM", "com", TLDGeneric},
+		{"uk", "uk", TLDCountryCode},
+		{"рф", "xn--p1ai", TLDCountryCode},
+		{"xn--p1ai", "xn--p1ai", TLDCountryCode},
+		{"arpa", "arpa", TLDInfrastructure},
+		{"museum", "museum", TLDSponsored},
+		{"biz", "biz", TLDGenericRestricted},
+	}
+
+	for _, tt := range tests {
+		info, ok := db.Lookup(tt.tld)
+		if !ok || info.TLD != tt.ascii || info.Type != tt.kind || !info.Delegated {
+			t.Errorf("Lookup(%q) = %+v, %v, want %s %s", tt.tld, info, ok, tt.ascii, tt.kind)
+		}
+	}
+
+	if _, ok := db.Lookup("invalidtld"); ok {
+		t.Error("Lookup(invalidtld) found an entry")
+	}
+}
+
+// TestParseTLDDatabase tests parsing databases and delegation files
+func TestParseTLDDatabase(t *testing.T) {
+	db, err := ParseTLDDatabase(strings.NewReader(testTLDDatabase))
+	if err != nil {
+		t.Fatalf("ParseTLDDatabase() error = %v", err)
+	}
+
+	if db.Len() != 6 || db.Version() != "2025-01-01" {
+		t.Errorf("Len() = %d, Version() = %q", db.Len(), db.Version())
+	}
+
+	info, _ := db.Lookup("рф")
+	if info.Unicode != "рф" || info.Sponsor != "Coordination Center for TLD RU" {
+		t.Errorf("Lookup(рф) = %+v", info)
+	}
+
+	if info, _ := db.Lookup("google"); info.Type != TLDBrand {
+		t.Errorf("Lookup(google) = %+v, want brand", info)
+	}
+
+	if info, _ := db.Lookup("example"); info.Delegated || info.Type != TLDTest || info.Sponsor != "" {
+		t.Errorf("Lookup(example) = %+v", info)
+	}
+
+	// Delegations come from tlds-alpha-by-domain.txt
+	alpha := "# Version 2025010100, Last Updated Wed Jan  1 07:07:01 2025 UTC\nCOM\nXN--P1AI\nNET\n"
+	updated, err := db.WithDelegations(strings.NewReader(alpha))
+	if err != nil {
+		t.Fatalf("WithDelegations() error = %v", err)
+	}
+
+	if info, _ := updated.Lookup("uk"); info.Delegated {
+		t.Errorf("uk Delegated = true, want false")
+	}
+	if info, ok := updated.Lookup("net"); !ok || !info.Delegated || info.Type != "" {
+		t.Errorf("Lookup(net) = %+v, %v", info, ok)
+	}
+	if info, _ := db.Lookup("uk"); !info.Delegated {
+		t.Errorf("WithDelegations() modified the original database")
+	}
+
+	for _, input := range []string{"", "# only comments\n", "com generic\n", "bad..tld\tgeneric\tdelegated\n"} {
+		if _, err := ParseTLDDatabase(strings.NewReader(input)); !errors.Is(err, ErrTLDDatabaseFormat) {
+			t.Errorf("ParseTLDDatabase(%q) error = %v, want %v", input, err, ErrTLDDatabaseFormat)
+		}
+	}
+}
+
+// TestTLDInfo tests TLD metadata lookups through the manager
+func TestTLDInfo(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tlds.txt")
+	if err := os.WriteFile(path, []byte(testTLDDatabase), 0o600); err != nil {
+		t.Fatalf("Failed to write database: %v", err)
+	}
+
+	fqdn, err := newFQDN(&Options{TLDDatabaseFile: path})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	info, err := fqdn.TLDInfo("https://www.example.co.uk/")
+	if err != nil || info.TLD != "uk" || info.Sponsor != "Nominet UK" {
+		t.Errorf("TLDInfo() = %+v, %v", info, err)
+	}
+
+	if _, err := fqdn.TLDInfo("example.org"); !errors.Is(err, ErrInvalidTLD) {
+		t.Errorf("TLDInfo() error = %v, want %v", err, ErrInvalidTLD)
+	}
+
+	// Reloading picks up changes to the file
+	if err := os.WriteFile(path, []byte(testTLDDatabase+"org\tgeneric\tdelegated\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write database: %v", err)
+	}
+	if err := fqdn.ReloadTLDDatabase(); err != nil {
+		t.Fatalf("ReloadTLDDatabase() error = %v", err)
+	}
+	if info, err := fqdn.TLDInfo("example.org"); err != nil || info.Type != TLDGeneric {
+		t.Errorf("TLDInfo() = %+v, %v", info, err)
+	}
+
+	if _, err := newFQDN(&Options{TLDDatabaseFile: filepath.Join(t.TempDir(), "missing.txt")}); !errors.Is(err, ErrTLDDatabaseFormat) {
+		t.Errorf("newFQDN() error = %v, want %v", err, ErrTLDDatabaseFormat)
+	}
+}
+
+// TestMissingTLDs tests finding root zone TLDs absent from the list
+func TestMissingTLDs(t *testing.T) {
+	builtin, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	if missing := builtin.MissingTLDs(); len(missing) != 0 {
+		t.Errorf("MissingTLDs() = %v, want none for the built-in list", missing)
+	}
+
+	path := filepath.Join(t.TempDir(), "tlds.txt")
+	if err := os.WriteFile(path, []byte(testTLDDatabase), 0o600); err != nil {
+		t.Fatalf("Failed to write database: %v", err)
+	}
+
+	fqdn, err := newFQDN(&Options{Source: NewReaderSource(strings.NewReader(testList)), TLDDatabaseFile: path})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	// com and uk are in the test list; undelegated TLDs are ignored
+	var got []string
+	for _, info := range fqdn.MissingTLDs() {
+		got = append(got, info.TLD)
+	}
+	if strings.Join(got, ",") != "arpa,google,xn--p1ai" {
+		t.Errorf("MissingTLDs() = %v, want [arpa google xn--p1ai]", got)
+	}
+}
@@ -0,0 +1,562 @@
+// file: typosquat.go
+// description: typosquat candidate generation and detection for protected domains
+
+package gotld
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// TyposquatKind is the technique that produces a typosquat
+type TyposquatKind int
+
+const (
+	// TypoOmission drops a character, e.g. "exmple.com"
+	TypoOmission TyposquatKind = iota
+
+	// TypoTransposition swaps adjacent characters, e.g. "exmaple.com"
+	TypoTransposition
+
+	// TypoRepetition doubles a character, e.g. "exxample.com"
+	TypoRepetition
+
+	// TypoKeyboard replaces a character with a QWERTY neighbour, e.g. "rxample.com"
+	TypoKeyboard
+
+	// TypoHomoglyph replaces characters with lookalikes, e.g. "examp1e.com"
+	TypoHomoglyph
+
+	// TypoBitFlip flips one bit of a character, e.g. "exaeple.com"
+	TypoBitFlip
+
+	// TypoTLDSwap keeps the label under another public suffix, e.g. "example.net"
+	TypoTLDSwap
+
+	// TypoHyphenation inserts a hyphen, e.g. "ex-ample.com"
+	TypoHyphenation
+
+	// TypoSubdomain places the domain in front of another registrable
+	// domain, e.g. "example.com-login.com" or "example.com.login.net"
+	TypoSubdomain
+
+	// TypoEdit is any other label within the edit distance, reported only
+	// by the detector
+	TypoEdit
+)
+
+// typosquatKinds are the kinds generated by default
+var typosquatKinds = []TyposquatKind{
+	TypoOmission, TypoTransposition, TypoRepetition, TypoKeyboard, TypoHomoglyph,
+	TypoBitFlip, TypoTLDSwap, TypoHyphenation, TypoSubdomain,
+}
+
+// String returns the name of the typosquat kind
+func (k TyposquatKind) String() string {
+	switch k {
+	case TypoOmission:
+		return "omission"
+	case TypoTransposition:
+		return "transposition"
+	case TypoRepetition:
+		return "repetition"
+	case TypoKeyboard:
+		return "keyboard"
+	case TypoHomoglyph:
+		return "homoglyph"
+	case TypoBitFlip:
+		return "bit-flip"
+	case TypoTLDSwap:
+		return "tld-swap"
+	case TypoHyphenation:
+		return "hyphenation"
+	case TypoSubdomain:
+		return "subdomain"
+	case TypoEdit:
+		return "edit"
+	}
+	return "unknown"
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k TyposquatKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// DefaultTyposquatKeywords are the keywords used for subdomain insertion
+var DefaultTyposquatKeywords = []string{"login", "secure", "account", "verify", "support"}
+
+// DefaultTyposquatSuffixes are the suffixes, besides the domain's own,
+// that subdomain insertion registers under, as attackers favour cheap and
+// familiar ones
+var DefaultTyposquatSuffixes = []string{"com", "net", "org", "info"}
+
+// DefaultTyposquatDistance is the edit distance used when none is given
+const DefaultTyposquatDistance = 2
+
+// TyposquatOptions configures typosquat generation
+type TyposquatOptions struct {
+	// Kinds limits generation to these kinds; empty generates all
+	Kinds []TyposquatKind
+
+	// Keywords are used for subdomain insertion; empty uses
+	// DefaultTyposquatKeywords
+	Keywords []string
+
+	// Suffixes are the public suffixes subdomain insertion uses in
+	// addition to the domain's own; empty uses DefaultTyposquatSuffixes.
+	// Suffixes missing from the loaded list are skipped.
+	Suffixes []string
+}
+
+// Typosquat is a generated typosquat candidate
+type Typosquat struct {
+	// Domain is the candidate in Unicode form, e.g. "exmple.com"
+	Domain string `json:"domain"`
+
+	// ASCII is the candidate in ASCII (punycode) form
+	ASCII string `json:"ascii"`
+
+	// Kind is the technique that produced the candidate
+	Kind TyposquatKind `json:"kind"`
+}
+
+// Typosquats generates likely typosquats of the registrable domain of a
+// URL or host. Candidates are deduplicated, keep the first kind that
+// produced them and are valid host names. TLD swaps use only normal rules
+// of the loaded list, private ones when AllowPrivateTLDs is set: every
+// top-level suffix and the siblings of the domain's own suffix, such as
+// "org.uk" for "co.uk". Subdomain insertion registers a keyword domain
+// under the domain's own suffix and each of TyposquatOptions.Suffixes,
+// as in "example.com-login.net" and "example.com.login.net"; it does not
+// enumerate every suffix of the list. A nil opts generates every kind.
+func (f *FQDN) Typosquats(srcURL string, opts *TyposquatOptions) ([]Typosquat, error) {
+	_, registrable, label, err := f.splitLabel(srcURL)
+	if err != nil {
+		return nil, err
+	}
+	eTLD := strings.TrimPrefix(registrable, label+".")
+
+	if opts == nil {
+		opts = &TyposquatOptions{}
+	}
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = typosquatKinds
+	}
+	keywords := opts.Keywords
+	if len(keywords) == 0 {
+		keywords = DefaultTyposquatKeywords
+	}
+
+	seen := map[string]bool{registrable: true}
+	var candidates []Typosquat
+	add := func(domain string, kind TyposquatKind) {
+		if seen[domain] {
+			return
+		}
+		seen[domain] = true
+
+		ascii, err := idna.Lookup.ToASCII(domain)
+		if err != nil || ValidateHostname(ascii, HostnameLDH) != nil {
+			return
+		}
+		candidates = append(candidates, Typosquat{Domain: domain, ASCII: ascii, Kind: kind})
+	}
+
+	for _, kind := range kinds {
+		switch kind {
+		case TypoTLDSwap:
+			for _, suffix := range f.swapSuffixes(eTLD) {
+				add(label+"."+suffix, kind)
+			}
+		case TypoSubdomain:
+			for _, suffix := range f.subdomainSuffixes(eTLD, opts.Suffixes) {
+				for _, keyword := range keywords {
+					add(registrable+"-"+keyword+"."+suffix, kind)
+					add(registrable+"."+keyword+"."+suffix, kind)
+				}
+			}
+		default:
+			for _, variant := range labelVariants(label, kind) {
+				add(variant+"."+eTLD, kind)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// swapSuffixes returns the top-level suffixes and the siblings of eTLD
+// that the loaded list holds normal rules for
+func (f *FQDN) swapSuffixes(eTLD string) []string {
+	table := f.snapshot()
+	_, parent, _ := strings.Cut(eTLD, ".")
+
+	var suffixes []string
+	for i := 0; i < table.Len(); i++ {
+		flags := table.flags[i]
+		if flags&ruleNormal == 0 || (flags&rulePrivate != 0 && !f.Options.AllowPrivateTLDs) {
+			continue
+		}
+
+		key := table.key(i)
+		_, keyParent, _ := strings.Cut(key, ".")
+		if key != eTLD && (keyParent == "" || keyParent == parent) {
+			suffixes = append(suffixes, key)
+		}
+	}
+
+	return suffixes
+}
+
+// subdomainSuffixes returns eTLD followed by the suffixes that the loaded
+// list holds normal rules for
+func (f *FQDN) subdomainSuffixes(eTLD string, suffixes []string) []string {
+	if len(suffixes) == 0 {
+		suffixes = DefaultTyposquatSuffixes
+	}
+	table := f.snapshot()
+
+	result := []string{eTLD}
+	for _, suffix := range suffixes {
+		suffix = tableForm(normalizeSuffix(suffix))
+		if suffix != eTLD && table.match(suffix, f.Options.AllowPrivateTLDs)&ruleNormal != 0 {
+			result = append(result, suffix)
+		}
+	}
+
+	return result
+}
+
+// labelVariants returns the variants of a label produced by one of the
+// label-level kinds
+func labelVariants(label string, kind TyposquatKind) []string {
+	runes := []rune(label)
+	n := len(runes)
+
+	// replace returns the label with runes[i:j] replaced by s
+	replace := func(i, j int, s string) string {
+		return string(runes[:i]) + s + string(runes[j:])
+	}
+
+	var variants []string
+	switch kind {
+	case TypoOmission:
+		for i := 0; i < n && n > 1; i++ {
+			variants = append(variants, replace(i, i+1, ""))
+		}
+	case TypoTransposition:
+		for i := 0; i+1 < n; i++ {
+			if runes[i] != runes[i+1] {
+				variants = append(variants, replace(i, i+2, string(runes[i+1])+string(runes[i])))
+			}
+		}
+	case TypoRepetition:
+		for i := 0; i < n; i++ {
+			variants = append(variants, replace(i, i, string(runes[i])))
+		}
+	case TypoKeyboard:
+		for i := 0; i < n; i++ {
+			for _, c := range keyboardAdjacent[runes[i]] {
+				variants = append(variants, replace(i, i+1, string(c)))
+			}
+		}
+	case TypoHomoglyph:
+		// Keys may span several characters, such as "rn" for "m"
+		table := homoglyphs()
+		for i := 0; i < len(label); {
+			_, size := utf8.DecodeRuneInString(label[i:])
+			for _, key := range table.keys {
+				if strings.HasPrefix(label[i:], key) {
+					for _, glyph := range table.glyphs[key] {
+						variants = append(variants, label[:i]+glyph+label[i+len(key):])
+					}
+				}
+			}
+			i += size
+		}
+	case TypoBitFlip:
+		for i := 0; i < n; i++ {
+			if runes[i] >= utf8.RuneSelf {
+				continue
+			}
+			for bit := 0; bit < 8; bit++ {
+				if c := runes[i] ^ 1<<bit; isLDHLower(c) {
+					variants = append(variants, replace(i, i+1, string(c)))
+				}
+			}
+		}
+	case TypoHyphenation:
+		for i := 1; i < n; i++ {
+			if runes[i-1] != '-' && runes[i] != '-' {
+				variants = append(variants, replace(i, i, "-"))
+			}
+		}
+	}
+
+	return variants
+}
+
+// isLDHLower reports whether c is a lowercase letter, digit or hyphen
+func isLDHLower(c rune) bool {
+	return 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-'
+}
+
+// keyboardAdjacent maps each key to its neighbours on a QWERTY keyboard
+var keyboardAdjacent = func() map[rune][]rune {
+	rows := []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"}
+	adjacent := make(map[rune][]rune)
+
+	// at returns the key at row r, column c, or 0
+	at := func(r, c int) rune {
+		if r < 0 || r >= len(rows) || c < 0 || c >= len(rows[r]) {
+			return 0
+		}
+		return rune(rows[r][c])
+	}
+
+	// Each row is shifted right of the one above, so a key touches
+	// columns c and c+1 above and c-1 and c below
+	for r, row := range rows {
+		for c, key := range row {
+			for _, k := range []rune{at(r, c-1), at(r, c+1), at(r-1, c), at(r-1, c+1), at(r+1, c-1), at(r+1, c)} {
+				if k != 0 {
+					adjacent[key] = append(adjacent[key], k)
+				}
+			}
+		}
+	}
+
+	return adjacent
+}()
+
+// homoglyphTable maps lowercase ASCII strings to their lookalikes
+type homoglyphTable struct {
+	keys   []string
+	glyphs map[string][]string
+}
+
+// homoglyphs builds the homoglyph table from the confusables table once
+var homoglyphs = sync.OnceValue(func() *homoglyphTable {
+	set := make(map[string]map[string]bool)
+	put := func(key, glyph string) {
+		if set[key] == nil {
+			set[key] = make(map[string]bool)
+		}
+		set[key][glyph] = true
+	}
+
+	for r, proto := range confusables {
+		key, glyph := strings.ToLower(proto), strings.ToLower(string(r))
+		if key == glyph || !isASCII(key) {
+			continue
+		}
+		put(key, glyph)
+
+		// ASCII pairs such as "0" and "o" or "m" and "rn" work both ways
+		if isASCII(glyph) {
+			put(glyph, key)
+		}
+	}
+
+	table := &homoglyphTable{glyphs: make(map[string][]string)}
+	for key, glyphs := range set {
+		table.keys = append(table.keys, key)
+		for glyph := range glyphs {
+			table.glyphs[key] = append(table.glyphs[key], glyph)
+		}
+		sort.Strings(table.glyphs[key])
+	}
+	sort.Strings(table.keys)
+
+	return table
+})
+
+// TyposquatMatch is a protected domain an observed host imitates
+type TyposquatMatch struct {
+	// Protected is the registrable domain being imitated
+	Protected string `json:"protected"`
+
+	// Kind is the most likely technique used
+	Kind TyposquatKind `json:"kind"`
+
+	// Distance is the edit distance between the registrable labels or
+	// their confusable skeletons, whichever is smaller
+	Distance int `json:"distance"`
+
+	// Score is the similarity of the labels from 0 to 1, where 1 means
+	// visually identical
+	Score float64 `json:"score"`
+}
+
+// TyposquatResult is the outcome of checking a host for typosquats
+type TyposquatResult struct {
+	// Host is the host in Unicode form
+	Host string `json:"host"`
+
+	// RegistrableDomain is the registrable domain in Unicode form
+	RegistrableDomain string `json:"registrable_domain"`
+
+	// Label is the registrable domain label
+	Label string `json:"label"`
+
+	// Matches are the imitated protected domains, closest first
+	Matches []TyposquatMatch `json:"matches,omitempty"`
+}
+
+// Suspicious reports whether the host imitates a protected domain
+func (r *TyposquatResult) Suspicious() bool {
+	return len(r.Matches) > 0
+}
+
+// TyposquatDetector scores observed hosts against protected domains
+type TyposquatDetector struct {
+	fqdn        *FQDN
+	maxDistance int
+	protected   []typosquatTarget
+}
+
+// typosquatTarget is a protected domain with its precomputed variants
+type typosquatTarget struct {
+	registrable string
+	label       string
+	skeleton    string
+	variants    map[string]TyposquatKind
+}
+
+// NewTyposquatDetector creates a detector for the registrable domains of
+// protected. Hosts match when their registrable label, or its confusable
+// skeleton, is within maxDistance edits of a protected one, or
+// DefaultTyposquatDistance when maxDistance is not positive. A nil manager
+// uses the global one.
+func NewTyposquatDetector(f *FQDN, protected []string, maxDistance int) (*TyposquatDetector, error) {
+	if f == nil {
+		var err error
+		if f, err = Manager(); err != nil {
+			return nil, err
+		}
+	}
+
+	if maxDistance <= 0 {
+		maxDistance = DefaultTyposquatDistance
+	}
+	d := &TyposquatDetector{fqdn: f, maxDistance: maxDistance}
+
+	for _, domain := range protected {
+		_, registrable, label, err := f.splitLabel(domain)
+		if err != nil {
+			return nil, wrapError(err, "invalid protected domain "+domain)
+		}
+
+		target := typosquatTarget{
+			registrable: registrable,
+			label:       label,
+			skeleton:    domainSkeleton(label),
+			variants:    make(map[string]TyposquatKind),
+		}
+		for _, kind := range typosquatKinds {
+			for _, variant := range labelVariants(label, kind) {
+				if _, ok := target.variants[variant]; !ok {
+					target.variants[variant] = kind
+				}
+			}
+		}
+		d.protected = append(d.protected, target)
+	}
+
+	return d, nil
+}
+
+// Check scores the registrable domain of a URL or host against the
+// protected domains. The protected domains themselves and their
+// subdomains never match. A host that carries a protected label in front
+// of another registrable domain, such as "example.com-login.net", matches
+// as a subdomain insertion.
+func (d *TyposquatDetector) Check(srcURL string) (*TyposquatResult, error) {
+	host, registrable, label, err := d.fqdn.splitLabel(srcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TyposquatResult{Host: host, RegistrableDomain: registrable, Label: label}
+	subdomains := strings.Split(strings.TrimSuffix(host, registrable), ".")
+	skeleton := domainSkeleton(label)
+
+	for _, target := range d.protected {
+		if registrable == target.registrable {
+			continue
+		}
+
+		match := TyposquatMatch{Protected: target.registrable}
+		switch {
+		case slices.Contains(subdomains, target.label):
+			match.Kind = TypoSubdomain
+		case label == target.label:
+			match.Kind = TypoTLDSwap
+		default:
+			// Skeletons catch homoglyphs, but expand characters such as
+			// "m" to "rn", so the plain labels may be closer
+			match.Distance = min(editDistance(label, target.label), editDistance(skeleton, target.skeleton))
+			if match.Distance > d.maxDistance || match.Distance >= utf8.RuneCountInString(target.label) {
+				continue
+			}
+
+			kind, ok := target.variants[label]
+			switch {
+			case ok:
+				match.Kind = kind
+			case match.Distance == 0:
+				match.Kind = TypoHomoglyph
+			default:
+				match.Kind = TypoEdit
+			}
+		}
+
+		longest := max(utf8.RuneCountInString(label), utf8.RuneCountInString(target.label))
+		match.Score = 1 - float64(match.Distance)/float64(longest)
+		res.Matches = append(res.Matches, match)
+	}
+
+	sort.SliceStable(res.Matches, func(i, j int) bool {
+		return res.Matches[i].Distance < res.Matches[j].Distance
+	})
+
+	return res, nil
+}
+
+// editDistance returns the optimal string alignment distance between a
+// and b: insertions, deletions, substitutions and adjacent transpositions
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Three rows suffice, as a transposition looks two rows back
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
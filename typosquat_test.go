@@ -0,0 +1,213 @@
+// file: typosquat_test.go
+// description: tests for typosquat generation and detection
+
+package gotld
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestTyposquats tests candidate generation for each kind
+func TestTyposquats(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	candidates, err := fqdn.Typosquats("https://www.example.com/", nil)
+	if err != nil {
+		t.Fatalf("Typosquats() error = %v", err)
+	}
+
+	got := make(map[string]TyposquatKind)
+	for _, c := range candidates {
+		if _, dup := got[c.Domain]; dup {
+			t.Errorf("duplicate candidate %q", c.Domain)
+		}
+		got[c.Domain] = c.Kind
+
+		if err := ValidateHostname(c.ASCII, HostnameLDH); err != nil {
+			t.Errorf("candidate %q is not a valid host name: %v", c.ASCII, err)
+		}
+	}
+
+	want := map[string]TyposquatKind{
+		"exmple.com":             TypoOmission,
+		"exmaple.com":            TypoTransposition,
+		"exxample.com":           TypoRepetition,
+		"rxample.com":            TypoKeyboard,
+		"examp1e.com":            TypoHomoglyph,
+		"exarnple.com":           TypoHomoglyph,
+		"exаmple.com":            TypoHomoglyph, // Cyrillic а
+		"exaeple.com":            TypoBitFlip,
+		"example.net":            TypoTLDSwap,
+		"example.org":            TypoTLDSwap,
+		"ex-ample.com":           TypoHyphenation,
+		"example.com-login.com":  TypoSubdomain,
+		"example.com.login.com":  TypoSubdomain,
+		"example.com-login.net":  TypoSubdomain,
+		"example.com.secure.org": TypoSubdomain,
+	}
+	for domain, kind := range want {
+		if got[domain] != kind {
+			t.Errorf("candidate %q kind = %v, want %v", domain, got[domain], kind)
+		}
+	}
+
+	for _, unwanted := range []string{"example.com", "-example.com", "example.co.uk", "example.blogspot.com"} {
+		if _, ok := got[unwanted]; ok {
+			t.Errorf("unexpected candidate %q", unwanted)
+		}
+	}
+}
+
+// TestTyposquatsTLDSwap tests that swaps only use suffixes from the list
+func TestTyposquatsTLDSwap(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	candidates, err := fqdn.Typosquats("example.co.uk", &TyposquatOptions{Kinds: []TyposquatKind{TypoTLDSwap}})
+	if err != nil {
+		t.Fatalf("Typosquats() error = %v", err)
+	}
+
+	got := make(map[string]bool)
+	for _, c := range candidates {
+		if c.Kind != TypoTLDSwap {
+			t.Errorf("candidate %q kind = %v", c.Domain, c.Kind)
+		}
+		suffix := strings.TrimPrefix(c.Domain, "example.")
+		if !fqdn.IsPublicSuffix(suffix) || fqdn.findTLD("gotld-test."+suffix) != suffix {
+			t.Errorf("candidate %q uses a suffix that is not in the list", c.Domain)
+		}
+		got[c.Domain] = true
+	}
+
+	for _, domain := range []string{"example.org.uk", "example.uk", "example.com"} {
+		if !got[domain] {
+			t.Errorf("missing candidate %q", domain)
+		}
+	}
+	if got["example.co.jp"] {
+		t.Error("unexpected candidate example.co.jp")
+	}
+
+	// Subdomain insertion honors custom keywords and suffixes, skipping
+	// suffixes that are not in the list
+	candidates, err = fqdn.Typosquats("example.co.uk", &TyposquatOptions{
+		Kinds:    []TyposquatKind{TypoSubdomain},
+		Keywords: []string{"pay"},
+		Suffixes: []string{"net", "gotld-invalid"},
+	})
+	if err != nil {
+		t.Fatalf("Typosquats() error = %v", err)
+	}
+
+	var domains []string
+	for _, c := range candidates {
+		domains = append(domains, c.Domain)
+	}
+	want := "example.co.uk-pay.co.uk,example.co.uk.pay.co.uk,example.co.uk-pay.net,example.co.uk.pay.net"
+	if got := strings.Join(domains, ","); got != want {
+		t.Errorf("Typosquats() = %s, want %s", got, want)
+	}
+}
+
+// TestTyposquatDetector tests scoring of observed hosts
+func TestTyposquatDetector(t *testing.T) {
+	fqdn, err := newFQDN(&Options{})
+	if err != nil {
+		t.Fatalf("newFQDN() error = %v", err)
+	}
+
+	detector, err := NewTyposquatDetector(fqdn, []string{"example.com", "paypal.com"}, 0)
+	if err != nil {
+		t.Fatalf("NewTyposquatDetector() error = %v", err)
+	}
+
+	tests := []struct {
+		input     string
+		protected string
+		kind      TyposquatKind
+		distance  int
+	}{
+		{"www.example.com", "", 0, 0},
+		{"unrelated.org", "", 0, 0},
+		{"https://exmple.com/login", "example.com", TypoOmission, 1},
+		{"exmaple.com", "example.com", TypoTransposition, 1},
+		{"example.net", "example.com", TypoTLDSwap, 0},
+		{"example.com-login.net", "example.com", TypoSubdomain, 0},
+		{"example.com.login.net", "example.com", TypoSubdomain, 0},
+		{"pаypal.com", "paypal.com", TypoHomoglyph, 0},
+		{"xn--pypal-4ve.com", "paypal.com", TypoHomoglyph, 0},
+		{"paypa1-secure.com", "", 0, 0},
+		{"paypals.net", "paypal.com", TypoEdit, 1},
+		{"exampel.com", "example.com", TypoTransposition, 1},
+		{"examplle.com", "example.com", TypoRepetition, 1},
+	}
+
+	for _, tt := range tests {
+		res, err := detector.Check(tt.input)
+		if err != nil {
+			t.Fatalf("Check(%q) error = %v", tt.input, err)
+		}
+
+		if tt.protected == "" {
+			if res.Suspicious() {
+				t.Errorf("Check(%q) = %+v, want no match", tt.input, res.Matches)
+			}
+			continue
+		}
+
+		if len(res.Matches) == 0 {
+			t.Errorf("Check(%q) has no match", tt.input)
+			continue
+		}
+		m := res.Matches[0]
+		if m.Protected != tt.protected || m.Kind != tt.kind || m.Distance != tt.distance {
+			t.Errorf("Check(%q) = %+v, want %s %v %d", tt.input, m, tt.protected, tt.kind, tt.distance)
+		}
+		if m.Score <= 0 || m.Score > 1 {
+			t.Errorf("Check(%q) score = %v", tt.input, m.Score)
+		}
+	}
+
+	if _, err := NewTyposquatDetector(fqdn, []string{"not a domain"}, 0); err == nil {
+		t.Error("NewTyposquatDetector() accepted an invalid domain")
+	}
+
+	// A nil manager uses the global one
+	global, err := NewTyposquatDetector(nil, []string{"example.com"}, 0)
+	if err != nil {
+		t.Fatalf("NewTyposquatDetector(nil) error = %v", err)
+	}
+	if res, err := global.Check("exmaple.com"); err != nil || !res.Suspicious() {
+		t.Errorf("Check() = %+v, %v, want a match", res, err)
+	}
+}
+
+// TestEditDistance tests the optimal string alignment distance
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"example", "exmple", 1},
+		{"example", "exmaple", 1},
+		{"example", "axample", 1},
+		{"kitten", "sitting", 3},
+		{"ca", "abc", 3},
+		{"bücher", "bucher", 1},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}